@@ -0,0 +1,290 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/datarobot/cli/internal/errs"
+	"github.com/spf13/cobra"
+)
+
+// methods lists the HTTP methods the command accepts
+var methods = []string{
+	http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+	http.MethodPatch, http.MethodDelete, http.MethodOptions,
+}
+
+type options struct {
+	raw     bool
+	data    string
+	headers []string
+	query   []string
+}
+
+// Run sends one request and writes the response body to stdout and its
+// status to stderr. A status outside 2xx is returned as an error after the
+// body is written, so the exit code reflects it.
+func Run(stdin io.Reader, stdout, stderr io.Writer, method, path string, opts options) error {
+	method = strings.ToUpper(method)
+	if !slices.Contains(methods, method) {
+		return fmt.Errorf("Unknown method %q (must be one of: %s).", method, strings.Join(methods, ", "))
+	}
+
+	target, err := requestURL(path, opts.query)
+	if err != nil {
+		return err
+	}
+
+	body, err := requestBody(stdin, opts.data)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(method, target, body)
+	if err != nil {
+		return err
+	}
+
+	if err := setHeaders(req, opts.headers); err != nil {
+		return err
+	}
+
+	if body != nil && req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := drapi.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	fmt.Fprintln(stderr, resp.Proto, resp.Status)
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errs.NewNetworkError("", err)
+	}
+
+	if err := writeBody(stdout, content, opts.raw); err != nil {
+		return err
+	}
+
+	return statusError(resp)
+}
+
+// requestURL resolves path against the configured endpoint. Paths that
+// start with /api/ are used as given; any other path is an API route such
+// as projects/. A full URL must point at the configured host, so the token
+// is never sent anywhere else.
+func requestURL(path string, query []string) (string, error) {
+	var (
+		target string
+		err    error
+	)
+
+	base := config.GetBaseURL()
+
+	switch {
+	case strings.Contains(path, "://"):
+		target = path
+
+		if base == "" || !sameHost(base, path) {
+			return "", fmt.Errorf("URL %q is not on the configured DataRobot host; pass a path such as /api/v2/projects/ instead.", path)
+		}
+	case strings.HasPrefix(path, "/api/"):
+		if base == "" {
+			return "", errors.New("Empty URL.")
+		}
+
+		target = base + path
+	default:
+		target, err = config.GetAPIURL(path)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if len(query) == 0 {
+		return target, nil
+	}
+
+	parsed, err := url.Parse(target)
+	if err != nil {
+		return "", err
+	}
+
+	values := parsed.Query()
+
+	for _, param := range query {
+		key, value, ok := strings.Cut(param, "=")
+		if !ok || key == "" {
+			return "", fmt.Errorf("Invalid query parameter %q (expected KEY=VALUE).", param)
+		}
+
+		values.Add(key, value)
+	}
+
+	parsed.RawQuery = values.Encode()
+
+	return parsed.String(), nil
+}
+
+func sameHost(base, target string) bool {
+	baseURL, err := url.Parse(base)
+	if err != nil {
+		return false
+	}
+
+	targetURL, err := url.Parse(target)
+	if err != nil {
+		return false
+	}
+
+	return strings.EqualFold(baseURL.Host, targetURL.Host) && baseURL.Scheme == targetURL.Scheme
+}
+
+// requestBody returns the body given with --data: the text itself,
+// @file to read a file, or @- to read stdin. No data means no body.
+func requestBody(stdin io.Reader, data string) (io.Reader, error) {
+	switch {
+	case data == "":
+		return nil, nil
+	case data == "@-":
+		content, err := io.ReadAll(stdin)
+		if err != nil {
+			return nil, err
+		}
+
+		return bytes.NewReader(content), nil
+	case strings.HasPrefix(data, "@"):
+		content, err := os.ReadFile(data[1:])
+		if err != nil {
+			return nil, err
+		}
+
+		return bytes.NewReader(content), nil
+	default:
+		return strings.NewReader(data), nil
+	}
+}
+
+func setHeaders(req *http.Request, headers []string) error {
+	for _, header := range headers {
+		key, value, ok := strings.Cut(header, ":")
+		key = strings.TrimSpace(key)
+
+		if !ok || key == "" {
+			return fmt.Errorf("Invalid header %q (expected \"Name: value\").", header)
+		}
+
+		req.Header.Add(key, strings.TrimSpace(value))
+	}
+
+	return nil
+}
+
+// writeBody writes the response body, indenting it when it is JSON and
+// raw is not set
+func writeBody(w io.Writer, content []byte, raw bool) error {
+	if len(content) == 0 {
+		return nil
+	}
+
+	if !raw && json.Valid(content) {
+		var indented bytes.Buffer
+
+		if err := json.Indent(&indented, content, "", "  "); err == nil {
+			indented.WriteByte('\n')
+			content = indented.Bytes()
+		}
+	}
+
+	_, err := w.Write(content)
+
+	return err
+}
+
+func statusError(resp *http.Response) error {
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	msg := "Response status code is " + resp.Status + "."
+
+	if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+		return errs.NewAuthError(msg, nil)
+	}
+
+	return errs.NewNetworkError(msg, nil)
+}
+
+func Cmd() *cobra.Command {
+	var opts options
+
+	cmd := &cobra.Command{
+		Use:     "api METHOD PATH",
+		GroupID: "advanced",
+		Short:   "🔌 Make an authenticated request to the DataRobot API",
+		Long: `Send a request to any DataRobot API endpoint using your current login,
+endpoint, proxy, and TLS settings.
+
+PATH is either a full API path such as /api/v2/projects/ or a route under
+/api/v2 such as projects/. JSON responses are pretty-printed; use --raw to
+print the body exactly as received. The response status is written to
+stderr, and a status outside 2xx makes the command fail after printing the
+body.
+
+--data sends a request body: the text itself, @file to read a file, or @-
+to read stdin. The body is sent as JSON unless a Content-Type header is
+given.`,
+		Example: `  dr api GET /api/v2/projects/
+  dr api GET deployments/ --query limit=5
+  dr api POST projects/ -d '{"projectName": "Churn"}'
+  dr api PATCH deployments/ID/ -d @changes.json -H 'X-Request-Id: 42'`,
+		Args:    cobra.ExactArgs(2),
+		PreRunE: auth.EnsureAuthenticatedE,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Run(cmd.InOrStdin(), cmd.OutOrStdout(), cmd.ErrOrStderr(), args[0], args[1], opts)
+		},
+		ValidArgsFunction: func(_ *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+			if len(args) == 0 {
+				return methods, cobra.ShellCompDirectiveNoFileComp
+			}
+
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.raw, "raw", false, "Print the response body verbatim instead of pretty-printing JSON")
+	cmd.Flags().StringVarP(&opts.data, "data", "d", "", "Request body, @file to read a file, or @- to read stdin")
+	cmd.Flags().StringArrayVarP(&opts.headers, "header", "H", nil, "Extra request header as \"Name: value\" (repeatable)")
+	cmd.Flags().StringArrayVar(&opts.query, "query", nil, "Query parameter as KEY=VALUE (repeatable)")
+
+	return cmd
+}
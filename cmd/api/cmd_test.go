@@ -0,0 +1,155 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package api
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/errs"
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+type request struct {
+	method string
+	uri    string
+	header http.Header
+	body   string
+}
+
+// setupServer points the CLI at a stub server that answers /version/ and
+// records every other request
+func setupServer(t *testing.T, status int, body string) *[]request {
+	t.Helper()
+
+	keyring.MockInit()
+	testutil.SetTestHomeDir(t, t.TempDir())
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	var requests []request
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/version/" {
+			return
+		}
+
+		content, _ := io.ReadAll(r.Body)
+
+		requests = append(requests, request{method: r.Method, uri: r.URL.RequestURI(), header: r.Header, body: string(content)})
+
+		w.WriteHeader(status)
+		_, _ = io.WriteString(w, body)
+	}))
+	t.Cleanup(server.Close)
+
+	viper.Set(config.DataRobotURL, server.URL)
+	viper.Set(config.DataRobotAPIKey, "test-token")
+	viper.Set(apiclient.MaxRetriesKey, 0)
+
+	return &requests
+}
+
+func TestRunPrettyPrintsJSON(t *testing.T) {
+	requests := setupServer(t, http.StatusOK, `{"data":[{"id":"p1"}]}`)
+
+	var stdout, stderr bytes.Buffer
+
+	err := Run(nil, &stdout, &stderr, "get", "/api/v2/projects/", options{query: []string{"limit=5"}})
+	require.NoError(t, err)
+
+	assert.Equal(t, "{\n  \"data\": [\n    {\n      \"id\": \"p1\"\n    }\n  ]\n}\n", stdout.String())
+	assert.Equal(t, "HTTP/1.1 200 OK\n", stderr.String())
+
+	require.Len(t, *requests, 1)
+	assert.Equal(t, http.MethodGet, (*requests)[0].method)
+	assert.Equal(t, "/api/v2/projects/?limit=5", (*requests)[0].uri)
+	assert.Equal(t, "Bearer test-token", (*requests)[0].header.Get("Authorization"))
+}
+
+func TestRunRawAndRoute(t *testing.T) {
+	requests := setupServer(t, http.StatusOK, `{"id":"p1"}`)
+
+	var stdout, stderr bytes.Buffer
+
+	err := Run(nil, &stdout, &stderr, "GET", "projects/p1/", options{raw: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, `{"id":"p1"}`, stdout.String())
+	assert.Equal(t, "/api/v2/projects/p1/", (*requests)[0].uri)
+}
+
+func TestRunSendsDataAndHeaders(t *testing.T) {
+	requests := setupServer(t, http.StatusCreated, "")
+
+	var stdout, stderr bytes.Buffer
+
+	stdin := strings.NewReader(`{"projectName": "Churn"}`)
+	opts := options{data: "@-", headers: []string{"X-Request-Id: 42"}}
+
+	err := Run(stdin, &stdout, &stderr, "POST", "projects/", opts)
+	require.NoError(t, err)
+
+	require.Len(t, *requests, 1)
+	assert.Equal(t, `{"projectName": "Churn"}`, (*requests)[0].body)
+	assert.Equal(t, "application/json", (*requests)[0].header.Get("Content-Type"))
+	assert.Equal(t, "42", (*requests)[0].header.Get("X-Request-Id"))
+	assert.Empty(t, stdout.String())
+	assert.Equal(t, "HTTP/1.1 201 Created\n", stderr.String())
+}
+
+func TestRunFailsOnErrorStatusAfterPrintingBody(t *testing.T) {
+	setupServer(t, http.StatusNotFound, `{"message":"Not found"}`)
+
+	var stdout, stderr bytes.Buffer
+
+	err := Run(nil, &stdout, &stderr, "GET", "projects/missing/", options{})
+	require.Error(t, err)
+
+	assert.Equal(t, errs.ExitCodeNetwork, errs.ExitCode(err))
+	assert.Contains(t, stdout.String(), `"message": "Not found"`)
+	assert.Equal(t, "HTTP/1.1 404 Not Found\n", stderr.String())
+}
+
+func TestRunRejectsBadInput(t *testing.T) {
+	requests := setupServer(t, http.StatusOK, "")
+
+	var stdout, stderr bytes.Buffer
+
+	err := Run(nil, &stdout, &stderr, "FETCH", "projects/", options{})
+	require.ErrorContains(t, err, `Unknown method "FETCH"`)
+
+	err = Run(nil, &stdout, &stderr, "GET", "https://example.com/api/v2/projects/", options{})
+	require.ErrorContains(t, err, "is not on the configured DataRobot host")
+
+	err = Run(nil, &stdout, &stderr, "GET", "projects/", options{headers: []string{"X-Broken"}})
+	require.EqualError(t, err, `Invalid header "X-Broken" (expected "Name: value").`)
+
+	err = Run(nil, &stdout, &stderr, "GET", "projects/", options{query: []string{"limit"}})
+	require.EqualError(t, err, `Invalid query parameter "limit" (expected KEY=VALUE).`)
+
+	assert.Empty(t, *requests)
+}
@@ -18,25 +18,30 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"github.com/datarobot/cli/internal/apiclient"
 	"github.com/datarobot/cli/internal/auth"
 	"github.com/datarobot/cli/internal/config"
 	"github.com/datarobot/cli/internal/envbuilder"
+	"github.com/datarobot/cli/internal/errs"
 	"github.com/datarobot/cli/internal/repo"
 	"github.com/datarobot/cli/tui"
 	"github.com/spf13/cobra"
 )
 
-func checkCLICredentials() bool {
+// checkCLICredentials reports valid credentials to out, and what is wrong
+// with them, and how to fix it, to diag
+func checkCLICredentials(out, diag io.Writer) bool {
 	allValid := true
 
 	// Check environment variables first (same pattern as EnsureAuthenticated)
 	creds, err := auth.VerifyEnvCredentials()
 	if err == nil {
-		fmt.Println(tui.BaseTextStyle.Render("✅ Environment variable authentication is valid."))
+		fmt.Fprintln(out, tui.BaseTextStyle.Render("✅ Environment variable authentication is valid."))
 
 		return true
 	}
@@ -46,16 +51,16 @@ func checkCLICredentials() bool {
 		if errors.Is(err, context.DeadlineExceeded) {
 			envDatarobotHost, _ := config.SchemeHostOnly(creds.Endpoint)
 
-			fmt.Print(tui.BaseTextStyle.Render("❌ Connection to "))
-			fmt.Print(tui.InfoStyle.Render(envDatarobotHost))
-			fmt.Println(tui.BaseTextStyle.Render(" timed out. Check your network and try again."))
+			fmt.Fprint(diag, tui.BaseTextStyle.Render("❌ Connection to "))
+			fmt.Fprint(diag, tui.InfoStyle.Render(envDatarobotHost))
+			fmt.Fprintln(diag, tui.BaseTextStyle.Render(" timed out. Check your network and try again."))
 
 			return false
 		}
 
-		fmt.Println(tui.BaseTextStyle.Render("❌ DATAROBOT_API_TOKEN environment variable is invalid or expired."))
-		fmt.Println(tui.BaseTextStyle.Render("Unset it and try again:"))
-		auth.PrintUnsetTokenInstructions()
+		fmt.Fprintln(diag, tui.BaseTextStyle.Render("❌ DATAROBOT_API_TOKEN environment variable is invalid or expired."))
+		fmt.Fprintln(diag, tui.BaseTextStyle.Render("Unset it and try again:"))
+		auth.PrintUnsetTokenInstructions(diag)
 
 		return false
 	}
@@ -63,10 +68,10 @@ func checkCLICredentials() bool {
 	// Fall back to config file credentials
 	datarobotHost := config.GetBaseURL()
 	if datarobotHost == "" {
-		fmt.Println(tui.BaseTextStyle.Render("❌ No DataRobot URL configured."))
-		fmt.Print(tui.BaseTextStyle.Render("Run "))
-		fmt.Print(tui.InfoStyle.Render("dr auth set-url"))
-		fmt.Println(tui.BaseTextStyle.Render(" to configure your DataRobot URL."))
+		fmt.Fprintln(diag, tui.BaseTextStyle.Render("❌ No DataRobot URL configured."))
+		fmt.Fprint(diag, tui.BaseTextStyle.Render("Run "))
+		fmt.Fprint(diag, tui.InfoStyle.Render("dr auth set-url"))
+		fmt.Fprintln(diag, tui.BaseTextStyle.Render(" to configure your DataRobot URL."))
 
 		allValid = false
 	}
@@ -74,49 +79,49 @@ func checkCLICredentials() bool {
 	_, err = config.GetAPIKey()
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
-			fmt.Print(tui.BaseTextStyle.Render("❌ Connection to "))
-			fmt.Print(tui.InfoStyle.Render(datarobotHost))
-			fmt.Println(tui.BaseTextStyle.Render(" timed out. Check your network and try again."))
+			fmt.Fprint(diag, tui.BaseTextStyle.Render("❌ Connection to "))
+			fmt.Fprint(diag, tui.InfoStyle.Render(datarobotHost))
+			fmt.Fprintln(diag, tui.BaseTextStyle.Render(" timed out. Check your network and try again."))
 		} else {
-			fmt.Println(tui.BaseTextStyle.Render("❌ No valid API key found in CLI config."))
-			fmt.Print(tui.BaseTextStyle.Render("Run "))
-			fmt.Print(tui.InfoStyle.Render("dr auth login"))
-			fmt.Println(tui.BaseTextStyle.Render(" to authenticate."))
+			fmt.Fprintln(diag, tui.BaseTextStyle.Render("❌ No valid API key found in CLI config."))
+			fmt.Fprint(diag, tui.BaseTextStyle.Render("Run "))
+			fmt.Fprint(diag, tui.InfoStyle.Render("dr auth login"))
+			fmt.Fprintln(diag, tui.BaseTextStyle.Render(" to authenticate."))
 		}
 
 		allValid = false
 	} else {
-		fmt.Println(tui.BaseTextStyle.Render("✅ CLI authentication is valid."))
+		fmt.Fprintln(out, tui.BaseTextStyle.Render("✅ CLI authentication is valid."))
 	}
 
 	return allValid
 }
 
-func printDotenvMissingError() {
-	fmt.Println(tui.BaseTextStyle.Render("⚠️ No '.env' file found in repository."))
-	fmt.Print(tui.BaseTextStyle.Render("Run "))
-	fmt.Print(tui.InfoStyle.Render("dr start"))
-	fmt.Print(tui.BaseTextStyle.Render(" or "))
-	fmt.Print(tui.InfoStyle.Render("dr dotenv setup"))
-	fmt.Println(tui.BaseTextStyle.Render(" to create one."))
+func printDotenvMissingError(w io.Writer) {
+	fmt.Fprintln(w, tui.BaseTextStyle.Render("⚠️ No '.env' file found in repository."))
+	fmt.Fprint(w, tui.BaseTextStyle.Render("Run "))
+	fmt.Fprint(w, tui.InfoStyle.Render("dr start"))
+	fmt.Fprint(w, tui.BaseTextStyle.Render(" or "))
+	fmt.Fprint(w, tui.InfoStyle.Render("dr dotenv setup"))
+	fmt.Fprintln(w, tui.BaseTextStyle.Render(" to create one."))
 }
 
-func printDotenvReadError() {
-	fmt.Println(tui.BaseTextStyle.Render("❌ Failed to read '.env' file."))
-	fmt.Print(tui.BaseTextStyle.Render("Run "))
-	fmt.Print(tui.InfoStyle.Render("dr start"))
-	fmt.Print(tui.BaseTextStyle.Render(" or "))
-	fmt.Print(tui.InfoStyle.Render("dr dotenv setup"))
-	fmt.Println(tui.BaseTextStyle.Render(" to create one."))
+func printDotenvReadError(w io.Writer) {
+	fmt.Fprintln(w, tui.BaseTextStyle.Render("❌ Failed to read '.env' file."))
+	fmt.Fprint(w, tui.BaseTextStyle.Render("Run "))
+	fmt.Fprint(w, tui.InfoStyle.Render("dr start"))
+	fmt.Fprint(w, tui.BaseTextStyle.Render(" or "))
+	fmt.Fprint(w, tui.InfoStyle.Render("dr dotenv setup"))
+	fmt.Fprintln(w, tui.BaseTextStyle.Render(" to create one."))
 }
 
-func printMissingEnvVarError(varName string) {
-	fmt.Println(tui.BaseTextStyle.Render(fmt.Sprintf("⚠️ No %s found in '.env'.", varName)))
-	fmt.Print(tui.BaseTextStyle.Render("Run "))
-	fmt.Print(tui.InfoStyle.Render("dr start"))
-	fmt.Print(tui.BaseTextStyle.Render(" or "))
-	fmt.Print(tui.InfoStyle.Render("dr dotenv setup"))
-	fmt.Println(tui.BaseTextStyle.Render(" to configure the '.env' file."))
+func printMissingEnvVarError(w io.Writer, varName string) {
+	fmt.Fprintln(w, tui.BaseTextStyle.Render(fmt.Sprintf("⚠️ No %s found in '.env'.", varName)))
+	fmt.Fprint(w, tui.BaseTextStyle.Render("Run "))
+	fmt.Fprint(w, tui.InfoStyle.Render("dr start"))
+	fmt.Fprint(w, tui.BaseTextStyle.Render(" or "))
+	fmt.Fprint(w, tui.InfoStyle.Render("dr dotenv setup"))
+	fmt.Fprintln(w, tui.BaseTextStyle.Render(" to configure the '.env' file."))
 }
 
 func extractDotenvVars(dotenvPath string) (string, string, error) {
@@ -148,13 +153,13 @@ func extractDotenvVars(dotenvPath string) (string, string, error) {
 	return dotenvToken, dotenvEndpoint, nil
 }
 
-func verifyDotenvToken(dotenvEndpoint, dotenvToken string) bool {
-	dotenvBaseURL, err := config.SchemeHostOnly(dotenvEndpoint)
+func verifyDotenvToken(w io.Writer, dotenvEndpoint, dotenvToken string) bool {
+	dotenvBaseURL, err := apiclient.NormalizeEndpoint(dotenvEndpoint)
 	if err != nil {
-		fmt.Println(tui.BaseTextStyle.Render("❌ Invalid DATAROBOT_ENDPOINT in '.env'."))
-		fmt.Print(tui.BaseTextStyle.Render("Run "))
-		fmt.Print(tui.InfoStyle.Render("dr dotenv update"))
-		fmt.Println(tui.BaseTextStyle.Render(" to fix the configuration."))
+		fmt.Fprintln(w, tui.BaseTextStyle.Render("❌ Invalid DATAROBOT_ENDPOINT in '.env'."))
+		fmt.Fprint(w, tui.BaseTextStyle.Render("Run "))
+		fmt.Fprint(w, tui.InfoStyle.Render("dr dotenv update"))
+		fmt.Fprintln(w, tui.BaseTextStyle.Render(" to fix the configuration."))
 
 		return false
 	}
@@ -162,14 +167,14 @@ func verifyDotenvToken(dotenvEndpoint, dotenvToken string) bool {
 	err = config.VerifyToken(dotenvEndpoint, dotenvToken)
 	if err != nil {
 		if errors.Is(err, context.DeadlineExceeded) {
-			fmt.Print(tui.BaseTextStyle.Render("❌ Connection to "))
-			fmt.Print(tui.InfoStyle.Render(dotenvBaseURL))
-			fmt.Println(tui.BaseTextStyle.Render(" timed out. Check your network and try again."))
+			fmt.Fprint(w, tui.BaseTextStyle.Render("❌ Connection to "))
+			fmt.Fprint(w, tui.InfoStyle.Render(dotenvBaseURL))
+			fmt.Fprintln(w, tui.BaseTextStyle.Render(" timed out. Check your network and try again."))
 		} else {
-			fmt.Println(tui.BaseTextStyle.Render("❌ DATAROBOT_API_TOKEN in '.env' is invalid or expired."))
-			fmt.Print(tui.BaseTextStyle.Render("Run "))
-			fmt.Print(tui.InfoStyle.Render("dr dotenv update"))
-			fmt.Println(tui.BaseTextStyle.Render(" to refresh credentials."))
+			fmt.Fprintln(w, tui.BaseTextStyle.Render("❌ DATAROBOT_API_TOKEN in '.env' is invalid or expired."))
+			fmt.Fprint(w, tui.BaseTextStyle.Render("Run "))
+			fmt.Fprint(w, tui.InfoStyle.Render("dr dotenv update"))
+			fmt.Fprintln(w, tui.BaseTextStyle.Render(" to refresh credentials."))
 		}
 
 		return false
@@ -178,65 +183,69 @@ func verifyDotenvToken(dotenvEndpoint, dotenvToken string) bool {
 	return true
 }
 
-func checkDotenvCredentials(repoRoot string) bool {
+// checkDotenvCredentials reports valid '.env' credentials to out, and
+// problems to diag
+func checkDotenvCredentials(out, diag io.Writer, repoRoot string) bool {
 	dotenvPath := filepath.Join(repoRoot, ".env")
 
 	_, statErr := os.Stat(dotenvPath)
 	if statErr != nil {
-		printDotenvMissingError()
+		printDotenvMissingError(diag)
 
 		return false
 	}
 
 	dotenvToken, dotenvEndpoint, err := extractDotenvVars(dotenvPath)
 	if err != nil {
-		printDotenvReadError()
+		printDotenvReadError(diag)
 
 		return false
 	}
 
 	if dotenvToken == "" {
-		printMissingEnvVarError("DATAROBOT_API_TOKEN")
+		printMissingEnvVarError(diag, "DATAROBOT_API_TOKEN")
 
 		return false
 	}
 
 	if dotenvEndpoint == "" {
-		printMissingEnvVarError("DATAROBOT_ENDPOINT")
+		printMissingEnvVarError(diag, "DATAROBOT_ENDPOINT")
 
 		return false
 	}
 
-	if !verifyDotenvToken(dotenvEndpoint, dotenvToken) {
+	if !verifyDotenvToken(diag, dotenvEndpoint, dotenvToken) {
 		return false
 	}
 
-	fmt.Println(tui.BaseTextStyle.Render("✅ '.env' credentials are valid."))
+	fmt.Fprintln(out, tui.BaseTextStyle.Render("✅ '.env' credentials are valid."))
 
 	return true
 }
 
-func Run(_ *cobra.Command, _ []string) {
+func Run(cmd *cobra.Command, _ []string) {
+	out, diag := cmd.OutOrStdout(), cmd.ErrOrStderr()
+
 	// Check .env credentials if in a repo
 	// If not, check the CLI credentials only
 	repoRoot, err := repo.FindRepoRoot()
 	if err != nil {
-		if checkCLICredentials() {
+		if checkCLICredentials(out, diag) {
 			return
 		}
 
-		os.Exit(1)
+		os.Exit(errs.ExitCodeAuth)
 	}
 
-	if checkDotenvCredentials(repoRoot) {
+	if checkDotenvCredentials(out, diag, repoRoot) {
 		return
 	}
 
-	if checkCLICredentials() {
+	if checkCLICredentials(out, diag) {
 		return
 	}
 
-	os.Exit(1)
+	os.Exit(errs.ExitCodeAuth)
 }
 
 func Cmd() *cobra.Command {
@@ -246,6 +255,8 @@ func Cmd() *cobra.Command {
 		Long: `Verify that your DataRobot credentials are properly configured and valid.
 
 If you're in a project directory with a '.env' file, this will check those credentials.`,
+		Example: `  dr auth check
+  dr auth check --quiet || dr auth login`,
 		Run: Run,
 	}
 }
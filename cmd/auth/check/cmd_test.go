@@ -0,0 +1,72 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package check
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datarobot/cli/tui"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckDotenvCredentialsValidOnlyOnStdout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/version/" || r.Header.Get("Authorization") != "Bearer good-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+	}))
+	defer server.Close()
+
+	repoRoot := t.TempDir()
+	dotenv := "DATAROBOT_ENDPOINT=" + server.URL + "/api/v2\nDATAROBOT_API_TOKEN=good-token\n"
+	require.NoError(t, os.WriteFile(filepath.Join(repoRoot, ".env"), []byte(dotenv), 0o600))
+
+	var out, diag bytes.Buffer
+
+	assert.True(t, checkDotenvCredentials(&out, &diag, repoRoot))
+	assert.Equal(t, tui.BaseTextStyle.Render("✅ '.env' credentials are valid.")+"\n", out.String())
+	assert.Empty(t, diag.String())
+}
+
+func TestCheckDotenvCredentialsProblemsOnStderr(t *testing.T) {
+	var out, diag bytes.Buffer
+
+	assert.False(t, checkDotenvCredentials(&out, &diag, t.TempDir()))
+	assert.Empty(t, out.String())
+	assert.Contains(t, diag.String(), "No '.env' file found in repository.")
+}
+
+func TestCheckDotenvCredentialsInvalidTokenOnStderr(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	repoRoot := t.TempDir()
+	dotenv := "DATAROBOT_ENDPOINT=" + server.URL + "/api/v2\nDATAROBOT_API_TOKEN=bad-token\n"
+	require.NoError(t, os.WriteFile(filepath.Join(repoRoot, ".env"), []byte(dotenv), 0o600))
+
+	var out, diag bytes.Buffer
+
+	assert.False(t, checkDotenvCredentials(&out, &diag, repoRoot))
+	assert.Empty(t, out.String())
+	assert.Contains(t, diag.String(), "DATAROBOT_API_TOKEN in '.env' is invalid or expired.")
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/datarobot/cli/cmd/auth/login"
 	"github.com/datarobot/cli/cmd/auth/logout"
 	"github.com/datarobot/cli/cmd/auth/seturl"
+	"github.com/datarobot/cli/cmd/auth/status"
 	"github.com/datarobot/cli/internal/version"
 	"github.com/spf13/cobra"
 )
@@ -43,6 +44,7 @@ Manage your DataRobot credentials and connection settings:
 		login.Cmd(),
 		logout.Cmd(),
 		seturl.Cmd(),
+		status.Cmd(),
 	)
 
 	return cmd
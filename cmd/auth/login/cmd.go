@@ -17,12 +17,15 @@ package login
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os"
 	"strings"
 
 	"github.com/datarobot/cli/internal/auth"
 	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/errs"
 	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/tui"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
@@ -43,25 +46,43 @@ func RunE(cmd *cobra.Command, args []string) error { //nolint: cyclop
 		url = args[0]
 	}
 
+	if endpoint, _ := cmd.Flags().GetString("endpoint"); endpoint != "" {
+		url = endpoint
+	}
+
 	if url != "" {
 		err := config.SaveURLToConfig(url)
 		if err != nil {
-			log.Error(err.Error())
+			cmd.SilenceUsage = true
+
+			return err
 		}
 	}
 
 	datarobotHost := auth.GetBaseURLOrAsk()
 	if datarobotHost == "" {
 		log.Info("💡 To set your DataRobot URL, run 'dr auth set-url'.")
-		os.Exit(1)
+		os.Exit(errs.ExitCodeConfig)
 
 		return nil
 	}
 
+	if withToken, _ := cmd.Flags().GetBool("with-token"); withToken {
+		cmd.SilenceUsage = true
+
+		return loginWithToken(cmd)
+	}
+
+	if useOAuth, _ := cmd.Flags().GetBool("oauth"); useOAuth {
+		cmd.SilenceUsage = true
+
+		return loginWithDeviceFlow(cmd)
+	}
+
 	token, err := config.GetAPIKey()
 	if errors.Is(err, context.DeadlineExceeded) {
 		log.Errorf("Connection to %s timed out. Check your network and try again.", datarobotHost)
-		os.Exit(1)
+		os.Exit(errs.ExitCodeNetwork)
 
 		return nil
 	}
@@ -78,7 +99,7 @@ func RunE(cmd *cobra.Command, args []string) error { //nolint: cyclop
 	// Clear existing token and get new one
 	viper.Set(config.DataRobotAPIKey, "")
 
-	key, err := auth.WaitForAPIKeyCallback(cmd.Context(), datarobotHost)
+	key, err := auth.APIKeyCallbackFunc(cmd.Context(), datarobotHost)
 	if err != nil {
 		log.Error(err)
 
@@ -105,8 +126,50 @@ func RunE(cmd *cobra.Command, args []string) error { //nolint: cyclop
 	return nil
 }
 
+func loginWithToken(cmd *cobra.Command) error {
+	token, err := auth.ReadToken(os.Stdin, cmd.ErrOrStderr())
+	if err != nil {
+		return err
+	}
+
+	info, location, err := auth.LoginWithToken(token)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), tui.SuccessStyle.Render("✅ Logged in to "+config.GetBaseURL()+" as "+info.Username+"."))
+	fmt.Fprintln(cmd.OutOrStdout(), tui.DimStyle.Render("Token stored in "+describeLocation(location)+"."))
+
+	return nil
+}
+
+func loginWithDeviceFlow(cmd *cobra.Command) error {
+	info, location, err := auth.LoginWithDeviceFlow(cmd.Context(), cmd.ErrOrStderr())
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), tui.SuccessStyle.Render("✅ Logged in to "+config.GetBaseURL()+" as "+info.Username+"."))
+	fmt.Fprintln(cmd.OutOrStdout(), tui.DimStyle.Render("Token stored in "+describeLocation(location)+"."))
+
+	return nil
+}
+
+func describeLocation(location config.TokenLocation) string {
+	if location == config.TokenLocationKeyring {
+		return "the OS keyring"
+	}
+
+	dir, err := config.TokensDir()
+	if err != nil {
+		return "a token file"
+	}
+
+	return dir
+}
+
 func Cmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "login [url]",
 		Short: "🔐 Log in to DataRobot using OAuth authentication.",
 		Long: `Log in to DataRobot using OAuth authentication in your browser.
@@ -114,7 +177,27 @@ func Cmd() *cobra.Command {
 This command will:
   1. Open your default browser.
   2. Redirect you to the DataRobot login page.
-  3. Securely store your API key for future CLI operations.`,
+  3. Securely store your API key for future CLI operations.
+
+With --with-token, the CLI instead prompts for an API token (input is masked),
+checks that the pasted token is complete, validates it, and stores it in the OS keyring, falling back to a file readable
+only by you. The token can also be piped in: 'echo $TOKEN | dr auth login --with-token'.
+
+With --oauth, the CLI uses the OAuth device authorization flow for SSO users:
+it displays a URL and a one-time code to approve in any browser, then stores
+the resulting access and refresh tokens. Expired access tokens are refreshed
+automatically.`,
+		Example: `  dr auth login
+  dr auth login https://app.eu.datarobot.com
+  dr auth login --endpoint https://datarobot.example.com --oauth
+  dr auth login --with-token`,
 		RunE: RunE,
 	}
+
+	cmd.Flags().Bool("with-token", false, "Prompt for an API token instead of logging in through the browser")
+	cmd.Flags().Bool("oauth", false, "Log in with the OAuth device authorization flow")
+	cmd.Flags().String("endpoint", "", "DataRobot URL to log in to, for self-managed installations")
+	cmd.MarkFlagsMutuallyExclusive("with-token", "oauth")
+
+	return cmd
 }
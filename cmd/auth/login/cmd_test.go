@@ -0,0 +1,71 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package login
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+func TestBrowserLoginReplacesStoredToken(t *testing.T) {
+	keyring.MockInit()
+	t.Cleanup(keyring.MockInit)
+	testutil.SetTestHomeDir(t, t.TempDir())
+	t.Setenv("DATAROBOT_ENDPOINT", "")
+	t.Setenv("DATAROBOT_API_TOKEN", "")
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	t.Cleanup(server.Close)
+
+	viper.Set(config.DataRobotURL, server.URL+"/api/v2")
+	require.NoError(t, config.CreateConfigFileDirIfNotExists())
+
+	// As left behind by 'dr auth login --with-token'
+	_, err := config.StoreToken("old-token")
+	require.NoError(t, err)
+
+	originalCallback := auth.APIKeyCallbackFunc
+	t.Cleanup(func() { auth.APIKeyCallbackFunc = originalCallback })
+
+	auth.APIKeyCallbackFunc = func(context.Context, string) (string, error) {
+		return "new-token\n", nil
+	}
+
+	cmd := Cmd()
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	cmd.SetArgs(nil)
+	require.NoError(t, cmd.Execute())
+
+	token, _, err := config.ResolveToken()
+	require.NoError(t, err)
+	assert.Equal(t, "new-token", token)
+
+	_, _, err = config.LoadStoredToken()
+	assert.ErrorIs(t, err, config.ErrNoStoredToken)
+}
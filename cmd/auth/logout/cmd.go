@@ -15,30 +15,50 @@
 package logout
 
 import (
-	"os"
+	"fmt"
+	"strings"
 
 	"github.com/datarobot/cli/internal/auth"
-	"github.com/datarobot/cli/internal/config"
-	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/tui"
 	"github.com/spf13/cobra"
-	"github.com/spf13/viper"
 )
 
-func Run(_ *cobra.Command, _ []string) {
-	viper.Set(config.DataRobotAPIKey, "")
+func RunE(cmd *cobra.Command, _ []string) error {
+	cmd.SilenceUsage = true
 
-	err := auth.WriteConfigFile()
+	all, _ := cmd.Flags().GetBool("all")
+
+	cleared, err := auth.Logout(all)
 	if err != nil {
-		log.Error(err)
-		os.Exit(1)
+		return err
 	}
+
+	if len(cleared) == 0 {
+		fmt.Fprintln(cmd.OutOrStdout(), tui.BaseTextStyle.Render("ℹ️ No stored credentials found; nothing to clear."))
+
+		return nil
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), tui.SuccessStyle.Render("✅ Logged out. Cleared credentials from: "+strings.Join(cleared, ", ")+"."))
+
+	return nil
 }
 
 func Cmd() *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "logout",
 		Short: "Log out from DataRobot.",
-		Long:  `Log out from DataRobot and clear the stored API key.`,
-		Run:   Run,
+		Long: `Log out from DataRobot and clear the stored API key.
+
+The token for the active profile is removed from the config file, the OS
+keyring, and the fallback token file. Use --all to clear the tokens of every
+profile.`,
+		Example: `  dr auth logout
+  dr auth logout --all`,
+		RunE: RunE,
 	}
+
+	cmd.Flags().Bool("all", false, "Clear the stored tokens of all profiles")
+
+	return cmd
 }
@@ -15,6 +15,9 @@
 package seturl
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/datarobot/cli/internal/auth"
 	"github.com/datarobot/cli/internal/config"
 	"github.com/spf13/cobra"
@@ -27,12 +30,14 @@ func Cmd() *cobra.Command {
 		Long: `Configure your DataRobot environment URL with an interactive selection.
 
 This command helps you choose the correct DataRobot environment:
-  • US Cloud (most common): https://app.datarobot.com
-  • EU Cloud: https://app.eu.datarobot.com
-  • Japan Cloud: https://app.jp.datarobot.com
-  • Custom/On-Premise: Your organization's DataRobot URL
+` + regionList() + `  • Custom/On-Premise: Your organization's DataRobot URL
+
+The URL can also be given as an argument, either in full or as a region ID
+from 'dr endpoints list', such as "eu".
 
 💡 If you're unsure, check the URL you use to log in to DataRobot in your browser.`,
+		Example: `  dr auth set-url
+  dr auth set-url https://app.datarobot.com`,
 		Run: func(cmd *cobra.Command, args []string) {
 			var url string
 			if len(args) > 0 {
@@ -56,3 +61,19 @@ This command helps you choose the correct DataRobot environment:
 		},
 	}
 }
+
+// regionList describes the known regions for the help text
+func regionList() string {
+	var sb strings.Builder
+
+	for i, region := range config.Regions {
+		name := region.Name
+		if i == 0 {
+			name += " (most common)"
+		}
+
+		fmt.Fprintf(&sb, "  • %s: %s\n", name, region.URL)
+	}
+
+	return sb.String()
+}
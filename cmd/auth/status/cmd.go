@@ -0,0 +1,140 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// statusResult is the structured form of the authentication state
+type statusResult struct {
+	Endpoint     string `json:"endpoint"               yaml:"endpoint"`
+	TokenLoaded  bool   `json:"token_loaded"           yaml:"token_loaded"`
+	TokenSource  string `json:"token_source"           yaml:"token_source"`
+	SkipAuth     bool   `json:"skip_auth"              yaml:"skip_auth"`
+	Valid        bool   `json:"valid"                  yaml:"valid"`
+	Username     string `json:"username,omitempty"     yaml:"username,omitempty"`
+	Organization string `json:"organization,omitempty" yaml:"organization,omitempty"`
+}
+
+func printField(w io.Writer, label, value string) {
+	fmt.Fprintln(w, tui.BaseTextStyle.Render(fmt.Sprintf("%-13s", label+":"))+" "+tui.InfoStyle.Render(value))
+}
+
+func (r statusResult) printText(w io.Writer) error {
+	endpoint := r.Endpoint
+	if endpoint == "" {
+		endpoint = "(not set)"
+	}
+
+	tokenState := "not loaded"
+	if r.TokenLoaded {
+		tokenState = "loaded (" + r.TokenSource + ")"
+	}
+
+	printField(w, "Endpoint", endpoint)
+	printField(w, "Token", tokenState)
+	printField(w, "Skip auth", strconv.FormatBool(r.SkipAuth))
+
+	if r.Username != "" {
+		printField(w, "User", r.Username)
+	}
+
+	if r.Organization != "" {
+		printField(w, "Organization", r.Organization)
+	}
+
+	return nil
+}
+
+func RunE(cmd *cobra.Command, _ []string) error {
+	cmd.SilenceUsage = true
+
+	result, err := checkStatus()
+
+	if printErr := printer.Print(cmd.OutOrStdout(), result, result.printText); printErr != nil {
+		return printErr
+	}
+
+	return err
+}
+
+func checkStatus() (statusResult, error) {
+	creds, credsErr := auth.ResolveCredentials()
+
+	result := statusResult{
+		TokenLoaded: creds.Token != "",
+		TokenSource: string(creds.Source),
+		SkipAuth:    viper.GetBool("skip-auth"),
+	}
+
+	if credsErr != nil {
+		return result, credsErr
+	}
+
+	baseURL, err := apiclient.NormalizeEndpoint(creds.Endpoint)
+	if err == nil {
+		result.Endpoint = baseURL
+	}
+
+	if err != nil || creds.Token == "" {
+		return result, errors.New("No credentials found. Run 'dr auth login' to authenticate.")
+	}
+
+	info, err := config.FetchAccountInfo(baseURL, creds.Token)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return result, fmt.Errorf("Connection to %s timed out. Check your network and try again.", baseURL)
+		}
+
+		return result, fmt.Errorf("Token is invalid or expired: %w", err)
+	}
+
+	result.Valid = true
+	result.Username = info.Username
+	result.Organization = info.OrgName
+
+	return result, nil
+}
+
+func Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show the current authentication state.",
+		Long: `Show which credentials the CLI will use and confirm they still work.
+
+Reports whether an API token is loaded and where it came from (flag,
+token-file, keyring, file, env, or config), the configured DataRobot URL, and whether --skip-auth is
+enabled. A single authenticated request is made to display the user and
+organization the token belongs to.
+
+Exits with a non-zero status if no valid credentials are found.`,
+		Example: `  dr auth status
+  dr auth status --output json`,
+		RunE: RunE,
+	}
+}
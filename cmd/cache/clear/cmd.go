@@ -0,0 +1,179 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cacheclear
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/datarobot/cli/internal/cache"
+	"github.com/datarobot/cli/internal/misc/confirm"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	all         bool
+	templates   bool
+	updateCheck bool
+	sessions    bool
+}
+
+// kinds returns the kinds of cached data selected by the flags; none means
+// every kind
+func (o options) kinds() []string {
+	if o.all {
+		return nil
+	}
+
+	var kinds []string
+
+	if o.templates {
+		kinds = append(kinds, cache.Templates)
+	}
+
+	if o.updateCheck {
+		kinds = append(kinds, cache.UpdateCheck)
+	}
+
+	if o.sessions {
+		kinds = append(kinds, cache.Sessions)
+	}
+
+	return kinds
+}
+
+type result struct {
+	Removed []cache.Entry `json:"removed" yaml:"removed"`
+	Freed   int64         `json:"freed"   yaml:"freed"`
+}
+
+func Run(w io.Writer, opts options) error {
+	kinds := opts.kinds()
+
+	pending, err := selected(kinds)
+	if err != nil {
+		return err
+	}
+
+	if len(pending) == 0 {
+		return printer.Print(w, result{Removed: []cache.Entry{}}, func(w io.Writer) error {
+			_, err := fmt.Fprintln(w, "Nothing to clear.")
+
+			return err
+		})
+	}
+
+	var size int64
+	for _, entry := range pending {
+		size += entry.Size
+	}
+
+	confirmed, err := confirm.Ask(fmt.Sprintf("Remove %s of cached data (%s)?", cache.FormatSize(size), kindList(pending)))
+	if err != nil {
+		return err
+	}
+
+	if !confirmed {
+		fmt.Fprintln(w, tui.DimStyle.Render("Nothing was removed."))
+
+		return nil
+	}
+
+	removed, err := cache.Clear(kinds)
+	if err != nil {
+		return err
+	}
+
+	res := result{Removed: removed}
+	for _, entry := range removed {
+		res.Freed += entry.Size
+	}
+
+	return printer.Print(w, res, func(w io.Writer) error {
+		for _, entry := range removed {
+			fmt.Fprintln(w, tui.SuccessStyle.Render("✓ Removed "+entry.Kind)+tui.DimStyle.Render(" "+entry.Path))
+		}
+
+		_, err := fmt.Fprintf(w, "Freed %s.\n", cache.FormatSize(res.Freed))
+
+		return err
+	})
+}
+
+// selected returns the cached entries Clear would remove for kinds
+func selected(kinds []string) ([]cache.Entry, error) {
+	entries, err := cache.List()
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []cache.Entry
+
+	for _, entry := range entries {
+		if entry.Exists && (len(kinds) == 0 || slices.Contains(kinds, entry.Kind)) {
+			pending = append(pending, entry)
+		}
+	}
+
+	return pending, nil
+}
+
+func kindList(entries []cache.Entry) string {
+	kinds := make([]string, 0, len(entries))
+
+	for _, entry := range entries {
+		kinds = append(kinds, entry.Kind)
+	}
+
+	return strings.Join(kinds, ", ")
+}
+
+func Cmd() *cobra.Command {
+	var opts options
+
+	cmd := &cobra.Command{
+		Use:   "clear",
+		Short: "Remove cached data",
+		Long: `Remove cached data from the config directory.
+
+Without flags, or with --all, everything in the cache is removed. Select
+what to remove with --templates, --update-check, and --sessions, which can
+be combined. You are asked to confirm first unless --assume-yes (-y) is
+set.`,
+		Example: `  dr cache clear --templates
+  dr cache clear --all -y`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.SilenceUsage = true
+
+			return Run(cmd.OutOrStdout(), opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.all, "all", false, "Remove all cached data")
+	cmd.Flags().BoolVar(&opts.templates, "templates", false, "Remove the cached template catalog")
+	cmd.Flags().BoolVar(&opts.updateCheck, "update-check", false, "Remove the result of the last update check")
+	cmd.Flags().BoolVar(&opts.sessions, "sessions", false, "Remove the quickstart sessions saved for dr start --resume")
+
+	cmd.MarkFlagsMutuallyExclusive("all", "templates")
+	cmd.MarkFlagsMutuallyExclusive("all", "update-check")
+	cmd.MarkFlagsMutuallyExclusive("all", "sessions")
+
+	return cmd
+}
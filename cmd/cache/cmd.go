@@ -0,0 +1,43 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	cacheclear "github.com/datarobot/cli/cmd/cache/clear"
+	"github.com/datarobot/cli/cmd/cache/show"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "cache",
+		GroupID: "self",
+		Short:   "🗄️  Inspect and clear cached data",
+		Long: `Inspect and clear the data the CLI caches in the config directory.
+
+The cache holds the template catalog, the result of the last update check,
+the account shown by 'dr whoami', and quickstart sessions saved for
+'dr start --resume'. Clearing it is safe: each item is fetched or
+recreated when it is next needed. Clear it when a command shows stale
+results.`,
+	}
+
+	cmd.AddCommand(
+		show.Cmd(),
+		cacheclear.Cmd(),
+	)
+
+	return cmd
+}
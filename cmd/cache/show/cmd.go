@@ -0,0 +1,105 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package show
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/datarobot/cli/internal/cache"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+// now is stubbed in tests to fix the ages shown
+var now = time.Now
+
+var columns = []printer.Column[cache.Entry]{
+	{Field: "kind", Header: "KIND", Value: func(e cache.Entry) string { return e.Kind }},
+	{Field: "size", Header: "SIZE", Value: sizeText},
+	{Field: "modified_at", Header: "AGE", Value: ageText},
+	{Field: "path", Header: "PATH", Value: func(e cache.Entry) string { return e.Path }},
+}
+
+func sizeText(entry cache.Entry) string {
+	if !entry.Exists {
+		return "-"
+	}
+
+	if entry.Files > 1 {
+		return cache.FormatSize(entry.Size) + " (" + plural(entry.Files, "file") + ")"
+	}
+
+	return cache.FormatSize(entry.Size)
+}
+
+// ageText returns how long ago the entry was last written, in the largest
+// whole unit: minutes, hours, or days
+func ageText(entry cache.Entry) string {
+	if !entry.Exists {
+		return "-"
+	}
+
+	age := now().Sub(entry.ModifiedAt)
+
+	switch {
+	case age < time.Minute:
+		return "just now"
+	case age < time.Hour:
+		return fmt.Sprintf("%dm ago", int(age.Minutes()))
+	case age < 48*time.Hour:
+		return fmt.Sprintf("%dh ago", int(age.Hours()))
+	default:
+		return fmt.Sprintf("%dd ago", int(age.Hours()/24))
+	}
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return "1 " + noun
+	}
+
+	return strconv.Itoa(n) + " " + noun + "s"
+}
+
+func Run(w io.Writer) error {
+	entries, err := cache.List()
+	if err != nil {
+		return err
+	}
+
+	return printer.Print(w, entries, func(w io.Writer) error {
+		return printer.WriteTable(w, columns, entries)
+	})
+}
+
+func Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "show",
+		Short: "List cached data with its size and age",
+		Long: `List each kind of cached data with its size, how long ago it was
+written, and its path. Kinds with nothing cached show "-".`,
+		Example: `  dr cache show
+  dr cache show --output json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.SilenceUsage = true
+
+			return Run(cmd.OutOrStdout())
+		},
+	}
+}
@@ -148,8 +148,11 @@ func Cmd() *cobra.Command {
 	names := strings.Join(copier.EnabledShortNames, ", ")
 
 	cmd := &cobra.Command{
-		Use:     fmt.Sprintf("add [%s or component_url]", names),
-		Short:   "Add a component.",
+		Use:   fmt.Sprintf("add [%s or component_url]", names),
+		Short: "Add a component.",
+		Example: `  dr component add
+  dr component add https://github.com/datarobot-community/af-component-agent
+  dr component add https://github.com/datarobot-community/af-component-agent --data agent_name=support --data-file answers.yaml`,
 		PreRunE: PreRunE,
 		RunE:    RunE,
 	}
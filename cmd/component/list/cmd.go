@@ -15,37 +15,31 @@
 package list
 
 import (
-	"fmt"
-	"os"
-	"text/tabwriter"
-
 	"github.com/datarobot/cli/internal/copier"
+	"github.com/datarobot/cli/internal/printer"
 	"github.com/spf13/cobra"
 )
 
-func RunE(_ *cobra.Command, _ []string) error {
+func RunE(cmd *cobra.Command, _ []string) error {
 	answers, err := copier.AnswersFromPath(".", false)
 	if err != nil {
 		return err
 	}
 
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-
-	fmt.Fprintf(w, "Component name\tAnswers file\tRepository\n")
+	table := printer.Table{Headers: []string{"Component name", "Answers file", "Repository"}}
 
 	for _, answer := range answers {
-		fmt.Fprintf(w, "%s\t%s\t%s\n", answer.ComponentDetails.Name, answer.FileName, answer.Repo)
+		table.Rows = append(table.Rows, []string{answer.ComponentDetails.Name, answer.FileName, answer.Repo})
 	}
 
-	w.Flush()
-
-	return nil
+	return table.Write(cmd.OutOrStdout())
 }
 
 func Cmd() *cobra.Command {
 	return &cobra.Command{
-		Use:   "list",
-		Short: "List installed components.",
-		RunE:  RunE,
+		Use:     "list",
+		Short:   "List installed components.",
+		Example: `  dr component list`,
+		RunE:    RunE,
 	}
 }
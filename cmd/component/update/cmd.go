@@ -98,8 +98,11 @@ func RunE(cmd *cobra.Command, args []string) error {
 
 func Cmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "update [answers_file]",
-		Short:   "Update installed component.",
+		Use:   "update [answers_file]",
+		Short: "Update installed component.",
+		Example: `  dr component update
+  dr component update .datarobot/answers/agent-support.yml
+  dr component update .datarobot/answers/agent-support.yml --recopy --data agent_name=helpdesk`,
 		PreRunE: PreRunE,
 		RunE:    RunE,
 	}
@@ -0,0 +1,51 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package context
+
+import (
+	"github.com/datarobot/cli/cmd/context/current"
+	"github.com/datarobot/cli/cmd/context/list"
+	"github.com/datarobot/cli/cmd/context/use"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "context",
+		Aliases: []string{"contexts"},
+		GroupID: "self",
+		Short:   "🔀 Switch between DataRobot contexts",
+		Long: `Switch between contexts defined under the 'contexts' key of the config
+file. A context bundles an endpoint, its credentials, and a default
+template for 'dr start', so one command moves you between environments.
+
+Settings in the active context are layered over the active profile.
+Flags and environment variables still override them. Each context keeps
+its own token: 'dr auth login' with a context active stores the token
+under a name scoped to that context.
+
+The active context is selected with --context-name, the
+DATAROBOT_CLI_CONTEXT_NAME environment variable, or the current context
+stored in the config file by 'dr context use'.`,
+	}
+
+	cmd.AddCommand(
+		current.Cmd(),
+		list.Cmd(),
+		use.Cmd(),
+	)
+
+	return cmd
+}
@@ -0,0 +1,56 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package current
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "current",
+		Short:   "Show the active context",
+		Example: `  dr context current`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			var active *config.Context
+
+			for _, context := range config.Contexts() {
+				if context.Active {
+					active = &context
+
+					break
+				}
+			}
+
+			return printer.Print(cmd.OutOrStdout(), active, func(w io.Writer) error {
+				if active == nil {
+					_, err := fmt.Fprintln(w, "No context is active. Run 'dr context use NAME' to choose one.")
+
+					return err
+				}
+
+				_, err := fmt.Fprintln(w, active.Name)
+
+				return err
+			})
+		},
+	}
+}
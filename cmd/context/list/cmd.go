@@ -0,0 +1,60 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package list
+
+import (
+	"io"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+// columns are the table columns, in their default order
+var columns = []printer.Column[config.Context]{
+	{Field: "active", Header: "CURRENT", Value: func(c config.Context) string {
+		if c.Active {
+			return "*"
+		}
+
+		return ""
+	}},
+	{Field: "name", Header: "NAME", Value: func(c config.Context) string { return c.Name }},
+	{Field: "endpoint", Header: "ENDPOINT", Value: func(c config.Context) string { return c.Endpoint }},
+	{Field: "template", Header: "TEMPLATE", Value: func(c config.Context) string { return c.Template }},
+}
+
+func Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List the contexts defined in the config file",
+		Example: `  dr context list
+  dr context list --output json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			contexts := config.Contexts()
+
+			return printer.Print(cmd.OutOrStdout(), contexts, func(w io.Writer) error {
+				if len(contexts) == 0 {
+					_, err := io.WriteString(w, "No contexts defined in the config file.\n")
+
+					return err
+				}
+
+				return printer.WriteTable(w, columns, contexts)
+			})
+		},
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package use
+
+import (
+	"fmt"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "use NAME",
+		Short: "Set the current context",
+		Long: `Persist NAME as the current context in the config file.
+Use an empty string to clear the current context.`,
+		Example: `  dr context use staging`,
+		Args:    cobra.ExactArgs(1),
+		ValidArgsFunction: func(_ *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+
+			return config.ContextNames(), cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			if err := config.SetCurrentContext(args[0]); err != nil {
+				return err
+			}
+
+			if args[0] == "" {
+				fmt.Fprintln(cmd.OutOrStdout(), "Current context cleared.")
+			} else {
+				fmt.Fprintf(cmd.OutOrStdout(), "Switched to context %q.\n", args[0])
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,158 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/datarobot/cli/cmd/start"
+	"github.com/datarobot/cli/internal/errs"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// DefaultCommandKey chooses what running dr without a command does
+const DefaultCommandKey = "default-command"
+
+const (
+	defaultCommandHelp       = "help"
+	defaultCommandStart      = "start"
+	defaultCommandOnboarding = "onboarding"
+)
+
+// DefaultCommands lists the values default-command accepts
+var DefaultCommands = []string{defaultCommandHelp, defaultCommandStart, defaultCommandOnboarding}
+
+// invokedArgs are the arguments dr was run with, so flags given without a
+// command can be passed on to the default command
+var invokedArgs []string
+
+// runDefaultCommand runs the command default-command names: the help, the
+// quickstart, or the onboarding wizard when it is still needed (and the
+// help otherwise). The root command ignores flags it doesn't define so
+// that flags of the default command can be given without naming it; they
+// are checked and parsed here instead.
+func runDefaultCommand(cmd *cobra.Command, args []string) error {
+	name := strings.ToLower(viper.GetString(DefaultCommandKey))
+	if name == "" {
+		name = defaultCommandHelp
+	}
+
+	if !slices.Contains(DefaultCommands, name) {
+		cmd.SilenceUsage = true
+
+		return errs.NewConfigError("", fmt.Errorf("Unknown %s %q (must be one of: %s).",
+			DefaultCommandKey, name, strings.Join(DefaultCommands, ", ")))
+	}
+
+	showAllCommands, _ := cmd.Flags().GetBool("all-commands")
+
+	if name == defaultCommandStart && !showAllCommands {
+		startCmd, _, err := cmd.Find([]string{defaultCommandStart})
+		if err != nil {
+			return err
+		}
+
+		return runWithFlags(cmd, startCmd, args)
+	}
+
+	if flag := unknownFlag(cmd.Flags(), args); flag != "" {
+		return fmt.Errorf("unknown flag: %s", flag)
+	}
+
+	if name == defaultCommandHelp || showAllCommands || printer.IsStructured() || !start.NeedsOnboarding() {
+		return cmd.Help()
+	}
+
+	return start.RunOnboarding(cmd)
+}
+
+// runWithFlags runs target with the flags in args that root doesn't
+// define. Root's own flags, including the persistent ones target inherits,
+// were parsed already and are left alone.
+func runWithFlags(root, target *cobra.Command, args []string) error {
+	known := pflag.NewFlagSet(root.Name(), pflag.ContinueOnError)
+	known.AddFlagSet(root.Flags())
+	known.AddFlagSet(target.LocalFlags())
+
+	if flag := unknownFlag(known, args); flag != "" {
+		return fmt.Errorf("unknown flag: %s for %s", flag, target.CommandPath())
+	}
+
+	local := pflag.NewFlagSet(target.Name(), pflag.ContinueOnError)
+	local.ParseErrorsWhitelist.UnknownFlags = true
+	local.AddFlagSet(target.LocalFlags())
+
+	if dash := slices.Index(args, "--"); dash >= 0 {
+		args = args[:dash]
+	}
+
+	if err := local.Parse(args); err != nil {
+		return err
+	}
+
+	root.SilenceUsage = true
+
+	target.SetContext(root.Context())
+
+	if target.PreRunE != nil {
+		if err := target.PreRunE(target, nil); err != nil {
+			return err
+		}
+	}
+
+	return target.RunE(target, nil)
+}
+
+// unknownFlag returns the first flag before any "--" in args that flags
+// doesn't define, or "" if there is none
+func unknownFlag(flags *pflag.FlagSet, args []string) string {
+	for _, arg := range args {
+		if arg == "--" {
+			break
+		}
+
+		if !strings.HasPrefix(arg, "-") || arg == "-" {
+			continue
+		}
+
+		if name, ok := strings.CutPrefix(arg, "--"); ok {
+			name, _, _ = strings.Cut(name, "=")
+			if flags.Lookup(name) == nil {
+				return "--" + name
+			}
+
+			continue
+		}
+
+		// Shorthands can be combined, as in -vy, until one takes a value
+		for _, c := range arg[1:] {
+			flag := flags.ShorthandLookup(string(c))
+			if flag == nil {
+				return "-" + string(c)
+			}
+
+			if flag.NoOptDefVal == "" {
+				break
+			}
+		}
+	}
+
+	return ""
+}
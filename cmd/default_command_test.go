@@ -0,0 +1,133 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnknownFlag(t *testing.T) {
+	flags := pflag.NewFlagSet("dr", pflag.ContinueOnError)
+	flags.CountP("verbose", "v", "")
+	flags.BoolP("yes", "y", false, "")
+	flags.StringP("output", "o", "", "")
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{args: nil},
+		{args: []string{"-vv", "--yes", "-o", "json", "--output=yaml"}},
+		{args: []string{"-vyojson"}},
+		{args: []string{"--", "--script-flag"}},
+		{args: []string{"--template", "x"}, want: "--template"},
+		{args: []string{"--template=x"}, want: "--template"},
+		{args: []string{"-vx"}, want: "-x"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, unknownFlag(flags, tt.args), "%q", tt.args)
+	}
+}
+
+// newDefaultCommandTree returns a root with a persistent flag and a
+// target with local flags, after root parsed args the way RootCmd does
+func newDefaultCommandTree(t *testing.T, args []string) (*cobra.Command, *cobra.Command, *string) {
+	t.Helper()
+
+	root := &cobra.Command{Use: "dr", FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true}}
+	root.PersistentFlags().CountP("verbose", "v", "")
+
+	var template string
+
+	target := &cobra.Command{
+		Use:  "start",
+		RunE: func(*cobra.Command, []string) error { return nil },
+	}
+	target.Flags().StringVar(&template, "template", "", "")
+	target.PersistentFlags().StringP("working-dir", "C", "", "")
+	root.AddCommand(target)
+
+	require.NoError(t, root.ParseFlags(args))
+
+	return root, target, &template
+}
+
+func TestRunWithFlags(t *testing.T) {
+	args := []string{"-vv", "--template", "talk-to-my-docs", "-C", "app"}
+	root, target, template := newDefaultCommandTree(t, args)
+
+	require.NoError(t, runWithFlags(root, target, args))
+
+	assert.Equal(t, "talk-to-my-docs", *template)
+	assert.Equal(t, "app", target.Flag("working-dir").Value.String())
+
+	verbose, err := root.PersistentFlags().GetCount("verbose")
+	require.NoError(t, err)
+	assert.Equal(t, 2, verbose, "root's flags are not parsed a second time")
+}
+
+func TestRunWithFlagsUnknown(t *testing.T) {
+	args := []string{"--templat", "x"}
+	root, target, _ := newDefaultCommandTree(t, args)
+
+	assert.EqualError(t, runWithFlags(root, target, args), "unknown flag: --templat for dr start")
+}
+
+func TestRunDefaultCommandHelp(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	root, _, _ := newDefaultCommandTree(t, nil)
+
+	var out bytes.Buffer
+	root.SetOut(&out)
+
+	require.NoError(t, runDefaultCommand(root, nil))
+	assert.Contains(t, out.String(), "Usage:")
+
+	assert.EqualError(t, runDefaultCommand(root, []string{"--template", "x"}), "unknown flag: --template")
+}
+
+func TestRunDefaultCommandStart(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	viper.Set(DefaultCommandKey, "start")
+
+	args := []string{"--template", "talk-to-my-docs"}
+	root, _, template := newDefaultCommandTree(t, args)
+
+	require.NoError(t, runDefaultCommand(root, args))
+	assert.Equal(t, "talk-to-my-docs", *template)
+}
+
+func TestRunDefaultCommandInvalid(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	viper.Set(DefaultCommandKey, "deploy")
+
+	root, _, _ := newDefaultCommandTree(t, nil)
+
+	assert.ErrorContains(t, runDefaultCommand(root, nil), `Unknown default-command "deploy" (must be one of: help, start, onboarding).`)
+}
@@ -23,9 +23,10 @@ import (
 
 func Cmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "check",
-		Short: "Check template dependencies.",
-		RunE:  RunE,
+		Use:     "check",
+		Short:   "Check template dependencies.",
+		Example: `  dr dependencies check`,
+		RunE:    RunE,
 	}
 
 	return cmd
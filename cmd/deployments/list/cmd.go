@@ -0,0 +1,159 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package list
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/datarobot/cli/internal/misc/since"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/internal/watch"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	limit    int
+	maxPages int
+	filters  []string
+	since    string
+	fields   []string
+	watch    watch.Flags
+}
+
+// columns are the table columns, in their default order
+var columns = []printer.Column[drapi.Deployment]{
+	{Field: "id", Header: "ID", Value: func(d drapi.Deployment) string { return d.ID }},
+	{Field: "label", Header: "LABEL", Value: func(d drapi.Deployment) string { return d.Label }},
+	{Field: "status", Header: "STATUS", Value: func(d drapi.Deployment) string { return d.Status }},
+	{Field: "predictionEnvironment", Header: "PREDICTION ENVIRONMENT", Value: environmentName},
+}
+
+func environmentName(d drapi.Deployment) string {
+	if name := d.PredictionEnvironmentName(); name != "" {
+		return name
+	}
+
+	return "-"
+}
+
+func Run(ctx context.Context, w io.Writer, opts options) error {
+	filter, err := drapi.ParseDeploymentFilters(opts.filters)
+	if err != nil {
+		return err
+	}
+
+	createdSince, err := since.Parse(opts.since, time.Now())
+	if err != nil {
+		return err
+	}
+
+	fields, err := printer.ParseFields(opts.fields, drapi.Deployment{})
+	if err != nil {
+		return err
+	}
+
+	if opts.watch.Enabled {
+		return watch.Run(ctx, watch.Options[drapi.Deployment]{
+			Interval: opts.watch.Interval,
+			Columns:  printer.SelectColumns(columns, fields),
+			Key:      func(d drapi.Deployment) string { return d.ID },
+			Fetch: func(ctx context.Context) ([]drapi.Deployment, error) {
+				return drapi.GetDeployments(ctx, filter, createdSince, opts.limit, opts.maxPages)
+			},
+			Empty: "No deployments found.",
+		})
+	}
+
+	if printer.IsStreaming() {
+		return drapi.ListDeployments(ctx, filter, createdSince, opts.limit, opts.maxPages, func(deployment drapi.Deployment) error {
+			record, err := fields.Select(deployment)
+			if err != nil {
+				return err
+			}
+
+			return printer.WriteRecord(w, record)
+		})
+	}
+
+	deployments, err := drapi.GetDeployments(ctx, filter, createdSince, opts.limit, opts.maxPages)
+	if err != nil {
+		return err
+	}
+
+	selected, err := fields.Select(deployments)
+	if err != nil {
+		return err
+	}
+
+	return printer.Print(w, selected, func(w io.Writer) error {
+		if len(deployments) == 0 {
+			_, err := fmt.Fprintln(w, "No deployments found.")
+
+			return err
+		}
+
+		return printer.WriteTable(w, printer.SelectColumns(columns, fields), deployments)
+	})
+}
+
+func Cmd() *cobra.Command {
+	var opts options
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "📋 List your DataRobot deployments",
+		Long: `List the deployments you have access to, with their ID, label, status,
+and prediction environment.
+
+All pages of results are fetched unless --limit is given, up to --max-pages
+pages (50 by default); stopping there warns that the list is truncated.
+Use --filter to keep only the deployments whose field equals a value, for
+example --filter status=active. Filters can be repeated and must all match.
+Use --since to keep only the deployments created at or after a time, given
+as an RFC3339 timestamp or as a duration back from now such as 7d or 24h.
+Use --fields to choose the columns, or the JSON fields, to show.
+
+With --watch, the table stays on screen and is fetched again every
+--interval (5s by default), highlighting the rows that changed since the
+previous refresh, until you press q or Ctrl-C. --watch needs a terminal.`,
+		Example: `  dr deployments list
+  dr deployments list --filter status=active --limit 10
+  dr deployments list --output json --fields id,label
+  dr deployments list --since 7d
+  dr deployments list --watch --interval 10s --filter status=active`,
+		Args:    cobra.NoArgs,
+		PreRunE: auth.EnsureAuthenticatedE,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return Run(cmd.Context(), cmd.OutOrStdout(), opts)
+		},
+	}
+
+	cmd.Flags().IntVar(&opts.limit, "limit", 0, "Show at most this many deployments (0 shows all)")
+	cmd.Flags().IntVar(&opts.maxPages, "max-pages", apiclient.DefaultMaxPages, "Stop after fetching this many pages of results (0 for no limit)")
+	cmd.Flags().StringSliceVar(&opts.fields, "fields", nil, "Comma-separated fields to show, such as id,label,status")
+	cmd.Flags().StringArrayVar(&opts.filters, "filter", nil,
+		fmt.Sprintf("Only show deployments where KEY=VALUE (keys: %s)", strings.Join(drapi.DeploymentFilterKeys, ", ")))
+	since.AddFlag(cmd, &opts.since, "deployments")
+	watch.AddFlags(cmd, &opts.watch)
+
+	return cmd
+}
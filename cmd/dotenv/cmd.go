@@ -57,8 +57,9 @@ Manage your '.env' file and application configuration:
 }
 
 var EditCmd = &cobra.Command{
-	Use:   "edit",
-	Short: "✏️ Edit '.env' file using built-in editor.",
+	Use:     "edit",
+	Short:   "✏️ Edit '.env' file using built-in editor.",
+	Example: `  dr dotenv edit`,
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		cwd, err := os.Getwd()
 		if err != nil {
@@ -103,6 +104,9 @@ This wizard will help you:
   4️⃣  Validate your configuration
 
 💡 Perfect for first-time setup or when adding new integrations.`,
+	Example: `  dr dotenv setup
+  dr dotenv setup --if-needed
+  dr dotenv setup --all`,
 	PreRunE: auth.EnsureAuthenticatedE,
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		repositoryRoot, err := ensureInRepo()
@@ -121,7 +125,7 @@ This wizard will help you:
 			}
 
 			if shouldSkipSetup {
-				fmt.Println("Configuration already exists, skipping setup.")
+				fmt.Fprintln(cmd.ErrOrStderr(), "Configuration already exists, skipping setup.")
 				return nil
 			}
 		}
@@ -201,6 +205,7 @@ This command will:
   • Preserve your existing custom settings
 
 💡 Use this when your credentials expire or you need to refresh your connection.`,
+	Example: `  dr dotenv update`,
 	PreRunE: auth.EnsureAuthenticatedE,
 	Run: func(_ *cobra.Command, _ []string) {
 		dotenv, err := ensureInRepoWithDotenv()
@@ -217,9 +222,10 @@ This command will:
 }
 
 var ValidateCmd = &cobra.Command{
-	Use:   "validate",
-	Short: "Validate '.env' and environment variable configuration against required settings.",
-	Run: func(_ *cobra.Command, _ []string) {
+	Use:     "validate",
+	Short:   "Validate '.env' and environment variable configuration against required settings.",
+	Example: `  dr dotenv validate`,
+	Run: func(cmd *cobra.Command, _ []string) {
 		dotenv, err := ensureInRepoWithDotenv()
 		if err != nil {
 			os.Exit(1)
@@ -252,18 +258,18 @@ var ValidateCmd = &cobra.Command{
 
 		// Then, show errors if any
 		if result.HasErrors() {
-			fmt.Println("\nValidation errors:")
+			fmt.Fprintln(cmd.ErrOrStderr(), "\nValidation errors:")
 
 			for _, valResult := range result.Results {
 				if !valResult.Valid {
-					fmt.Printf("\n%s: Required variable %s is not set\n",
+					fmt.Fprintf(cmd.ErrOrStderr(), "\n%s: Required variable %s is not set\n",
 						tui.ErrorStyle.Render("Error"), varStyle.Render(valResult.Field))
 
 					if valResult.Help != "" {
-						fmt.Printf("  Description: %s\n", valResult.Help)
+						fmt.Fprintf(cmd.ErrOrStderr(), "  Description: %s\n", valResult.Help)
 					}
 
-					fmt.Println("  Set this variable in your '.env' file or run `dr dotenv setup` to configure it.")
+					fmt.Fprintln(cmd.ErrOrStderr(), "  Set this variable in your '.env' file or run `dr dotenv setup` to configure it.")
 				}
 			}
 
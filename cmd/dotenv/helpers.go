@@ -24,6 +24,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/datarobot/cli/internal/envbuilder"
+	"github.com/datarobot/cli/internal/printer"
 	"github.com/datarobot/cli/internal/repo"
 	"github.com/datarobot/cli/tui"
 )
@@ -43,15 +44,15 @@ func generateRandomSecret(length int) (string, error) {
 func ensureInRepo() (string, error) {
 	repoRoot, err := repo.FindRepoRoot()
 	if err != nil {
-		fmt.Println(tui.ErrorStyle.Render("Oops! ") + "This command needs to run inside your AI application folder.")
-		fmt.Println()
-		fmt.Println("📁 What this means:")
-		fmt.Println("   You need to be in a folder that contains your AI application code.")
-		fmt.Println()
-		fmt.Println("🔧 How to fix this:")
-		fmt.Println("   1. If you haven't created an app yet: run " + tui.InfoStyle.Render("dr templates setup"))
-		fmt.Println("   2. If you have an app: navigate to its folder using " + tui.InfoStyle.Render("cd your-app-name"))
-		fmt.Println("   3. Then try this command again")
+		fmt.Fprintln(printer.Diagnostics, tui.ErrorStyle.Render("Oops! ")+"This command needs to run inside your AI application folder.")
+		fmt.Fprintln(printer.Diagnostics)
+		fmt.Fprintln(printer.Diagnostics, "📁 What this means:")
+		fmt.Fprintln(printer.Diagnostics, "   You need to be in a folder that contains your AI application code.")
+		fmt.Fprintln(printer.Diagnostics)
+		fmt.Fprintln(printer.Diagnostics, "🔧 How to fix this:")
+		fmt.Fprintln(printer.Diagnostics, "   1. If you haven't created an app yet: run "+tui.InfoStyle.Render("dr templates setup"))
+		fmt.Fprintln(printer.Diagnostics, "   2. If you have an app: navigate to its folder using "+tui.InfoStyle.Render("cd your-app-name"))
+		fmt.Fprintln(printer.Diagnostics, "   3. Then try this command again")
 
 		return "", errors.New("Not in git repository.")
 	}
@@ -70,14 +71,14 @@ func ensureInRepoWithDotenv() (string, error) {
 	dotenv := filepath.Join(repoRoot, ".env")
 
 	if _, err := os.Stat(dotenv); os.IsNotExist(err) {
-		fmt.Printf("%s: Your app is missing its configuration file (.env)\n", tui.ErrorStyle.Render("Missing Config"))
-		fmt.Println()
-		fmt.Println("📄 What this means:")
-		fmt.Println("   Your AI application needs a '.env' file to store settings like API keys.")
-		fmt.Println()
-		fmt.Println("🔧 How to fix this:")
-		fmt.Println("   Run " + tui.InfoStyle.Render("dr dotenv setup") + " to create the configuration file.")
-		fmt.Println("   This will guide you through setting up all required settings.")
+		fmt.Fprintf(printer.Diagnostics, "%s: Your app is missing its configuration file (.env)\n", tui.ErrorStyle.Render("Missing Config"))
+		fmt.Fprintln(printer.Diagnostics)
+		fmt.Fprintln(printer.Diagnostics, "📄 What this means:")
+		fmt.Fprintln(printer.Diagnostics, "   Your AI application needs a '.env' file to store settings like API keys.")
+		fmt.Fprintln(printer.Diagnostics)
+		fmt.Fprintln(printer.Diagnostics, "🔧 How to fix this:")
+		fmt.Fprintln(printer.Diagnostics, "   Run "+tui.InfoStyle.Render("dr dotenv setup")+" to create the configuration file.")
+		fmt.Fprintln(printer.Diagnostics, "   This will guide you through setting up all required settings.")
 
 		return "", errors.New("'.env' file does not exist.")
 	}
@@ -110,12 +111,12 @@ func ValidateAndEditIfNeeded() error {
 	}
 
 	// Validation failed, prompt user to edit
-	fmt.Println()
-	fmt.Println(tui.InfoStyle.Render("⚠️  Configuration Update Needed"))
-	fmt.Println()
-	fmt.Println("The newly added component requires additional environment variables.")
-	fmt.Println("Let's set those up now.")
-	fmt.Println()
+	fmt.Fprintln(printer.Diagnostics)
+	fmt.Fprintln(printer.Diagnostics, tui.InfoStyle.Render("⚠️  Configuration Update Needed"))
+	fmt.Fprintln(printer.Diagnostics)
+	fmt.Fprintln(printer.Diagnostics, "The newly added component requires additional environment variables.")
+	fmt.Fprintln(printer.Diagnostics, "Let's set those up now.")
+	fmt.Fprintln(printer.Diagnostics)
 
 	// Check if there are extra variables that need wizard setup
 	variables := envbuilder.ParseVariablesOnly(dotenvFileLines)
@@ -136,12 +137,12 @@ func ValidateAndEditIfNeeded() error {
 
 	_, err = tui.Run(m, tea.WithAltScreen())
 	if err != nil {
-		fmt.Println()
-		fmt.Println(tui.ErrorStyle.Render("⚠️  Configuration update incomplete"))
-		fmt.Println()
-		fmt.Println("You may need to update your '.env' file manually or run:")
-		fmt.Println("  " + tui.InfoStyle.Render("dr dotenv edit"))
-		fmt.Println()
+		fmt.Fprintln(printer.Diagnostics)
+		fmt.Fprintln(printer.Diagnostics, tui.ErrorStyle.Render("⚠️  Configuration update incomplete"))
+		fmt.Fprintln(printer.Diagnostics)
+		fmt.Fprintln(printer.Diagnostics, "You may need to update your '.env' file manually or run:")
+		fmt.Fprintln(printer.Diagnostics, "  "+tui.InfoStyle.Render("dr dotenv edit"))
+		fmt.Fprintln(printer.Diagnostics)
 
 		return err
 	}
@@ -32,6 +32,7 @@ import (
 type promptModel struct {
 	prompt     envbuilder.UserPrompt
 	input      textinput.Model
+	secret     tui.MaskedInput // Used instead of input for secret prompts
 	list       list.Model
 	Values     []string
 	successCmd tea.Cmd
@@ -42,10 +43,7 @@ var (
 	selectedItemStyle = lipgloss.NewStyle().PaddingLeft(2).Foreground(tui.DrRed)
 )
 
-const (
-	cursorStyle           = '•'
-	generatedSecretLength = 32
-)
+const generatedSecretLength = 32
 
 type item envbuilder.PromptOption
 
@@ -117,15 +115,21 @@ func newTextInputPrompt(prompt envbuilder.UserPrompt, successCmd tea.Cmd) (promp
 		// If generation fails, just leave value empty and let user enter manually
 	}
 
-	ti := textinput.New()
-	ti.SetValue(prompt.Value)
-
-	// Mask the input if it's a secret
 	if prompt.Type == envbuilder.PromptTypeSecret {
-		ti.EchoMode = textinput.EchoPassword
-		ti.EchoCharacter = cursorStyle
+		secret := tui.NewMaskedInput("> ")
+		secret.SetValue(prompt.Value)
+		cmd := secret.Focus()
+
+		return promptModel{
+			prompt:     prompt,
+			secret:     secret,
+			successCmd: successCmd,
+		}, cmd
 	}
 
+	ti := textinput.New()
+	ti.SetValue(prompt.Value)
+
 	cmd := ti.Focus()
 
 	return promptModel{
@@ -135,6 +139,10 @@ func newTextInputPrompt(prompt envbuilder.UserPrompt, successCmd tea.Cmd) (promp
 	}, cmd
 }
 
+func (pm promptModel) isSecret() bool {
+	return pm.prompt.Type == envbuilder.PromptTypeSecret && len(pm.prompt.Options) == 0
+}
+
 func newListPrompt(prompt envbuilder.UserPrompt, successCmd tea.Cmd) (promptModel, tea.Cmd) {
 	items := make([]list.Item, 0, len(prompt.Options)+1)
 
@@ -195,6 +203,10 @@ func newLLMListPrompt(prompt envbuilder.UserPrompt, successCmd tea.Cmd) (promptM
 }
 
 func (pm promptModel) GetValues() []string {
+	if pm.isSecret() {
+		return []string{strings.TrimSpace(pm.secret.Value())}
+	}
+
 	if len(pm.prompt.Options) == 0 {
 		return []string{strings.TrimSpace(pm.input.Value())}
 	}
@@ -252,6 +264,12 @@ func (pm promptModel) Update(msg tea.Msg) (promptModel, tea.Cmd) {
 
 	var cmd tea.Cmd
 
+	if pm.isSecret() {
+		pm.secret, cmd = pm.secret.Update(msg)
+
+		return pm, cmd
+	}
+
 	pm.input, cmd = pm.input.Update(msg)
 
 	return pm, cmd
@@ -321,6 +339,9 @@ func (pm promptModel) View() string {
 		if pm.prompt.Multiple {
 			sb.WriteString(tui.DimStyle.Render("space to toggle • enter to answer • "))
 		}
+	} else if pm.isSecret() {
+		sb.WriteString(pm.secret.View())
+		sb.WriteString("\n\n")
 	} else {
 		sb.WriteString(pm.input.View())
 		sb.WriteString("\n\n")
@@ -16,11 +16,11 @@ package dotenv
 
 import (
 	"fmt"
-	"strings"
 
 	"github.com/datarobot/cli/internal/envbuilder"
 	"github.com/datarobot/cli/internal/log"
-	"github.com/datarobot/cli/internal/misc/reader"
+	"github.com/datarobot/cli/internal/misc/confirm"
+	"github.com/datarobot/cli/internal/printer"
 	"github.com/datarobot/cli/internal/repo"
 	"github.com/datarobot/cli/tui"
 )
@@ -58,11 +58,11 @@ func handleExtraEnvVars(variables envbuilder.Variables) bool { //nolint: cyclop
 	}
 
 	if extraEnvVarsFound {
-		fmt.Println("Environment Configuration")
-		fmt.Println("=========================")
-		fmt.Println("")
-		fmt.Println("Editing '.env' file with component-specific variables...")
-		fmt.Println("")
+		fmt.Fprintln(printer.Diagnostics, "Environment Configuration")
+		fmt.Fprintln(printer.Diagnostics, "=========================")
+		fmt.Fprintln(printer.Diagnostics, "")
+		fmt.Fprintln(printer.Diagnostics, "Editing '.env' file with component-specific variables...")
+		fmt.Fprintln(printer.Diagnostics, "")
 
 		for _, up := range userPrompts {
 			if !up.HasEnvValue() {
@@ -75,18 +75,17 @@ func handleExtraEnvVars(variables envbuilder.Variables) bool { //nolint: cyclop
 				style = tui.BaseTextStyle
 			}
 
-			fmt.Println(style.Render(up.StringWithoutHelp()))
+			fmt.Fprintln(printer.Diagnostics, style.Render(up.StringWithoutHelp()))
 		}
 
-		fmt.Println("")
-		fmt.Println("Configure required missing variables now? (y/N): ")
+		fmt.Fprintln(printer.Diagnostics, "")
 
-		selectedOption, err := reader.ReadString()
+		configure, err := confirm.Ask("Configure required missing variables now?")
 		if err != nil {
-			log.Fatalf("Error reading user reply: %v", err)
+			log.Fatal(err)
 		}
 
-		return strings.ToLower(strings.TrimSpace(selectedOption)) == "y"
+		return configure
 	}
 
 	return false
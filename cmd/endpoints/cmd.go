@@ -0,0 +1,37 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package endpoints
+
+import (
+	"github.com/datarobot/cli/cmd/endpoints/list"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "endpoints",
+		Aliases: []string{"endpoint", "regions"},
+		GroupID: "core",
+		Short:   "🌍 Known DataRobot cloud endpoints",
+		Long: `Commands for the DataRobot cloud endpoints the CLI knows about.
+
+Each region has an ID that can be used in place of its URL, for example
+'dr auth set-url eu'.`,
+	}
+
+	cmd.AddCommand(list.Cmd())
+
+	return cmd
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package list
+
+import (
+	"io"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+// columns are the table columns, in their default order
+var columns = []printer.Column[config.Region]{
+	{Field: "id", Header: "ID", Value: func(r config.Region) string { return r.ID }},
+	{Field: "name", Header: "NAME", Value: func(r config.Region) string { return r.Name }},
+	{Field: "url", Header: "URL", Value: func(r config.Region) string { return r.URL }},
+}
+
+func Run(w io.Writer, fieldNames []string) error {
+	fields, err := printer.ParseFields(fieldNames, config.Region{})
+	if err != nil {
+		return err
+	}
+
+	selected, err := fields.Select(config.Regions)
+	if err != nil {
+		return err
+	}
+
+	return printer.Print(w, selected, func(w io.Writer) error {
+		return printer.WriteTable(w, printer.SelectColumns(columns, fields), config.Regions)
+	})
+}
+
+func Cmd() *cobra.Command {
+	var fields []string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "📋 List the known DataRobot cloud endpoints",
+		Long: `List the DataRobot cloud regions and their URLs.
+
+These are the choices offered by 'dr auth login' and 'dr auth set-url'.
+For a self-managed installation, use your organization's DataRobot URL.`,
+		Example: `  dr endpoints list
+  dr endpoints list --fields id,url --output json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return Run(cmd.OutOrStdout(), fields)
+		},
+	}
+
+	cmd.Flags().StringSliceVar(&fields, "fields", nil, "Comma-separated fields to show, such as id,url")
+
+	return cmd
+}
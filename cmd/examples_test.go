@@ -0,0 +1,310 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestCommandsHaveExamples keeps every command users can run documented
+// with at least one example in its help
+func TestCommandsHaveExamples(t *testing.T) {
+	for _, cmd := range visibleCommands(RootCmd) {
+		// Cobra adds its help command the first time the root runs, so it
+		// is only listed after another test has executed a command
+		if cmd == RootCmd || !cmd.Runnable() || cmd.Name() == "help" {
+			continue
+		}
+
+		assert.NotEmpty(t, cmd.Example, "%s has no examples", cmd.CommandPath())
+	}
+}
+
+// TestExamplesParse checks that every example in the help names an
+// existing command and only uses flags, flag values, and a number of
+// arguments the command accepts, so examples can't drift from the flags
+func TestExamplesParse(t *testing.T) {
+	for _, cmd := range visibleCommands(RootCmd) {
+		for _, line := range exampleInvocations(cmd.Example) {
+			t.Run(line, func(t *testing.T) {
+				assert.NoError(t, validateExample(RootCmd, line), "example of %s", cmd.CommandPath())
+			})
+		}
+	}
+}
+
+func TestValidateExample(t *testing.T) {
+	tests := []struct {
+		line    string
+		wantErr string
+	}{
+		{line: "dr templates list --refresh -o json"},
+		{line: `dr templates describe "Talk to my docs"`},
+		{line: "dr templates lst", wantErr: "unknown command"},
+		{line: "dr templates list --refreshh", wantErr: "unknown flag"},
+		{line: "dr templates list -o xml", wantErr: "invalid argument"},
+		{line: "dr templates describe", wantErr: "accepts 1 arg"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			err := validateExample(RootCmd, tt.line)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExampleInvocations(t *testing.T) {
+	example := `  # List everything
+  dr templates list
+  $ dr templates list -o json | jq '.[].name'
+  dr start \
+    --yes
+
+  Use this in CI:
+  PS> dr self version`
+
+	assert.Equal(t, []string{
+		"dr templates list",
+		"dr templates list -o json",
+		"dr start --yes",
+		"dr self version",
+	}, exampleInvocations(example))
+}
+
+// visibleCommands returns cmd and its descendants that appear in the help
+func visibleCommands(cmd *cobra.Command) []*cobra.Command {
+	if cmd.Hidden {
+		return nil
+	}
+
+	commands := []*cobra.Command{cmd}
+
+	for _, child := range cmd.Commands() {
+		commands = append(commands, visibleCommands(child)...)
+	}
+
+	return commands
+}
+
+// exampleInvocations returns the dr command lines in an example, joining
+// continued lines and dropping prompts and anything after a pipe or
+// redirect
+func exampleInvocations(example string) []string {
+	var lines []string
+
+	pending := ""
+
+	for _, raw := range strings.Split(example, "\n") {
+		line := strings.TrimSpace(raw)
+
+		if pending != "" {
+			line = pending + " " + line
+			pending = ""
+		}
+
+		if rest, ok := strings.CutSuffix(line, `\`); ok {
+			pending = strings.TrimSpace(rest)
+
+			continue
+		}
+
+		for _, prompt := range []string{"$ ", "PS> "} {
+			line = strings.TrimPrefix(line, prompt)
+		}
+
+		if !strings.HasPrefix(line, "dr ") && line != "dr" {
+			continue
+		}
+
+		lines = append(lines, strings.Join(splitCommandLine(line), " "))
+	}
+
+	return lines
+}
+
+// splitCommandLine splits a shell command line into words, honoring single
+// and double quotes, and stops at an unquoted pipe, redirect, command
+// separator, or comment. Quotes are kept on words that need them, so the
+// words can be joined back for display.
+func splitCommandLine(line string) []string {
+	words, _ := shellWords(line, true)
+
+	return words
+}
+
+// shellWords splits line like splitCommandLine. With display, words that
+// were quoted keep their quotes; otherwise quotes are removed.
+func shellWords(line string, display bool) ([]string, error) {
+	var (
+		words   []string
+		current strings.Builder
+		quote   rune
+		inWord  bool
+		quoted  bool
+	)
+
+	flush := func() {
+		if inWord {
+			word := current.String()
+			if display && quoted {
+				word = fmt.Sprintf("%q", word)
+			}
+
+			words = append(words, word)
+		}
+
+		current.Reset()
+
+		inWord, quoted = false, false
+	}
+
+	for _, r := range line {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				current.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote, inWord, quoted = r, true, true
+		case r == ' ' || r == '\t':
+			flush()
+		case strings.ContainsRune("|<>;&#", r) && !inWord:
+			flush()
+
+			return words, nil
+		default:
+			current.WriteRune(r)
+
+			inWord = true
+		}
+	}
+
+	if quote != 0 {
+		return nil, errors.New("unterminated quote")
+	}
+
+	flush()
+
+	return words, nil
+}
+
+// validateExample parses an example command line the way root would,
+// without running anything or changing the values of root's flags
+func validateExample(root *cobra.Command, line string) error {
+	words, err := shellWords(line, false)
+	if err != nil {
+		return err
+	}
+
+	if len(words) == 0 || words[0] != root.Name() {
+		return fmt.Errorf("%q does not start with %s", line, root.Name())
+	}
+
+	cmd, rest, err := root.Find(words[1:])
+	if err != nil {
+		return err
+	}
+
+	if cmd.DisableFlagParsing {
+		return nil
+	}
+
+	flags := cloneFlags(cmd)
+	if err := flags.Parse(rest); err != nil {
+		return fmt.Errorf("%s: %w", cmd.CommandPath(), err)
+	}
+
+	args := flags.Args()
+
+	// Like cobra, treat a leftover word as a mistyped subcommand
+	if cmd.HasAvailableSubCommands() && !cmd.Runnable() && len(args) > 0 {
+		return fmt.Errorf("unknown command %q for %q", args[0], cmd.CommandPath())
+	}
+
+	if err := cmd.ValidateArgs(args); err != nil {
+		return fmt.Errorf("%s: %w", cmd.CommandPath(), err)
+	}
+
+	return nil
+}
+
+// cloneFlags returns a copy of the flags cmd accepts, each with a fresh
+// value of the same type, so parsing an example checks flag values the
+// same way without touching the command's own
+func cloneFlags(cmd *cobra.Command) *pflag.FlagSet {
+	flags := pflag.NewFlagSet(cmd.Name(), pflag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+
+	add := func(f *pflag.Flag) {
+		if flags.Lookup(f.Name) != nil {
+			return
+		}
+
+		clone := *f
+		clone.Value = freshValue(f.Value)
+		clone.Changed = false
+
+		flags.AddFlag(&clone)
+	}
+
+	cmd.LocalFlags().VisitAll(add)
+	cmd.InheritedFlags().VisitAll(add)
+
+	return flags
+}
+
+// freshValue returns a new, unset value of the same type as value
+func freshValue(value pflag.Value) pflag.Value {
+	scratch := pflag.NewFlagSet("scratch", pflag.ContinueOnError)
+
+	switch value.Type() {
+	case "bool":
+		scratch.Bool("v", false, "")
+	case "count":
+		scratch.Count("v", "")
+	case "duration":
+		scratch.Duration("v", 0, "")
+	case "int":
+		scratch.Int("v", 0, "")
+	case "string":
+		scratch.String("v", "", "")
+	case "stringArray":
+		scratch.StringArray("v", nil, "")
+	case "stringSlice":
+		scratch.StringSlice("v", nil, "")
+	default:
+		// Enum types defined by the CLI, such as printer.Format, are
+		// pointers to a string type whose Set validates the value
+		return reflect.New(reflect.TypeOf(value).Elem()).Interface().(pflag.Value)
+	}
+
+	return scratch.Lookup("v").Value
+}
@@ -49,10 +49,18 @@ func getSectionHeader(title string) string {
 }
 
 func getCommandColor() string {
+	if !tui.ColorEnabled() {
+		return ""
+	}
+
 	return "\033[1m" + tui.SetAnsiForegroundColor(tui.GetAdaptiveColor(tui.DrPurple, tui.DrPurpleDark))
 }
 
 func resetCommandStyle() string {
+	if !tui.ColorEnabled() {
+		return ""
+	}
+
 	return tui.ResetForegroundColor() + "\033[0m"
 }
 
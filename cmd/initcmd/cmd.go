@@ -0,0 +1,267 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package initcmd implements dr init. It is not named init, which Go
+// reserves for package initializers.
+package initcmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/datarobot/cli/cmd/start"
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/internal/repo"
+	"github.com/datarobot/cli/internal/version"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+)
+
+// versionsFile is the project file listing the tools it needs, read by
+// dr start and dr self doctor
+var versionsFile = filepath.Join(repo.DataRobotTemplateDetectCliPath, "versions.yaml")
+
+// releaseVersion matches a released CLI version, as opposed to a dev build
+var releaseVersion = regexp.MustCompile(`^v?(\d+\.\d+\.\d+)$`)
+
+type options struct {
+	template string
+	dir      string
+	force    bool
+}
+
+// initResult is the structured form of what dr init did
+type initResult struct {
+	Dir       string     `json:"dir"                yaml:"dir"`
+	Template  string     `json:"template,omitempty" yaml:"template,omitempty"`
+	Files     []string   `json:"files"              yaml:"files"`
+	NextSteps []nextStep `json:"next_steps"         yaml:"next_steps"`
+}
+
+// nextStep is a command to run after dr init, and what it is for
+type nextStep struct {
+	Command     string `json:"command"               yaml:"command"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
+func (r initResult) printText(w io.Writer) error {
+	if r.Template != "" {
+		fmt.Fprintln(w, tui.SuccessStyle.Render(fmt.Sprintf("✅ Initialized a DataRobot project from %s in %s.", r.Template, r.Dir)))
+	} else {
+		fmt.Fprintln(w, tui.SuccessStyle.Render("✅ Initialized a DataRobot project in "+r.Dir+"."))
+	}
+
+	for _, file := range r.Files {
+		fmt.Fprintln(w, tui.DimStyle.Render("   wrote "+file))
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Next steps:")
+
+	for _, step := range r.NextSteps {
+		if step.Description == "" {
+			fmt.Fprintln(w, "  "+step.Command)
+		} else {
+			fmt.Fprintf(w, "  %-17s %s\n", step.Command, tui.DimStyle.Render("# "+step.Description))
+		}
+	}
+
+	return nil
+}
+
+func Cmd() *cobra.Command {
+	var opts options
+
+	cmd := &cobra.Command{
+		Use:     "init",
+		GroupID: "core",
+		Short:   "Create a DataRobot project",
+		Long: `Create a DataRobot project directory, ready for the other dr commands.
+
+The project gets a .datarobot/cli/versions.yaml listing the tools it needs,
+starting with this version of the CLI, which dr start and dr self doctor
+check. With --template, the template is cloned into the directory first, the
+same way dr start does.
+
+The directory defaults to the current one, or with --template to one named
+after the template's repository. Initializing over an existing project fails
+unless --force is given.
+
+For the full guided setup, including login and environment variables, use
+'dr start' instead.`,
+		Example: `  dr init
+  dr init --dir my-app
+  dr init --template "Talk to My Docs"`,
+		Args: cobra.NoArgs,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			// Only a template from the catalog needs a login
+			if opts.template == "" || drapi.UsesLocalTemplates() || drapi.HasCachedCatalog() {
+				return nil
+			}
+
+			return auth.EnsureAuthenticatedE(cmd, args)
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.SilenceUsage = true
+
+			result, err := Run(opts)
+			if err != nil {
+				return err
+			}
+
+			return printer.Print(cmd.OutOrStdout(), result, result.printText)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.template, "template", "", "Clone this template, by name or ID, into the project")
+	cmd.Flags().StringVar(&opts.dir, "dir", "", "Directory to create the project in (default: the current directory, or one named after the template)")
+	cmd.Flags().BoolVar(&opts.force, "force", false, "Initialize even if the directory is already a project, replacing a template clone")
+
+	return cmd
+}
+
+// Run creates the project described by opts
+func Run(opts options) (initResult, error) {
+	var template *drapi.Template
+
+	if opts.template != "" {
+		catalog, err := drapi.GetPublicTemplatesSorted()
+		if err != nil {
+			return initResult{}, fmt.Errorf("Failed to fetch templates: %w", err)
+		}
+
+		found, err := drapi.FindTemplate(catalog.Templates, opts.template)
+		if err != nil {
+			return initResult{}, err
+		}
+
+		template = &found
+
+		if opts.dir == "" {
+			opts.dir = found.DefaultDir()
+		}
+	}
+
+	if opts.dir == "" {
+		opts.dir = "."
+	}
+
+	dir, err := filepath.Abs(opts.dir)
+	if err != nil {
+		return initResult{}, fmt.Errorf("Failed to resolve directory %s: %w", opts.dir, err)
+	}
+
+	if repo.IsRepoRoot(dir) && !opts.force {
+		return initResult{}, fmt.Errorf("%s is already a DataRobot project. Use --force to initialize it again.", dir)
+	}
+
+	result := initResult{Dir: dir, Files: []string{}}
+
+	if template != nil {
+		if dir, err = start.SetUpTemplate(*template, dir, opts.force); err != nil {
+			return initResult{}, err
+		}
+
+		result.Template = template.Name
+	} else if err := os.MkdirAll(dir, 0o755); err != nil {
+		return initResult{}, fmt.Errorf("Failed to create %s: %w", dir, err)
+	}
+
+	// A template's own list of tools is kept
+	written, err := writeVersionsFile(dir, template == nil && opts.force)
+	if err != nil {
+		return initResult{}, err
+	}
+
+	if written {
+		result.Files = append(result.Files, versionsFile)
+	}
+
+	result.NextSteps = nextSteps(dir, template != nil)
+
+	return result, nil
+}
+
+// writeVersionsFile writes the default versions.yaml into dir, unless one
+// is already there and overwrite is false. It reports whether it wrote it.
+func writeVersionsFile(dir string, overwrite bool) (bool, error) {
+	path := filepath.Join(dir, versionsFile)
+
+	if _, err := os.Stat(path); err == nil && !overwrite {
+		return false, nil
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, fmt.Errorf("Failed to check %s: %w", path, err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return false, fmt.Errorf("Failed to create %s: %w", filepath.Dir(path), err)
+	}
+
+	if err := os.WriteFile(path, []byte(defaultVersions()), 0o644); err != nil {
+		return false, fmt.Errorf("Failed to write %s: %w", path, err)
+	}
+
+	return true, nil
+}
+
+// defaultVersions is the versions.yaml of a new project: it requires the
+// CLI version that created it, unless that is a dev build
+func defaultVersions() string {
+	var b strings.Builder
+
+	b.WriteString("# Tools this project needs. dr start and dr self doctor check that each\n")
+	b.WriteString("# is installed, at minimum-version or later.\n")
+	b.WriteString("dr:\n")
+	b.WriteString("  name: " + version.AppName + "\n")
+
+	if match := releaseVersion.FindStringSubmatch(version.Version); match != nil {
+		b.WriteString("  minimum-version: " + match[1] + "\n")
+		b.WriteString("  command: " + version.CliName + " self version\n")
+	}
+
+	b.WriteString("  url: https://github.com/datarobot-oss/cli\n")
+
+	return b.String()
+}
+
+// nextSteps suggests what to run after dr init in dir
+func nextSteps(dir string, fromTemplate bool) []nextStep {
+	var steps []nextStep
+
+	if cwd, err := os.Getwd(); err == nil && cwd != dir {
+		rel, err := filepath.Rel(cwd, dir)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			rel = dir
+		}
+
+		steps = append(steps, nextStep{Command: "cd " + rel})
+	}
+
+	if fromTemplate {
+		return append(steps,
+			nextStep{Command: "dr dotenv setup", Description: "set the environment variables the template needs"},
+			nextStep{Command: "dr start", Description: "run the template's quickstart"})
+	}
+
+	return append(steps,
+		nextStep{Command: "dr templates list", Description: "find a template to build on"},
+		nextStep{Command: "dr self doctor", Description: "check the tools in " + versionsFile + " are installed"})
+}
@@ -0,0 +1,124 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package initcmd
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/datarobot/cli/internal/version"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupInit(t *testing.T) string {
+	t.Helper()
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	dir := t.TempDir()
+	testutil.SetTestHomeDir(t, dir)
+	t.Chdir(dir)
+
+	return dir
+}
+
+func TestRunCreatesProject(t *testing.T) {
+	dir := setupInit(t)
+
+	result, err := Run(options{dir: "my-app"})
+	require.NoError(t, err)
+
+	project := filepath.Join(dir, "my-app")
+	assert.Equal(t, project, result.Dir)
+	assert.Equal(t, []string{versionsFile}, result.Files)
+	assert.Equal(t, "cd my-app", result.NextSteps[0].Command)
+	assert.FileExists(t, filepath.Join(project, versionsFile))
+
+	// The project is now recognized, so a second init needs --force
+	_, err = Run(options{dir: "my-app"})
+	require.ErrorContains(t, err, "is already a DataRobot project. Use --force")
+
+	_, err = Run(options{dir: "my-app", force: true})
+	require.NoError(t, err)
+}
+
+func TestRunInCurrentDirectory(t *testing.T) {
+	dir := setupInit(t)
+
+	result, err := Run(options{})
+	require.NoError(t, err)
+
+	assert.Equal(t, dir, result.Dir)
+	assert.Equal(t, "dr templates list", result.NextSteps[0].Command)
+}
+
+func TestRunFromTemplate(t *testing.T) {
+	dir := setupInit(t)
+
+	// A template repository with its own versions.yaml, which is kept
+	source := filepath.Join(dir, "source", "talk-to-my-docs")
+	require.NoError(t, os.MkdirAll(filepath.Join(source, ".datarobot", "cli"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(source, versionsFile), []byte("uv:\n  name: uv\n"), 0o644))
+	git(t, source, "init", "-q")
+	git(t, source, "add", ".")
+	git(t, source, "-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "-qm", "init")
+
+	templates := filepath.Join(dir, "templates", "docs")
+	require.NoError(t, os.MkdirAll(templates, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(templates, "manifest.yaml"),
+		[]byte("id: docs\nname: Talk to My Docs\nrepository:\n  url: "+source+"\n"), 0o644))
+	viper.Set(drapi.TemplatesDirKey, filepath.Dir(templates))
+
+	result, err := Run(options{template: "talk to my docs"})
+	require.NoError(t, err)
+
+	assert.Equal(t, filepath.Join(dir, "talk-to-my-docs"), result.Dir)
+	assert.Equal(t, "Talk to My Docs", result.Template)
+	assert.Empty(t, result.Files)
+	assert.Equal(t, "dr dotenv setup", result.NextSteps[1].Command)
+
+	data, err := os.ReadFile(filepath.Join(result.Dir, versionsFile))
+	require.NoError(t, err)
+	assert.Equal(t, "uv:\n  name: uv\n", string(data))
+}
+
+func TestDefaultVersions(t *testing.T) {
+	prev := version.Version
+	t.Cleanup(func() { version.Version = prev })
+
+	version.Version = "v1.4.2"
+	assert.Contains(t, defaultVersions(), "  minimum-version: 1.4.2\n  command: dr self version\n")
+
+	// A dev build can't be compared, so it isn't required
+	version.Version = "dev"
+	assert.NotContains(t, defaultVersions(), "  minimum-version:")
+}
+
+func git(t *testing.T, dir string, args ...string) {
+	t.Helper()
+
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
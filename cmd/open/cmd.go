@@ -0,0 +1,154 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package open
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/config"
+	browser "github.com/datarobot/cli/internal/misc/open"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+// errNoEndpoint is returned when there is no DataRobot URL to build a web
+// address from
+var errNoEndpoint = errors.New("No DataRobot URL is configured. Run 'dr auth set-url' to set one.")
+
+// resource is a kind of object with a page in the web application
+type resource struct {
+	Name string
+	Path string
+}
+
+// resources lists what 'dr open' can open, in the order they are offered
+var resources = []resource{
+	{Name: "deployment", Path: "/deployments/%s/overview"},
+	{Name: "project", Path: "/projects/%s/models"},
+}
+
+func resourceNames() []string {
+	names := make([]string, len(resources))
+	for i, r := range resources {
+		names[i] = r.Name
+	}
+
+	return names
+}
+
+// target is the structured form of the page to open
+type target struct {
+	URL string `json:"url" yaml:"url"`
+}
+
+// WebURL returns the address of the page for args: the dashboard when args
+// is empty, otherwise the page of the resource kind args[0] with ID args[1]
+func WebURL(args []string) (string, error) {
+	creds, err := auth.ResolveCredentials()
+	if err != nil {
+		return "", err
+	}
+
+	if creds.Endpoint == "" {
+		return "", errNoEndpoint
+	}
+
+	base, err := config.WebBaseURL(creds.Endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	if len(args) == 0 {
+		return base + "/", nil
+	}
+
+	i := slices.IndexFunc(resources, func(r resource) bool { return r.Name == strings.ToLower(args[0]) })
+	if i < 0 {
+		return "", fmt.Errorf("Unknown resource %q (must be one of: %s).", args[0], strings.Join(resourceNames(), ", "))
+	}
+
+	return base + fmt.Sprintf(resources[i].Path, url.PathEscape(args[1])), nil
+}
+
+func Run(w, stderr io.Writer, args []string, printURL bool) error {
+	address, err := WebURL(args)
+	if err != nil {
+		return err
+	}
+
+	if printURL || printer.IsStructured() {
+		return printer.Print(w, target{URL: address}, func(w io.Writer) error {
+			_, err := fmt.Fprintln(w, address)
+
+			return err
+		})
+	}
+
+	fmt.Fprintf(stderr, "Opening %s in your browser...\n", address)
+	browser.Open(address)
+
+	return nil
+}
+
+func Cmd() *cobra.Command {
+	var printURL bool
+
+	cmd := &cobra.Command{
+		Use:     "open [deployment|project ID]",
+		GroupID: "core",
+		Short:   "🌐 Open DataRobot in your browser",
+		Long: `Open the DataRobot web application in your default browser: the
+dashboard, or the page of a deployment or project given its ID.
+
+The address is built from your configured endpoint. Cloud endpoints open
+their region's application, and self-managed ones open the same host
+without the API path. If your installation serves the application from
+another host, set web-url in the config file.
+
+Use --print-url to print the address instead of opening it, such as over
+SSH.`,
+		Example: `  dr open
+  dr open deployment 65f1c0a2b3d4e5f6a7b8c9d0
+  dr open project 65f1c0a2b3d4e5f6a7b8c9d1 --print-url`,
+		Args: func(_ *cobra.Command, args []string) error {
+			if len(args) == 0 || len(args) == 2 {
+				return nil
+			}
+
+			return fmt.Errorf("Expected no arguments, or a resource (%s) and its ID.", strings.Join(resourceNames(), " or "))
+		},
+		ValidArgsFunction: func(_ *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+			if len(args) == 0 {
+				return resourceNames(), cobra.ShellCompDirectiveNoFileComp
+			}
+
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		},
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Run(cmd.OutOrStdout(), cmd.ErrOrStderr(), args, printURL)
+		},
+	}
+
+	cmd.Flags().BoolVar(&printURL, "print-url", false, "Print the address instead of opening it in a browser")
+
+	return cmd
+}
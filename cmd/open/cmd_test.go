@@ -0,0 +1,100 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package open
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+func setup(t *testing.T, endpoint string) {
+	t.Helper()
+
+	keyring.MockInit()
+	testutil.SetTestHomeDir(t, t.TempDir())
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	viper.Set(config.DataRobotURL, endpoint)
+}
+
+func TestWebURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		args     []string
+		want     string
+	}{
+		{"dashboard", "https://app.datarobot.com/api/v2", nil, "https://app.datarobot.com/"},
+		{"deployment", "https://app.eu.datarobot.com/api/v2", []string{"deployment", "65f1c0a2b3d4e5f6a7b8c9d0"}, "https://app.eu.datarobot.com/deployments/65f1c0a2b3d4e5f6a7b8c9d0/overview"},
+		{"project", "https://example.com/datarobot/api/v2", []string{"Project", "65f1c0a2b3d4e5f6a7b8c9d1"}, "https://example.com/datarobot/projects/65f1c0a2b3d4e5f6a7b8c9d1/models"},
+		{"escaped id", "https://datarobot.example.com/api/v2", []string{"project", "a/b"}, "https://datarobot.example.com/projects/a%2Fb/models"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup(t, tt.endpoint)
+
+			got, err := WebURL(tt.args)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWebURLErrors(t *testing.T) {
+	setup(t, "")
+
+	_, err := WebURL(nil)
+	require.ErrorIs(t, err, errNoEndpoint)
+
+	setup(t, "https://app.datarobot.com/api/v2")
+
+	_, err = WebURL([]string{"notebook", "abc"})
+	assert.EqualError(t, err, `Unknown resource "notebook" (must be one of: deployment, project).`)
+}
+
+func TestRunPrintURL(t *testing.T) {
+	setup(t, "https://app.datarobot.com/api/v2")
+
+	var out, stderr bytes.Buffer
+
+	require.NoError(t, Run(&out, &stderr, []string{"deployment", "abc"}, true))
+	assert.Equal(t, "https://app.datarobot.com/deployments/abc/overview\n", out.String())
+	assert.Empty(t, stderr.String())
+
+	out.Reset()
+	viper.Set(printer.OutputKey, "json")
+
+	require.NoError(t, Run(&out, &stderr, nil, false))
+	assert.JSONEq(t, `{"url": "https://app.datarobot.com/"}`, out.String())
+}
+
+func TestArgs(t *testing.T) {
+	cmd := Cmd()
+
+	assert.NoError(t, cmd.Args(cmd, nil))
+	assert.NoError(t, cmd.Args(cmd, []string{"project", "abc"}))
+	assert.EqualError(t, cmd.Args(cmd, []string{"project"}), "Expected no arguments, or a resource (deployment or project) and its ID.")
+}
@@ -16,6 +16,7 @@ package install
 
 import (
 	"fmt"
+	"io"
 
 	"github.com/datarobot/cli/cmd/plugin/shared"
 	"github.com/datarobot/cli/internal/plugin"
@@ -61,10 +62,14 @@ Use --version to specify a version constraint:
 	return cmd
 }
 
-func runInstall(_ *cobra.Command, args []string) error {
+func runInstall(cmd *cobra.Command, args []string) error {
+	// Progress goes to stderr, leaving stdout to the plugin lists and the
+	// result
+	out, diag := cmd.OutOrStdout(), cmd.ErrOrStderr()
+
 	finalRegistryURL := shared.NormalizeRegistryURL(registryURL)
-	if viper.GetBool("verbose") {
-		fmt.Printf("Fetching plugin registry from %s...\n", finalRegistryURL)
+	if viper.GetInt("verbose") > 0 {
+		fmt.Fprintf(diag, "Fetching plugin registry from %s...\n", finalRegistryURL)
 	}
 
 	registry, baseURL, err := plugin.FetchRegistry(finalRegistryURL)
@@ -74,9 +79,9 @@ func runInstall(_ *cobra.Command, args []string) error {
 
 	// Handle --list flag or no args (show list by default)
 	if listPlugins || len(args) == 0 {
-		fmt.Println()
-		fmt.Println(tui.SubTitleStyle.Render("Available Plugins"))
-		printAvailablePlugins(registry)
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, tui.SubTitleStyle.Render("Available Plugins"))
+		printAvailablePlugins(out, registry)
 
 		return nil
 	}
@@ -87,63 +92,63 @@ func runInstall(_ *cobra.Command, args []string) error {
 	if listVersions {
 		pluginEntry, ok := registry.Plugins[pluginName]
 		if !ok {
-			printAvailablePlugins(registry)
+			printAvailablePlugins(diag, registry)
 
 			return fmt.Errorf("plugin %q not found in registry", pluginName)
 		}
 
-		fmt.Println()
-		fmt.Println(tui.SubTitleStyle.Render("Available Versions for " + pluginName))
-		printAvailableVersions(pluginEntry.Versions)
+		fmt.Fprintln(out)
+		fmt.Fprintln(out, tui.SubTitleStyle.Render("Available Versions for "+pluginName))
+		printAvailableVersions(out, pluginEntry.Versions)
 
 		return nil
 	}
 
-	fmt.Println()
-	fmt.Println(tui.SubTitleStyle.Render("Installing Plugin"))
+	fmt.Fprintln(diag)
+	fmt.Fprintln(diag, tui.SubTitleStyle.Render("Installing Plugin"))
 
 	pluginEntry, ok := registry.Plugins[pluginName]
 	if !ok {
-		printAvailablePlugins(registry)
+		printAvailablePlugins(diag, registry)
 
 		return fmt.Errorf("plugin %q not found in registry", pluginName)
 	}
 
 	version, err := plugin.ResolveVersion(pluginEntry.Versions, versionConstraint)
 	if err != nil {
-		printAvailableVersions(pluginEntry.Versions)
+		printAvailableVersions(diag, pluginEntry.Versions)
 
 		return fmt.Errorf("failed to resolve version: %w", err)
 	}
 
-	fmt.Printf("Installing %s version %s...\n", pluginEntry.Name, version.Version)
-	fmt.Printf("Downloading from: %s/%s\n", baseURL, version.URL)
+	fmt.Fprintf(diag, "Installing %s version %s...\n", pluginEntry.Name, version.Version)
+	fmt.Fprintf(diag, "Downloading from: %s/%s\n", baseURL, version.URL)
 
 	if err := plugin.InstallPlugin(pluginEntry, *version, baseURL); err != nil {
 		return fmt.Errorf("failed to install plugin: %w", err)
 	}
 
-	fmt.Println()
-	fmt.Println(tui.SuccessStyle.Render("✓ Successfully installed " + pluginEntry.Name + " " + version.Version))
-	fmt.Println()
-	fmt.Printf("Run `dr %s --help` to get started.\n", pluginEntry.Name)
+	fmt.Fprintln(diag)
+	fmt.Fprintln(out, tui.SuccessStyle.Render("✓ Successfully installed "+pluginEntry.Name+" "+version.Version))
+	fmt.Fprintln(diag)
+	fmt.Fprintf(diag, "Run `dr %s --help` to get started.\n", pluginEntry.Name)
 
 	return nil
 }
 
-func printAvailablePlugins(registry *plugin.PluginRegistry) {
+func printAvailablePlugins(w io.Writer, registry *plugin.PluginRegistry) {
 	for name, p := range registry.Plugins {
 		latestVersion := "-"
 		if len(p.Versions) > 0 {
 			latestVersion = p.Versions[0].Version
 		}
 
-		fmt.Printf("  - %s (%s): %s\n", name, latestVersion, p.Description)
+		fmt.Fprintf(w, "  - %s (%s): %s\n", name, latestVersion, p.Description)
 	}
 }
 
-func printAvailableVersions(versions []plugin.RegistryVersion) {
+func printAvailableVersions(w io.Writer, versions []plugin.RegistryVersion) {
 	for _, v := range versions {
-		fmt.Printf("  - %s\n", v.Version)
+		fmt.Fprintf(w, "  - %s\n", v.Version)
 	}
 }
@@ -0,0 +1,81 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package install
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRegistry = `{
+  "version": "1",
+  "plugins": {
+    "assist": {
+      "name": "assist",
+      "description": "AI assistant",
+      "versions": [
+        {"version": "0.2.0", "url": "assist/assist-0.2.0.tar.gz"},
+        {"version": "0.1.0", "url": "assist/assist-0.1.0.tar.gz"}
+      ]
+    }
+  }
+}`
+
+// runInstallCmd runs the install command against a stub registry and
+// returns what it wrote to stdout and stderr
+func runInstallCmd(t *testing.T, args ...string) (string, string, error) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(testRegistry))
+	}))
+	t.Cleanup(server.Close)
+
+	var out, diag bytes.Buffer
+
+	cmd := Cmd()
+	// Cobra sends usage to the out writer once one is set; without one it
+	// goes to stderr like the rest of the diagnostics
+	cmd.SilenceUsage = true
+	cmd.SetOut(&out)
+	cmd.SetErr(&diag)
+	cmd.SetArgs(append([]string{"--registry-url", server.URL + "/index.json"}, args...))
+
+	err := cmd.Execute()
+
+	return out.String(), diag.String(), err
+}
+
+func TestInstallListVersionsOnStdout(t *testing.T) {
+	out, diag, err := runInstallCmd(t, "assist", "--versions")
+	require.NoError(t, err)
+
+	assert.Contains(t, out, "  - 0.2.0\n  - 0.1.0\n")
+	assert.NotContains(t, diag, "0.2.0")
+}
+
+func TestInstallUnknownPluginReportsOnStderr(t *testing.T) {
+	out, diag, err := runInstallCmd(t, "missing")
+	require.Error(t, err)
+
+	assert.Empty(t, out)
+	assert.Contains(t, diag, "Installing Plugin")
+	assert.Contains(t, diag, "  - assist (0.2.0): AI assistant\n")
+}
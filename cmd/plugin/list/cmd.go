@@ -21,6 +21,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
 	"github.com/datarobot/cli/internal/plugin"
+	"github.com/datarobot/cli/internal/printer"
 	"github.com/datarobot/cli/tui"
 	"github.com/spf13/cobra"
 )
@@ -30,16 +31,41 @@ func Cmd() *cobra.Command {
 		Use:   "list",
 		Short: "List discovered plugins",
 		Long:  "List all discovered plugins with their paths and versions. Uses cached results from CLI startup.",
-		RunE:  runList,
+		Example: `  dr plugin list
+  dr plugin list --output json`,
+		RunE: runList,
 	}
 }
 
-func runList(_ *cobra.Command, _ []string) error {
+// pluginResult is the structured form of a discovered plugin
+type pluginResult struct {
+	Name        string `json:"name"                  yaml:"name"`
+	Version     string `json:"version,omitempty"     yaml:"version,omitempty"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	Path        string `json:"path"                  yaml:"path"`
+}
+
+func runList(cmd *cobra.Command, _ []string) error {
 	plugins, err := plugin.GetPlugins()
 	if err != nil {
 		return fmt.Errorf("failed to get plugins: %w", err)
 	}
 
+	if printer.IsStructured() {
+		results := make([]pluginResult, 0, len(plugins))
+
+		for _, p := range plugins {
+			results = append(results, pluginResult{
+				Name:        p.Manifest.Name,
+				Version:     p.Manifest.Version,
+				Description: p.Manifest.Description,
+				Path:        p.Executable,
+			})
+		}
+
+		return printer.Print(cmd.OutOrStdout(), results, nil)
+	}
+
 	if len(plugins) == 0 {
 		fmt.Println("No plugins discovered.")
 		fmt.Println()
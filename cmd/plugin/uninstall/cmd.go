@@ -33,7 +33,7 @@ func Cmd() *cobra.Command {
 	}
 }
 
-func runUninstall(_ *cobra.Command, args []string) error {
+func runUninstall(cmd *cobra.Command, args []string) error {
 	pluginName := args[0]
 
 	installed, err := plugin.GetInstalledPlugins()
@@ -55,15 +55,15 @@ func runUninstall(_ *cobra.Command, args []string) error {
 		return fmt.Errorf("plugin %q is not installed as a managed plugin", pluginName)
 	}
 
-	fmt.Printf("Uninstalling %s...\n", pluginName)
+	fmt.Fprintf(cmd.ErrOrStderr(), "Uninstalling %s...\n", pluginName)
 
 	if err := plugin.UninstallPlugin(pluginName); err != nil {
 		return err
 	}
 
-	fmt.Println()
-	fmt.Println(tui.SuccessStyle.Render("✓ Successfully uninstalled " + pluginName))
-	fmt.Println()
+	fmt.Fprintln(cmd.ErrOrStderr())
+	fmt.Fprintln(cmd.OutOrStdout(), tui.SuccessStyle.Render("✓ Successfully uninstalled "+pluginName))
+	fmt.Fprintln(cmd.ErrOrStderr())
 
 	return nil
 }
@@ -17,6 +17,7 @@ package update
 import (
 	"errors"
 	"fmt"
+	"io"
 
 	"github.com/datarobot/cli/cmd/plugin/shared"
 	"github.com/datarobot/cli/internal/plugin"
@@ -48,14 +49,17 @@ If no plugin name is provided with --all, checks all installed plugins for updat
 	return cmd
 }
 
-func runUpdate(_ *cobra.Command, args []string) error {
+func runUpdate(cmd *cobra.Command, args []string) error {
+	// Progress and warnings go to stderr, leaving stdout to the result
+	out, diag := cmd.OutOrStdout(), cmd.ErrOrStderr()
+
 	installed, err := plugin.GetInstalledPlugins()
 	if err != nil {
 		return fmt.Errorf("failed to get installed plugins: %w", err)
 	}
 
 	if len(installed) == 0 {
-		fmt.Println("No managed plugins installed.")
+		fmt.Fprintln(out, "No managed plugins installed.")
 
 		return nil
 	}
@@ -67,23 +71,23 @@ func runUpdate(_ *cobra.Command, args []string) error {
 
 	finalRegistryURL := shared.NormalizeRegistryURL(registryURL)
 
-	fmt.Printf("Fetching plugin registry from %s...\n", finalRegistryURL)
+	fmt.Fprintf(diag, "Fetching plugin registry from %s...\n", finalRegistryURL)
 
 	registry, baseURL, err := plugin.FetchRegistry(finalRegistryURL)
 	if err != nil {
 		return fmt.Errorf("failed to fetch plugin registry: %w", err)
 	}
 
-	fmt.Println()
+	fmt.Fprintln(diag)
 
-	updated := updatePlugins(toUpdate, registry, baseURL)
+	updated := updatePlugins(out, diag, toUpdate, registry, baseURL)
 
-	fmt.Println()
+	fmt.Fprintln(diag)
 
 	if updated > 0 {
-		fmt.Printf("Updated %d plugin(s)\n", updated)
+		fmt.Fprintf(out, "Updated %d plugin(s)\n", updated)
 	} else {
-		fmt.Println("All plugins are up to date.")
+		fmt.Fprintln(out, "All plugins are up to date.")
 	}
 
 	return nil
@@ -109,11 +113,11 @@ func selectPluginsToUpdate(args []string, installed []plugin.InstalledPlugin) ([
 	return nil, errors.New("specify a plugin name or use --all to update all plugins")
 }
 
-func updatePlugins(toUpdate []plugin.InstalledPlugin, registry *plugin.PluginRegistry, baseURL string) int {
+func updatePlugins(out, diag io.Writer, toUpdate []plugin.InstalledPlugin, registry *plugin.PluginRegistry, baseURL string) int {
 	var updated int
 
 	for _, p := range toUpdate {
-		if updateSinglePlugin(p, registry, baseURL) {
+		if updateSinglePlugin(out, diag, p, registry, baseURL) {
 			updated++
 		}
 	}
@@ -121,65 +125,67 @@ func updatePlugins(toUpdate []plugin.InstalledPlugin, registry *plugin.PluginReg
 	return updated
 }
 
-func updateSinglePlugin(p plugin.InstalledPlugin, registry *plugin.PluginRegistry, baseURL string) bool {
+// updateSinglePlugin writes what became of p to out, and progress and
+// failures to diag
+func updateSinglePlugin(out, diag io.Writer, p plugin.InstalledPlugin, registry *plugin.PluginRegistry, baseURL string) bool {
 	pluginEntry, ok := registry.Plugins[p.Name]
 	if !ok {
-		fmt.Printf("⚠ Plugin %s not found in registry, skipping\n", p.Name)
+		fmt.Fprintf(diag, "⚠ Plugin %s not found in registry, skipping\n", p.Name)
 
 		return false
 	}
 
 	latestVersion, err := plugin.ResolveVersion(pluginEntry.Versions, "latest")
 	if err != nil {
-		fmt.Printf("⚠ Failed to resolve latest version for %s: %v\n", p.Name, err)
+		fmt.Fprintf(diag, "⚠ Failed to resolve latest version for %s: %v\n", p.Name, err)
 
 		return false
 	}
 
 	if p.Version == latestVersion.Version {
-		fmt.Printf("✓ %s is already at the latest version (%s)\n", p.Name, p.Version)
+		fmt.Fprintf(out, "✓ %s is already at the latest version (%s)\n", p.Name, p.Version)
 
 		return false
 	}
 
-	fmt.Printf("Updating %s from %s to %s...\n", p.Name, p.Version, latestVersion.Version)
+	fmt.Fprintf(diag, "Updating %s from %s to %s...\n", p.Name, p.Version, latestVersion.Version)
 
 	backupPath, err := plugin.BackupPlugin(p.Name)
 	if err != nil {
-		fmt.Printf("✗ Failed to backup %s: %v\n", p.Name, err)
+		fmt.Fprintf(diag, "✗ Failed to backup %s: %v\n", p.Name, err)
 
 		return false
 	}
 	defer plugin.CleanupBackup(backupPath)
 
 	if err := plugin.InstallPlugin(pluginEntry, *latestVersion, baseURL); err != nil {
-		fmt.Printf("✗ Failed to update %s: %v\n", p.Name, err)
-		fmt.Printf("Rolling back to previous version...\n")
+		fmt.Fprintf(diag, "✗ Failed to update %s: %v\n", p.Name, err)
+		fmt.Fprintf(diag, "Rolling back to previous version...\n")
 
 		if restoreErr := plugin.RestorePlugin(p.Name, backupPath); restoreErr != nil {
-			fmt.Printf("✗ Failed to restore backup: %v\n", restoreErr)
+			fmt.Fprintf(diag, "✗ Failed to restore backup: %v\n", restoreErr)
 		} else {
-			fmt.Printf("✓ Restored previous version\n")
+			fmt.Fprintf(diag, "✓ Restored previous version\n")
 		}
 
 		return false
 	}
 
 	if err := plugin.ValidatePlugin(p.Name); err != nil {
-		fmt.Printf("✗ Plugin validation failed: %v\n", err)
-		fmt.Printf("Rolling back to previous version...\n")
+		fmt.Fprintf(diag, "✗ Plugin validation failed: %v\n", err)
+		fmt.Fprintf(diag, "Rolling back to previous version...\n")
 
 		if restoreErr := plugin.RestorePlugin(p.Name, backupPath); restoreErr != nil {
-			fmt.Printf("✗ Failed to restore backup: %v\n", restoreErr)
+			fmt.Fprintf(diag, "✗ Failed to restore backup: %v\n", restoreErr)
 		} else {
-			fmt.Printf("✓ Restored previous version\n")
+			fmt.Fprintf(diag, "✓ Restored previous version\n")
 		}
 
 		return false
 	}
 
-	fmt.Println(tui.SuccessStyle.Render("✓ Updated " + p.Name + " to " + latestVersion.Version))
-	fmt.Println()
+	fmt.Fprintln(out, tui.SuccessStyle.Render("✓ Updated "+p.Name+" to "+latestVersion.Version))
+	fmt.Fprintln(diag)
 
 	return true
 }
@@ -0,0 +1,84 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+// printConfigPathKey is the flag that prints the config file in use and
+// exits, without running the command
+const printConfigPathKey = "print-config-path"
+
+type configPathResult struct {
+	Path   string `json:"path"`
+	Found  bool   `json:"found"`
+	Source string `json:"source"`
+}
+
+// printConfigPath prints the config file resolved from --config,
+// DATAROBOT_CLI_CONFIG, or the default location, and stops cmd from
+// running
+func printConfigPath(cmd *cobra.Command) error {
+	skipRun(cmd)
+
+	result := configPathResult{
+		Path:   config.LoadedConfigFile(),
+		Source: configSource(cmd),
+	}
+	result.Found = result.Path != ""
+
+	return printer.Print(cmd.OutOrStdout(), result, func(w io.Writer) error {
+		switch {
+		case result.Path == config.StdinConfig:
+			_, err := fmt.Fprintln(w, "stdin")
+
+			return err
+		case result.Found:
+			_, err := fmt.Fprintln(w, result.Path)
+
+			return err
+		default:
+			_, err := fmt.Fprintln(w, "none found")
+
+			return err
+		}
+	})
+}
+
+// configSource names where the config file location came from
+func configSource(cmd *cobra.Command) string {
+	switch {
+	case cmd.Flags().Changed("config"):
+		return "flag"
+	case configFilePath != "":
+		return "env"
+	default:
+		return "default"
+	}
+}
+
+// skipRun leaves only the persistent hooks of cmd to run, for flags that
+// print an answer instead of running the command
+func skipRun(cmd *cobra.Command) {
+	cmd.PreRun, cmd.PreRunE = nil, nil
+	cmd.Run, cmd.PostRun, cmd.PostRunE = nil, nil, nil
+	cmd.RunE = func(*cobra.Command, []string) error { return nil }
+}
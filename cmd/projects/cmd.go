@@ -0,0 +1,36 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package projects
+
+import (
+	"github.com/datarobot/cli/cmd/projects/list"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "projects",
+		Aliases: []string{"project"},
+		GroupID: "core",
+		Short:   "🧪 DataRobot projects commands",
+		Long: `Commands for your DataRobot modeling projects.
+
+💡 Run 'dr auth login' first to connect to your DataRobot instance.`,
+	}
+
+	cmd.AddCommand(list.Cmd())
+
+	return cmd
+}
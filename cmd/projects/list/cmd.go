@@ -0,0 +1,216 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package list
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/datarobot/cli/internal/misc/since"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/internal/watch"
+	"github.com/spf13/cobra"
+)
+
+// sortKeys lists the values --sort accepts
+var sortKeys = []string{"created", "name"}
+
+type options struct {
+	sort         string
+	limit        int
+	maxPages     int
+	nameContains string
+	since        string
+	createdSince time.Time // since, once parsed
+	fields       []string
+	watch        watch.Flags
+}
+
+// columns are the table columns, in their default order
+var columns = []printer.Column[drapi.Project]{
+	{Field: "id", Header: "ID", Value: func(p drapi.Project) string { return p.ID }},
+	{Field: "projectName", Header: "NAME", Value: func(p drapi.Project) string { return p.Name }},
+	{Field: "created", Header: "CREATED", Value: createdText},
+}
+
+func createdText(p drapi.Project) string {
+	if p.Created.IsZero() {
+		return "-"
+	}
+
+	return p.Created.Local().Format("2006-01-02 15:04")
+}
+
+func Run(ctx context.Context, w io.Writer, opts options) error {
+	if opts.sort != "" && !slices.Contains(sortKeys, opts.sort) {
+		return fmt.Errorf("Unknown sort %q (must be one of: %s).", opts.sort, strings.Join(sortKeys, ", "))
+	}
+
+	createdSince, err := since.Parse(opts.since, time.Now())
+	if err != nil {
+		return err
+	}
+
+	opts.createdSince = createdSince
+
+	fields, err := printer.ParseFields(opts.fields, drapi.Project{})
+	if err != nil {
+		return err
+	}
+
+	if opts.watch.Enabled {
+		return watch.Run(ctx, watch.Options[drapi.Project]{
+			Interval: opts.watch.Interval,
+			Columns:  printer.SelectColumns(columns, fields),
+			Key:      func(p drapi.Project) string { return p.ID },
+			Fetch: func(ctx context.Context) ([]drapi.Project, error) {
+				return fetchProjects(ctx, opts)
+			},
+			Empty: emptyMessage(opts),
+		})
+	}
+
+	// Records stream as they arrive unless they have to be sorted first
+	if printer.IsStreaming() && opts.sort == "" {
+		return drapi.ListProjects(ctx, opts.nameContains, opts.createdSince, opts.limit, opts.maxPages, func(project drapi.Project) error {
+			record, err := fields.Select(project)
+			if err != nil {
+				return err
+			}
+
+			return printer.WriteRecord(w, record)
+		})
+	}
+
+	projects, err := fetchProjects(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	selected, err := fields.Select(projects)
+	if err != nil {
+		return err
+	}
+
+	return printer.Print(w, selected, func(w io.Writer) error {
+		if len(projects) == 0 {
+			_, err := fmt.Fprintln(w, emptyMessage(opts))
+
+			return err
+		}
+
+		return printer.WriteTable(w, printer.SelectColumns(columns, fields), projects)
+	})
+}
+
+// fetchProjects returns the projects to show, sorted and limited
+func fetchProjects(ctx context.Context, opts options) ([]drapi.Project, error) {
+	// Sorting needs every project, so the limit is applied afterwards.
+	fetchLimit := opts.limit
+	if opts.sort != "" {
+		fetchLimit = 0
+	}
+
+	projects, err := drapi.GetProjects(ctx, opts.nameContains, opts.createdSince, fetchLimit, opts.maxPages)
+	if err != nil {
+		return nil, err
+	}
+
+	sortProjects(projects, opts.sort)
+
+	if opts.limit > 0 && len(projects) > opts.limit {
+		projects = projects[:opts.limit]
+	}
+
+	return projects, nil
+}
+
+// sortProjects orders projects newest first by "created", or
+// alphabetically by "name". Any other key keeps the API's order.
+func sortProjects(projects []drapi.Project, key string) {
+	switch key {
+	case "created":
+		slices.SortStableFunc(projects, func(a, b drapi.Project) int {
+			return b.Created.Compare(a.Created)
+		})
+	case "name":
+		slices.SortStableFunc(projects, func(a, b drapi.Project) int {
+			return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+		})
+	}
+}
+
+func emptyMessage(opts options) string {
+	if opts.nameContains != "" {
+		return fmt.Sprintf("No projects have a name containing %q.", opts.nameContains)
+	}
+
+	if opts.since != "" {
+		return fmt.Sprintf("No projects were created since %s.", opts.since)
+	}
+
+	return "You don't have any projects yet. Create one in the DataRobot application to see it here."
+}
+
+func Cmd() *cobra.Command {
+	var opts options
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "📋 List your DataRobot projects",
+		Long: `List the projects you have access to, with their ID, name, and creation time.
+
+All pages of results are fetched unless --limit is given, up to --max-pages
+pages (50 by default); stopping there warns that the list is truncated.
+Use --sort to order the projects newest first (created) or alphabetically
+(name), and --name-contains to keep only the projects whose name includes
+some text. Use --since to keep only the projects created at or after a
+time, given as an RFC3339 timestamp or as a duration back from now such as
+7d or 24h. Use --fields to choose the columns, or the JSON fields, to show.
+
+With --watch, the table stays on screen and is fetched again every
+--interval (5s by default), highlighting the rows that changed since the
+previous refresh, until you press q or Ctrl-C. --watch needs a terminal.`,
+		Example: `  dr projects list
+  dr projects list --sort created --limit 5
+  dr projects list --name-contains churn --output json
+  dr projects list --since 2w --sort created
+  dr projects list --watch --sort created --limit 10`,
+		Args:    cobra.NoArgs,
+		PreRunE: auth.EnsureAuthenticatedE,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return Run(cmd.Context(), cmd.OutOrStdout(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.sort, "sort", "", "Order projects by "+strings.Join(sortKeys, " or "))
+	cmd.Flags().IntVar(&opts.limit, "limit", 0, "Show at most this many projects (0 shows all)")
+	cmd.Flags().IntVar(&opts.maxPages, "max-pages", apiclient.DefaultMaxPages, "Stop after fetching this many pages of results (0 for no limit)")
+	cmd.Flags().StringSliceVar(&opts.fields, "fields", nil, "Comma-separated fields to show, such as id,projectName")
+	cmd.Flags().StringVar(&opts.nameContains, "name-contains", "", "Only show projects whose name contains this text, ignoring case")
+	since.AddFlag(cmd, &opts.since, "projects")
+	watch.AddFlags(cmd, &opts.watch)
+
+	_ = cmd.RegisterFlagCompletionFunc("sort", cobra.FixedCompletions(sortKeys, cobra.ShellCompDirectiveNoFileComp))
+
+	return cmd
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package list
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSortProjects(t *testing.T) {
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	projects := func() []drapi.Project {
+		return []drapi.Project{
+			{ID: "a", Name: "beta", Created: base},
+			{ID: "b", Name: "Alpha", Created: base.Add(2 * time.Hour)},
+			{ID: "c", Name: "gamma", Created: base.Add(time.Hour)},
+		}
+	}
+
+	ids := func(projects []drapi.Project) []string {
+		var result []string
+
+		for _, project := range projects {
+			result = append(result, project.ID)
+		}
+
+		return result
+	}
+
+	created := projects()
+	sortProjects(created, "created")
+	assert.Equal(t, []string{"b", "c", "a"}, ids(created))
+
+	named := projects()
+	sortProjects(named, "name")
+	assert.Equal(t, []string{"b", "a", "c"}, ids(named))
+
+	unsorted := projects()
+	sortProjects(unsorted, "")
+	assert.Equal(t, []string{"a", "b", "c"}, ids(unsorted))
+}
+
+func TestRunRejectsUnknownSort(t *testing.T) {
+	err := Run(context.Background(), nil, options{sort: "size"})
+
+	assert.EqualError(t, err, `Unknown sort "size" (must be one of: created, name).`)
+}
+
+func TestEmptyMessage(t *testing.T) {
+	assert.Contains(t, emptyMessage(options{}), "You don't have any projects yet.")
+	assert.Equal(t, `No projects have a name containing "churn".`, emptyMessage(options{nameContains: "churn"}))
+}
+
+func TestRunRejectsInvalidSince(t *testing.T) {
+	err := Run(context.Background(), nil, options{since: "7 days"})
+
+	assert.ErrorContains(t, err, `Invalid --since "7 days".`)
+}
+
+func TestEmptyMessageSince(t *testing.T) {
+	assert.Equal(t, "No projects were created since 7d.", emptyMessage(options{since: "7d"}))
+}
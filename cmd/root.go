@@ -15,33 +15,58 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
+	"runtime"
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/x/ansi"
 	"github.com/datarobot/cli/cmd/allcommands"
+	"github.com/datarobot/cli/cmd/api"
 	"github.com/datarobot/cli/cmd/auth"
+	"github.com/datarobot/cli/cmd/cache"
 	"github.com/datarobot/cli/cmd/component"
+	contextCmd "github.com/datarobot/cli/cmd/context"
 	"github.com/datarobot/cli/cmd/dependencies"
+	"github.com/datarobot/cli/cmd/deployments"
 	"github.com/datarobot/cli/cmd/dotenv"
+	"github.com/datarobot/cli/cmd/endpoints"
+	"github.com/datarobot/cli/cmd/initcmd"
+	"github.com/datarobot/cli/cmd/open"
 	"github.com/datarobot/cli/cmd/plugin"
+	"github.com/datarobot/cli/cmd/projects"
 	"github.com/datarobot/cli/cmd/self"
+	"github.com/datarobot/cli/cmd/serverinfo"
 	"github.com/datarobot/cli/cmd/start"
 	"github.com/datarobot/cli/cmd/task"
 	"github.com/datarobot/cli/cmd/task/run"
+	"github.com/datarobot/cli/cmd/telemetry"
 	"github.com/datarobot/cli/cmd/templates"
+	"github.com/datarobot/cli/cmd/whoami"
+	"github.com/datarobot/cli/internal/apiclient"
 	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/datarobot/cli/internal/errs"
 	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/misc/confirm"
 	internalPlugin "github.com/datarobot/cli/internal/plugin"
+	"github.com/datarobot/cli/internal/printer"
+	internalTelemetry "github.com/datarobot/cli/internal/telemetry"
 	internalVersion "github.com/datarobot/cli/internal/version"
 	"github.com/datarobot/cli/tui"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"golang.org/x/term"
 )
 
-var configFilePath string
+var (
+	configFilePath string
+	outputFormat   = printer.FormatText
+)
 
 // RootCmd represents the base command when called without any subcommands
 var RootCmd = &cobra.Command{
@@ -71,7 +96,42 @@ using pre-built templates. Get from idea to production in minutes, not hours.
 
 		log.Start()
 
-		return initializeConfig(cmd)
+		if err := initializeConfig(cmd); err != nil {
+			return errs.NewConfigError("", err)
+		}
+
+		if show, _ := cmd.Flags().GetBool(printConfigPathKey); show {
+			return printConfigPath(cmd)
+		}
+
+		builtinPatterns := !viper.IsSet(log.RedactBuiltinPatternsKey) || viper.GetBool(log.RedactBuiltinPatternsKey)
+		if err := log.SetRedactPatterns(viper.GetStringSlice(log.RedactPatternsKey), builtinPatterns); err != nil {
+			return errs.NewConfigError("", err)
+		}
+
+		if err := log.CheckFormat(); err != nil {
+			return errs.NewConfigError("", err)
+		}
+
+		// no-color and log-format may come from the config file, read
+		// only just now
+		if viper.GetBool(tui.NoColorKey) {
+			tui.DisableColor()
+		}
+
+		log.StartStderr()
+
+		if err := log.StartTranscript(viper.GetString(log.FileKey), viper.GetBool(log.FileAppendKey)); err != nil {
+			return err
+		}
+
+		return openTraceFile()
+	},
+	// Without a command, default-command decides what runs; flags it
+	// doesn't know are checked there
+	FParseErrWhitelist: cobra.FParseErrWhitelist{UnknownFlags: true},
+	RunE: func(cmd *cobra.Command, _ []string) error {
+		return runDefaultCommand(cmd, invokedArgs)
 	},
 	PersistentPostRun: func(_ *cobra.Command, _ []string) {
 		log.Stop()
@@ -82,7 +142,51 @@ using pre-built templates. Get from idea to production in minutes, not hours.
 // It adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func ExecuteContext(ctx context.Context) error {
-	return RootCmd.ExecuteContext(ctx)
+	// Errors are printed here rather than by cobra so they can be emitted
+	// as structured objects when --output json or yaml is selected
+	RootCmd.SilenceErrors = true
+
+	invokedArgs = os.Args[1:]
+
+	registerInvokedPlugin(invokedArgs)
+
+	started := time.Now()
+
+	cmd, err := RootCmd.ExecuteContextC(ctx)
+
+	if closeTraceFile != nil {
+		_ = closeTraceFile()
+	}
+
+	if err != nil {
+		log.Debug("Command failed", "exit_code", errs.ExitCode(err), "error", errs.Detail(err))
+		printer.PrintError(cmd.ErrOrStderr(), err)
+	}
+
+	// Shell completion runs on every tab press, so it isn't a command run
+	if cmd.Name() != cobra.ShellCompRequestCmd && cmd.Name() != cobra.ShellCompNoDescRequestCmd {
+		internalTelemetry.Record(ctx, cmd.CommandPath(), time.Since(started), err == nil)
+
+		if err == nil {
+			showTelemetryNotice(cmd)
+		}
+	}
+
+	return err
+}
+
+// showTelemetryNotice tells interactive users once that telemetry exists
+// and is off until they opt in
+func showTelemetryNotice(cmd *cobra.Command) {
+	if !internalTelemetry.ShouldShowNotice() || viper.GetBool("quiet") || !term.IsTerminal(int(os.Stderr.Fd())) {
+		return
+	}
+
+	fmt.Fprintln(cmd.ErrOrStderr(), tui.DimStyle.Render(
+		"ℹ️ dr can send anonymous usage metrics (command names, durations, and success or failure) to help improve it.\n"+
+			"   Telemetry is off. Run 'dr telemetry enable' to opt in; this notice won't be shown again."))
+
+	internalTelemetry.MarkNoticeShown()
 }
 
 func init() {
@@ -97,23 +201,101 @@ func init() {
 
 	// Configure persistent flags
 	RootCmd.PersistentFlags().StringVar(&configFilePath, "config", "",
-		"path to config file (default location: $HOME/.config/datarobot/drconfig.yaml)")
+		"path to config file, or - to read it from stdin (default location: drconfig.yaml in the config directory)")
+	RootCmd.PersistentFlags().String(config.ConfigTypeKey, "yaml", "format of a config read from stdin with --config -: yaml or json")
+	RootCmd.PersistentFlags().String(config.ConfigDirKey, "",
+		"directory for the config file, caches, sessions, plugins, and token files (default: $XDG_CONFIG_HOME/datarobot or the platform equivalent)")
+	RootCmd.PersistentFlags().Bool(printConfigPathKey, false, "print the path of the config file in use, or \"none found\", and exit")
+	RootCmd.PersistentFlags().Bool(config.AllowUnsetEnvKey, false, "expand ${VAR} references to unset environment variables in the config file to an empty string instead of failing")
+	RootCmd.PersistentFlags().Bool(config.NoAutoMigrateKey, false, "don't rename deprecated keys when reading a config file from an older version (see dr self config migrate)")
+	RootCmd.PersistentFlags().String(config.ContextNameKey, "", "context to use: an endpoint, credentials, and default template (overrides the current context in the config file)")
+	RootCmd.PersistentFlags().String("profile", "", "configuration profile to use (overrides the default profile in the config file)")
+	RootCmd.PersistentFlags().VarP(&outputFormat, "output", "o",
+		fmt.Sprintf("output format (options: %s)", strings.Join(printer.Formats, ", ")))
 	RootCmd.PersistentFlags().BoolP("version", "V", false, "display the version")
-	RootCmd.PersistentFlags().BoolP("verbose", "v", false, "verbose output")
+	RootCmd.PersistentFlags().CountP("verbose", "v", "verbose output (repeat as -vv for trace output with HTTP request summaries)")
+	RootCmd.PersistentFlags().BoolP("quiet", "q", false, "only log errors and suppress progress output")
 	RootCmd.PersistentFlags().Bool("debug", false, "debug output")
 	RootCmd.PersistentFlags().Bool("all-commands", false, "display all available commands and their flags in tree format")
+	RootCmd.PersistentFlags().BoolP(confirm.AssumeYesKey, "y", false, "answer yes to every confirmation prompt (without a terminal, prompts fail unless this is set)")
 	RootCmd.PersistentFlags().Bool("skip-auth", false, "skip authentication checks (for advanced users)")
 	RootCmd.PersistentFlags().Bool("force-interactive", false, "force setup wizards to run even if already completed")
+	RootCmd.PersistentFlags().String(config.DataRobotAPIKey, "", "API token to use (visible to other processes; prefer --token-file)")
+	RootCmd.PersistentFlags().String(config.TokenFileKey, "", "read the API token from this file (must not be world-readable)")
+	RootCmd.PersistentFlags().Duration("timeout", apiclient.DefaultTimeout, "time allowed for each API request, including retries (0 disables)")
+	RootCmd.PersistentFlags().Int(apiclient.MaxRetriesKey, apiclient.DefaultMaxRetries,
+		"how many times to retry API requests that fail with a network error, 429, or 5xx (0 disables)")
+	RootCmd.PersistentFlags().Float64(apiclient.RateLimitKey, 0,
+		"most API requests to send per second, retries included; requests over the limit wait their turn (0 disables)")
+	RootCmd.PersistentFlags().Bool(apiclient.OfflineKey, false,
+		"don't connect to the network: skip the update check and authentication checks, and use cached templates (commands that need the network fail)")
+	RootCmd.PersistentFlags().String(apiclient.ProxyKey, "", "proxy URL for outbound requests (overrides HTTP_PROXY and HTTPS_PROXY)")
+	RootCmd.PersistentFlags().String(apiclient.CACertKey, "", "PEM bundle of additional CA certificates to trust")
+	RootCmd.PersistentFlags().Bool(apiclient.InsecureSkipTLSVerifyKey, false, "skip TLS certificate verification (development only)")
+	RootCmd.PersistentFlags().String(apiclient.TraceFileKey, "", "write a JSON record of every HTTP request and response to this file (credentials redacted)")
+	RootCmd.PersistentFlags().Bool(apiclient.TraceAppendKey, false, "append to the trace file instead of truncating it")
+	RootCmd.PersistentFlags().String(drapi.TemplatesDirKey, "", "read templates from this local directory instead of the DataRobot catalog")
+	RootCmd.PersistentFlags().String(log.FileKey, "", "write a timestamped transcript of log and script output to this file (secrets redacted)")
+	RootCmd.PersistentFlags().Bool(log.FileAppendKey, false, "append to the log file instead of truncating it")
+	RootCmd.PersistentFlags().String(log.FormatKey, log.FormatText, "format of log lines on stderr: text, or json for one JSON object per line (a non-terminal then gets no TUI)")
+	RootCmd.PersistentFlags().Bool(tui.NoColorKey, false, "disable colored output (also set by NO_COLOR, and the default when stdout is not a terminal)")
+	RootCmd.PersistentFlags().Duration(apiclient.PollIntervalKey, apiclient.DefaultPollInterval, "first wait between status checks of a long-running operation; later waits double, up to 30s")
+	RootCmd.PersistentFlags().Duration(apiclient.PollTimeoutKey, apiclient.DefaultPollTimeout, "how long to wait for a long-running operation to finish (0 waits as long as it takes)")
 	RootCmd.PersistentFlags().Duration("plugin-discovery-timeout", 2*time.Second, "timeout for plugin discovery (0s disables)")
 
 	// Make some of these flags available via Viper
+	RootCmd.Flags().Bool(start.SkipOnboardingKey, false, "show the help instead of the first-run setup wizard")
+
 	_ = viper.BindPFlag("config", RootCmd.PersistentFlags().Lookup("config"))
+	_ = viper.BindPFlag(start.SkipOnboardingKey, RootCmd.Flags().Lookup(start.SkipOnboardingKey))
+	_ = viper.BindPFlag(config.ConfigTypeKey, RootCmd.PersistentFlags().Lookup(config.ConfigTypeKey))
+	_ = viper.BindPFlag(config.ConfigDirKey, RootCmd.PersistentFlags().Lookup(config.ConfigDirKey))
+	_ = viper.BindPFlag(config.AllowUnsetEnvKey, RootCmd.PersistentFlags().Lookup(config.AllowUnsetEnvKey))
+	_ = viper.BindPFlag(config.NoAutoMigrateKey, RootCmd.PersistentFlags().Lookup(config.NoAutoMigrateKey))
+	_ = viper.BindPFlag(config.ContextNameKey, RootCmd.PersistentFlags().Lookup(config.ContextNameKey))
+	_ = viper.BindPFlag(config.ProfileKey, RootCmd.PersistentFlags().Lookup("profile"))
+	_ = viper.BindPFlag(printer.OutputKey, RootCmd.PersistentFlags().Lookup("output"))
 	_ = viper.BindPFlag("verbose", RootCmd.PersistentFlags().Lookup("verbose"))
+	_ = viper.BindPFlag("quiet", RootCmd.PersistentFlags().Lookup("quiet"))
 	_ = viper.BindPFlag("debug", RootCmd.PersistentFlags().Lookup("debug"))
+	_ = viper.BindEnv(log.LevelKey, config.EnvVarName(log.LevelKey))
+
+	RootCmd.MarkFlagsMutuallyExclusive("quiet", "verbose")
+	_ = viper.BindPFlag(confirm.AssumeYesKey, RootCmd.PersistentFlags().Lookup(confirm.AssumeYesKey))
 	_ = viper.BindPFlag("skip-auth", RootCmd.PersistentFlags().Lookup("skip-auth"))
 	_ = viper.BindPFlag("force-interactive", RootCmd.PersistentFlags().Lookup("force-interactive"))
+	_ = viper.BindPFlag(config.TokenFileKey, RootCmd.PersistentFlags().Lookup(config.TokenFileKey))
+	_ = viper.BindPFlag(apiclient.RequestTimeoutKey, RootCmd.PersistentFlags().Lookup("timeout"))
+	_ = viper.BindPFlag(apiclient.MaxRetriesKey, RootCmd.PersistentFlags().Lookup(apiclient.MaxRetriesKey))
+	_ = viper.BindPFlag(apiclient.PollIntervalKey, RootCmd.PersistentFlags().Lookup(apiclient.PollIntervalKey))
+	_ = viper.BindPFlag(apiclient.PollTimeoutKey, RootCmd.PersistentFlags().Lookup(apiclient.PollTimeoutKey))
+	_ = viper.BindPFlag(apiclient.RateLimitKey, RootCmd.PersistentFlags().Lookup(apiclient.RateLimitKey))
+	_ = viper.BindPFlag(apiclient.OfflineKey, RootCmd.PersistentFlags().Lookup(apiclient.OfflineKey))
+	_ = viper.BindPFlag(apiclient.ProxyKey, RootCmd.PersistentFlags().Lookup(apiclient.ProxyKey))
+	_ = viper.BindPFlag(apiclient.CACertKey, RootCmd.PersistentFlags().Lookup(apiclient.CACertKey))
+	_ = viper.BindPFlag(apiclient.InsecureSkipTLSVerifyKey, RootCmd.PersistentFlags().Lookup(apiclient.InsecureSkipTLSVerifyKey))
+	_ = viper.BindPFlag(apiclient.TraceFileKey, RootCmd.PersistentFlags().Lookup(apiclient.TraceFileKey))
+	_ = viper.BindPFlag(apiclient.TraceAppendKey, RootCmd.PersistentFlags().Lookup(apiclient.TraceAppendKey))
+	_ = viper.BindPFlag(drapi.TemplatesDirKey, RootCmd.PersistentFlags().Lookup(drapi.TemplatesDirKey))
+	_ = viper.BindPFlag(log.FileKey, RootCmd.PersistentFlags().Lookup(log.FileKey))
+	_ = viper.BindPFlag(log.FileAppendKey, RootCmd.PersistentFlags().Lookup(log.FileAppendKey))
+	_ = viper.BindPFlag(log.FormatKey, RootCmd.PersistentFlags().Lookup(log.FormatKey))
+	_ = viper.BindEnv(log.FormatKey, config.EnvVarName(log.FormatKey))
+	_ = viper.BindPFlag(tui.NoColorKey, RootCmd.PersistentFlags().Lookup(tui.NoColorKey))
 	_ = viper.BindPFlag("plugin-discovery-timeout", RootCmd.PersistentFlags().Lookup("plugin-discovery-timeout"))
 
+	// viper merges the flag, env var and config file into the same key, so
+	// config needs the flag itself to rank it above a stored token
+	config.RegisterTokenFlag(RootCmd.PersistentFlags().Lookup(config.DataRobotAPIKey))
+
+	_ = RootCmd.RegisterFlagCompletionFunc("profile", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return config.ProfileNames(), cobra.ShellCompDirectiveNoFileComp
+	})
+
+	_ = RootCmd.RegisterFlagCompletionFunc("output", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return printer.Formats, cobra.ShellCompDirectiveNoFileComp
+	})
+
 	// Add command groups (plugin group added conditionally by registerPluginCommands)
 	RootCmd.AddGroup(
 		&cobra.Group{ID: "core", Title: tui.BaseTextStyle.Render("Core Commands:")},
@@ -125,15 +307,26 @@ func init() {
 	// Be sure to set the command's GroupID field appropriately;
 	// otherwise the command will be added under 'Additional Commands'.
 	RootCmd.AddCommand(
+		api.Cmd(),
 		auth.Cmd(),
+		cache.Cmd(),
 		component.Cmd(),
+		contextCmd.Cmd(),
 		dependencies.Cmd(),
+		deployments.Cmd(),
 		dotenv.Cmd(),
+		endpoints.Cmd(),
+		initcmd.Cmd(),
+		open.Cmd(),
+		projects.Cmd(),
 		run.Cmd(),
 		self.Cmd(),
+		serverinfo.Cmd(),
 		start.Cmd(),
 		task.Cmd(),
+		telemetry.Cmd(),
 		templates.Cmd(),
+		whoami.Cmd(),
 		plugin.Cmd(),
 	)
 
@@ -156,28 +349,54 @@ func init() {
 		} else {
 			// Use default help behavior but with customized template
 			RootCmd.SetHelpTemplate(CustomHelpTemplate)
+
+			// The template and long descriptions are styled when the
+			// package loads, before --no-color is parsed
+			if viper.GetBool(tui.NoColorKey) {
+				var help bytes.Buffer
+
+				out := cmd.OutOrStdout()
+				cmd.SetOut(&help)
+				defaultHelpFunc(cmd, args)
+				cmd.SetOut(out)
+
+				_, _ = fmt.Fprint(out, ansi.Strip(help.String()))
+
+				return
+			}
+
 			defaultHelpFunc(cmd, args)
 		}
 	})
 }
 
+// initConfig bootstraps viper's environment handling: DATAROBOT_CLI_ (or
+// the prefix set at build time) variables map to config keys, with dashes
+// in keys replaced by underscores, and VISUAL or EDITOR feed the
+// external-editor key, which otherwise defaults to notepad on Windows and
+// vi elsewhere
+func initConfig() {
+	config.ConfigureEnv(viper.GetViper())
+
+	viper.SetDefault("external-editor", defaultEditor())
+
+	_ = viper.BindEnv("external-editor", "VISUAL", "EDITOR")
+}
+
+func defaultEditor() string {
+	if runtime.GOOS == "windows" {
+		return "notepad"
+	}
+
+	return "vi"
+}
+
 // initializeConfig initializes the configuration by reading from
 // various sources such as environment variables and config files.
 func initializeConfig(cmd *cobra.Command) error {
 	var err error
 
-	// Set up Viper to process environment variables
-	// First automatically map any environment variables
-	// that are prefixed with DATAROBOT_CLI_ to config keys
-	viper.SetEnvPrefix("DATAROBOT_CLI")
-	viper.AutomaticEnv()
-	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
-
-	// map VISUAL and EDITOR to external-editor config key,
-	// but set a default value
-	viper.SetDefault("external-editor", "vi")
-
-	_ = viper.BindEnv("external-editor", "VISUAL", "EDITOR")
+	initConfig()
 
 	// If DATAROBOT_CLI_CONFIG is set and no explicit --config flag was provided,
 	// use the environment variable value
@@ -187,10 +406,42 @@ func initializeConfig(cmd *cobra.Command) error {
 		}
 	}
 
+	// The output format may also come from DATAROBOT_CLI_OUTPUT, which
+	// bypasses the flag's own validation
+	if _, err = printer.ParseFormat(viper.GetString(printer.OutputKey)); err != nil {
+		return err
+	}
+
+	// Commands that repair the config file run even when it is broken,
+	// without the settings it would have contributed
+	lenient := cmd.Annotations[config.LenientAnnotation] != ""
+
+	// --print-config-path is for troubleshooting, so it reports the file
+	// even when it can't be read
+	if show, _ := cmd.Flags().GetBool(printConfigPathKey); show {
+		lenient = true
+	}
+
 	// Now read the config file
 	err = config.ReadConfigFile(configFilePath)
+	if err == nil {
+		// Overlay the active profile (flag, env or config file default)
+		// on top of the base config before flags are bound
+		err = config.ApplyProfile(config.ActiveProfile())
+		if err == nil {
+			// The active context is layered over the profile
+			err = config.ApplyContext(config.ActiveContext())
+		}
+	} else {
+		err = fmt.Errorf("Failed to read config file: %w", err)
+	}
+
 	if err != nil {
-		return fmt.Errorf("Failed to read config file: %w", err)
+		if !lenient {
+			return err
+		}
+
+		log.Warn(err)
 	}
 
 	// Bind Cobra flags to Viper
@@ -202,6 +453,27 @@ func initializeConfig(cmd *cobra.Command) error {
 	return nil
 }
 
+// closeTraceFile closes the --trace-file, if one was opened
+var closeTraceFile func() error
+
+// openTraceFile starts recording outbound HTTP requests when --trace-file
+// is set, so the file can be attached to a support request
+func openTraceFile() error {
+	path := viper.GetString(apiclient.TraceFileKey)
+	if path == "" {
+		return nil
+	}
+
+	closer, err := apiclient.OpenTraceFile(path, viper.GetBool(apiclient.TraceAppendKey))
+	if err != nil {
+		return err
+	}
+
+	closeTraceFile = closer
+
+	return nil
+}
+
 // registerPluginCommands discovers and registers plugin commands
 func registerPluginCommands() {
 	timeout := viper.GetDuration("plugin-discovery-timeout")
@@ -250,11 +522,7 @@ func registerPluginCommands() {
 		return
 	}
 
-	// Only add plugin group if we have plugins to show
-	RootCmd.AddGroup(&cobra.Group{
-		ID:    "plugin",
-		Title: tui.BaseTextStyle.Render("Plugin Commands:"),
-	})
+	addPluginGroup()
 
 	for _, p := range plugins {
 		// Skip if conflicts with builtin command
@@ -271,10 +539,85 @@ func registerPluginCommands() {
 	}
 }
 
+// addPluginGroup adds the help group for plugin commands. It is only added
+// once there is a plugin to show.
+func addPluginGroup() {
+	if RootCmd.ContainsGroup("plugin") {
+		return
+	}
+
+	RootCmd.AddGroup(&cobra.Group{
+		ID:    "plugin",
+		Title: tui.BaseTextStyle.Render("Plugin Commands:"),
+	})
+}
+
+// registerInvokedPlugin makes `dr <name>` run the dr-<name> plugin when
+// name isn't a command yet, so cobra only reports an unknown command once
+// the plugin search has also failed. Discovery at startup already
+// registered the plugins it found; this covers the ones it didn't, such as
+// when it was disabled or timed out.
+func registerInvokedPlugin(args []string) {
+	if _, _, err := RootCmd.Find(args); err == nil {
+		return
+	}
+
+	name := firstCommandArg(RootCmd.PersistentFlags(), args)
+
+	p, err := internalPlugin.Lookup(name)
+	if err != nil {
+		log.Debug("Plugin lookup failed", "name", name, "error", err)
+		return
+	}
+
+	if p == nil {
+		return
+	}
+
+	// The plugin runs as name even when its manifest says otherwise
+	p.Manifest.Name = name
+
+	addPluginGroup()
+	RootCmd.AddCommand(createPluginCommand(*p))
+}
+
+// firstCommandArg returns the first argument that isn't a root flag or a
+// root flag's value
+func firstCommandArg(flags *pflag.FlagSet, args []string) string {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+
+		var flag *pflag.Flag
+
+		switch {
+		case arg == "--":
+			return ""
+		case strings.HasPrefix(arg, "--"):
+			if !strings.Contains(arg, "=") {
+				flag = flags.Lookup(arg[2:])
+			}
+		case strings.HasPrefix(arg, "-") && len(arg) == 2:
+			flag = flags.ShorthandLookup(arg[1:])
+		case strings.HasPrefix(arg, "-"):
+			// Combined shorthands such as -vv, or a value attached to a shorthand
+		default:
+			return arg
+		}
+
+		// Skip the value of a flag given as two arguments
+		if flag != nil && flag.NoOptDefVal == "" {
+			i++
+		}
+	}
+
+	return ""
+}
+
 func createPluginCommand(p internalPlugin.DiscoveredPlugin) *cobra.Command {
 	executable := p.Executable // Capture for closure
 	manifest := p.Manifest     // Capture for closure
 	pluginName := p.Manifest.Name
+	bare := p.Bare
 
 	return &cobra.Command{
 		Use:                p.Manifest.Name,
@@ -283,9 +626,16 @@ func createPluginCommand(p internalPlugin.DiscoveredPlugin) *cobra.Command {
 		DisableFlagParsing: true, // Pass all args to plugin
 		DisableSuggestions: true,
 		Run: func(_ *cobra.Command, args []string) {
-			fmt.Println(tui.InfoStyle.Render("🔌 Running plugin: " + pluginName))
 			log.Debug("Executing plugin", "name", pluginName, "executable", executable)
 
+			// Plugins without a manifest get a clean stdout, so they can be
+			// used in pipelines like any other executable
+			if bare {
+				os.Exit(internalPlugin.ExecuteBarePlugin(executable, args))
+			}
+
+			fmt.Fprintln(printer.Diagnostics, tui.InfoStyle.Render("🔌 Running plugin: "+pluginName))
+
 			exitCode := internalPlugin.ExecutePlugin(manifest, executable, args)
 			os.Exit(exitCode)
 		},
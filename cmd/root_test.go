@@ -16,8 +16,23 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/datarobot/cli/cmd/start"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -79,3 +94,281 @@ func TestCaseInsensitiveCommands(t *testing.T) {
 		})
 	}
 }
+
+func TestDynamicFlagCompletion(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected []string
+	}{
+		{
+			name:     "start actions",
+			args:     []string{"__complete", "start", "--action", ""},
+			expected: start.Actions,
+		},
+		{
+			name:     "output formats",
+			args:     []string{"__complete", "--output", ""},
+			expected: printer.Formats,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			RootCmd.SetOut(buf)
+			RootCmd.SetErr(new(bytes.Buffer))
+			RootCmd.SetArgs(tt.args)
+
+			require.NoError(t, RootCmd.Execute())
+
+			// The last line is the completion directive
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+			assert.Equal(t, tt.expected, lines[:len(lines)-1])
+		})
+	}
+}
+
+func TestInitializeConfigAutomaticEnvRespectsReplacer(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv(config.EnvPrefix+"_PLUGIN_DISCOVERY_TIMEOUT", "7s")
+	t.Setenv(config.EnvPrefix+"_FORCE_INTERACTIVE", "true")
+
+	require.NoError(t, initializeConfig(RootCmd))
+
+	// Dashed keys only resolve from the environment if the replacer
+	// maps them to underscores
+	assert.Equal(t, "7s", viper.GetString("plugin-discovery-timeout"))
+	assert.True(t, viper.GetBool("force-interactive"))
+}
+
+func TestFirstCommandArg(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{name: "plain", args: []string{"foo", "bar"}, want: "foo"},
+		{name: "after a bool flag", args: []string{"--debug", "foo"}, want: "foo"},
+		{name: "after a flag value", args: []string{"--profile", "dev", "foo"}, want: "foo"},
+		{name: "after an attached value", args: []string{"--profile=dev", "foo"}, want: "foo"},
+		{name: "after a shorthand value", args: []string{"-o", "json", "foo"}, want: "foo"},
+		{name: "after combined shorthands", args: []string{"-vv", "foo"}, want: "foo"},
+		{name: "only flags", args: []string{"--debug"}, want: ""},
+		{name: "after a terminator", args: []string{"--", "foo"}, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, firstCommandArg(RootCmd.PersistentFlags(), tt.args))
+		})
+	}
+}
+
+func TestRegisterInvokedPlugin(t *testing.T) {
+	binDir := t.TempDir()
+	t.Setenv("PATH", binDir)
+	testutil.SetTestHomeDir(t, t.TempDir())
+
+	script := "#!/bin/sh\nexit 2\n"
+	require.NoError(t, os.WriteFile(filepath.Join(binDir, "dr-hello"), []byte(script), 0o755))
+
+	registerInvokedPlugin([]string{"--debug", "hello", "--flag"})
+
+	t.Cleanup(func() {
+		if cmd, _, err := RootCmd.Find([]string{"hello"}); err == nil && cmd != RootCmd {
+			RootCmd.RemoveCommand(cmd)
+		}
+	})
+
+	cmd, args, err := RootCmd.Find([]string{"--debug", "hello", "--flag"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "hello", cmd.Name())
+	assert.Equal(t, "plugin", cmd.GroupID)
+	assert.Contains(t, args, "--flag")
+}
+
+func TestRegisterInvokedPluginLeavesUnknownCommands(t *testing.T) {
+	t.Setenv("PATH", t.TempDir())
+	testutil.SetTestHomeDir(t, t.TempDir())
+
+	registerInvokedPlugin([]string{"no-such-command"})
+
+	_, _, err := RootCmd.Find([]string{"no-such-command"})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unknown command")
+}
+
+func TestInitializeConfigLenientAnnotation(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+
+	path := filepath.Join(dir, "broken.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("endpoint: [oops\n"), 0o600))
+
+	prev := configFilePath
+	configFilePath = path
+
+	t.Cleanup(func() { configFilePath = prev })
+
+	strict := &cobra.Command{Use: "strict"}
+	require.ErrorContains(t, initializeConfig(strict), "Failed to read config file")
+
+	lenient := &cobra.Command{Use: "lenient", Annotations: map[string]string{config.LenientAnnotation: "true"}}
+	require.NoError(t, initializeConfig(lenient))
+
+	used, err := config.ConfigFilePath()
+	require.NoError(t, err)
+	assert.Equal(t, path, used)
+}
+
+func TestPrintConfigPath(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+
+	path := filepath.Join(dir, "broken.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("endpoint: [oops\n"), 0o600))
+
+	prev := configFilePath
+	t.Cleanup(func() { configFilePath = prev })
+
+	run := func(configPath string) (string, bool) {
+		configFilePath = configPath
+
+		ran := false
+		cmd := &cobra.Command{Use: "test", RunE: func(*cobra.Command, []string) error {
+			ran = true
+
+			return nil
+		}}
+		cmd.Flags().Bool(printConfigPathKey, true, "")
+
+		var out bytes.Buffer
+
+		cmd.SetOut(&out)
+
+		require.NoError(t, initializeConfig(cmd))
+		require.NoError(t, printConfigPath(cmd))
+		require.NoError(t, cmd.RunE(cmd, nil))
+
+		return out.String(), ran
+	}
+
+	out, ran := run(path)
+	assert.Equal(t, path+"\n", out)
+	assert.False(t, ran)
+
+	viper.Reset()
+
+	out, _ = run("")
+	assert.Equal(t, "none found\n", out)
+}
+
+func TestStructuredOutputKeepsStdoutClean(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	testutil.SetTestHomeDir(t, t.TempDir())
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/version/":
+			fmt.Fprint(w, `{"major": 2, "minor": 36}`)
+		case "/api/v2/projects/":
+			fmt.Fprint(w, `{"data": [{"id": "p1", "projectName": "Churn", "created": "2026-01-02T10:00:00Z"}], "next": null}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	t.Setenv("DATAROBOT_ENDPOINT", server.URL)
+	t.Setenv("DATAROBOT_API_TOKEN", "test-token")
+	// Debug output includes a dump of the config
+	t.Setenv(config.EnvPrefix+"_DEBUG", "true")
+
+	// Stray writes to os.Stdout, not just cmd.OutOrStdout, would break a pipe
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+
+	realStdout := os.Stdout
+	os.Stdout = w
+
+	RootCmd.SetOut(w)
+	RootCmd.SetErr(new(bytes.Buffer))
+	RootCmd.SetArgs([]string{"projects", "list", "-o", "json"})
+
+	t.Cleanup(func() {
+		os.Stdout = realStdout
+
+		RootCmd.SetOut(nil)
+		RootCmd.SetErr(nil)
+		RootCmd.SetArgs(nil)
+	})
+
+	execErr := RootCmd.Execute()
+
+	require.NoError(t, w.Close())
+
+	os.Stdout = realStdout
+
+	out, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, execErr)
+
+	assert.True(t, json.Valid(out), "stdout should only hold the JSON result, got:\n%s", out)
+	assert.Contains(t, string(out), `"p1"`)
+}
+
+func TestSetURLOnlyChangesEndpoint(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	dir := t.TempDir()
+	testutil.SetTestHomeDir(t, dir)
+
+	configFile := filepath.Join(dir, ".config", "datarobot", "drconfig.yaml")
+	require.NoError(t, os.MkdirAll(filepath.Dir(configFile), 0o700))
+
+	const initial = `# my config
+endpoint: https://app.datarobot.com/api/v2
+profile: staging
+profiles:
+  staging:
+    proxy: http://staging-proxy:3128
+`
+
+	require.NoError(t, os.WriteFile(configFile, []byte(initial), 0o600))
+
+	// EnsureAuthenticated looks up skip_auth, which only the environment
+	// sets, so without this set-url would start the browser login
+	t.Setenv(config.EnvPrefix+"_SKIP_AUTH", "true")
+
+	RootCmd.SetOut(new(bytes.Buffer))
+	RootCmd.SetErr(new(bytes.Buffer))
+	RootCmd.SetArgs([]string{"auth", "set-url", "https://app.eu.datarobot.com", "--skip-auth"})
+
+	t.Cleanup(func() {
+		_ = RootCmd.PersistentFlags().Set("skip-auth", "false")
+
+		RootCmd.SetOut(nil)
+		RootCmd.SetErr(nil)
+		RootCmd.SetArgs(nil)
+	})
+
+	require.NoError(t, RootCmd.Execute())
+
+	data, err := os.ReadFile(configFile)
+	require.NoError(t, err)
+
+	want := strings.Replace(initial, "https://app.datarobot.com/api/v2", "https://app.eu.datarobot.com/api/v2", 1)
+	assert.Equal(t, want, string(data))
+}
@@ -17,6 +17,7 @@ package self
 import (
 	"github.com/datarobot/cli/cmd/self/completion"
 	"github.com/datarobot/cli/cmd/self/config"
+	"github.com/datarobot/cli/cmd/self/doctor"
 	selfplugin "github.com/datarobot/cli/cmd/self/plugin"
 	"github.com/datarobot/cli/cmd/self/update"
 	"github.com/datarobot/cli/cmd/self/version"
@@ -33,6 +34,7 @@ func Cmd() *cobra.Command {
 	cmd.AddCommand(
 		completion.Cmd(),
 		config.Cmd(),
+		doctor.Cmd(),
 		selfplugin.Cmd(),
 		update.Cmd(),
 		version.Cmd(),
@@ -17,7 +17,6 @@ package completion
 import (
 	"errors"
 	"fmt"
-	"os"
 	"strings"
 
 	"github.com/datarobot/cli/cmd/self/completion/install"
@@ -34,17 +33,20 @@ func Cmd() *cobra.Command {
 		Long: `Generate shell completion script for supported shells. This will be output
 		to stdout so it can be redirected to the appropriate location.
 
+Completions are dynamic: profile names from your config file, start actions,
+output formats, and other known flag values are suggested as you type.
+
 You can also use the 'install' subcommand to install completions interactively.`,
 		Example: `To load completions:
 
 Bash:
 
-  $ source <(` + version.CliName + ` completion bash)
+  $ source <(` + version.CliName + ` self completion bash)
 
   # To load completions for each session, execute once:
 
   # Linux:
-  $ ` + version.CliName + ` completion bash > /etc/bash_completion.d/` + version.CliName + `
+  $ ` + version.CliName + ` self completion bash > /etc/bash_completion.d/` + version.CliName + `
 
 Zsh:
 
@@ -53,21 +55,21 @@ Zsh:
   $ echo "autoload -U compinit; compinit" >> ~/.zshrc
 
   # Linux or MacOS:
-  $ ` + version.CliName + ` completion zsh > ${ZDOTDIR:-$HOME}/.zsh/completions/_dr` + version.CliName + `
+  $ ` + version.CliName + ` self completion zsh > ${ZDOTDIR:-$HOME}/.zsh/completions/_` + version.CliName + `
 
 Fish:
 
-  $ ` + version.CliName + ` completion fish | source
+  $ ` + version.CliName + ` self completion fish | source
 
   # To load completions for each session, execute once:
-  $ ` + version.CliName + ` completion fish > ~/.config/fish/completions/` + version.CliName + `.fish
+  $ ` + version.CliName + ` self completion fish > ~/.config/fish/completions/` + version.CliName + `.fish
 
 PowerShell:
 
-  PS> ` + version.CliName + ` completion powershell | Out-String | Invoke-Expression
+  PS> ` + version.CliName + ` self completion powershell | Out-String | Invoke-Expression
 
   # To load completions for every new session, run:
-  PS> ` + version.CliName + ` completion powershell > ` + version.CliName + `.ps1
+  PS> ` + version.CliName + ` self completion powershell > ` + version.CliName + `.ps1
   # and source it from your PowerShell profile.
 `,
 		DisableFlagsInUseLine: true,
@@ -84,15 +86,15 @@ PowerShell:
 				cmd.SilenceUsage = true
 				return errors.New("No shell provided.")
 			case internalShell.Bash:
-				return cmd.Root().GenBashCompletion(os.Stdout)
+				return cmd.Root().GenBashCompletion(cmd.OutOrStdout())
 			case internalShell.Zsh:
 				// Cobra v1.1.1+ supports GenZshCompletion
-				return cmd.Root().GenZshCompletion(os.Stdout)
+				return cmd.Root().GenZshCompletion(cmd.OutOrStdout())
 			case internalShell.Fish:
 				// the `true` gives fish the "__fish_use_subcommand" behavior
-				return cmd.Root().GenFishCompletion(os.Stdout, true)
+				return cmd.Root().GenFishCompletion(cmd.OutOrStdout(), true)
 			case internalShell.PowerShell:
-				return cmd.Root().GenPowerShellCompletionWithDesc(os.Stdout)
+				return cmd.Root().GenPowerShellCompletionWithDesc(cmd.OutOrStdout())
 			default:
 				cmd.SilenceUsage = true
 				return fmt.Errorf("Unsupported shell %q.", args[0])
@@ -25,7 +25,8 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/datarobot/cli/internal/fsutil"
-	"github.com/datarobot/cli/internal/misc/reader"
+	"github.com/datarobot/cli/internal/misc/confirm"
+	"github.com/datarobot/cli/internal/printer"
 	internalShell "github.com/datarobot/cli/internal/shell"
 	"github.com/datarobot/cli/internal/version"
 	"github.com/spf13/cobra"
@@ -60,20 +61,20 @@ This command will:
 
 By default, this command runs in preview mode. Use '--yes' to install directly.`,
 		Example: `  # Preview what would be installed (default behavior):
-  ` + version.CliName + ` completion install
+  ` + version.CliName + ` self completion install
 
   # Install completions for your current shell:
-  ` + version.CliName + ` completion install --yes
+  ` + version.CliName + ` self completion install --yes
 
   # Install completions for a specific shell:
-  ` + version.CliName + ` completion install bash --yes
-  ` + version.CliName + ` completion install zsh --yes
+  ` + version.CliName + ` self completion install bash --yes
+  ` + version.CliName + ` self completion install zsh --yes
 
   # Preview installation for a specific shell:
-  ` + version.CliName + ` completion install bash
+  ` + version.CliName + ` self completion install bash
 
   # Force reinstall, even if completions are already installed:
-  ` + version.CliName + ` completion install --force --yes`,
+  ` + version.CliName + ` self completion install --force --yes`,
 		Args:      cobra.MaximumNArgs(1),
 		ValidArgs: internalShell.SupportedShells(),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -97,7 +98,7 @@ By default, this command runs in preview mode. Use '--yes' to install directly.`
 	}
 
 	cmd.Flags().BoolVarP(&force, "force", "f", false, "Force reinstall, even if completions are already installed.")
-	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Automatically confirm installation without prompting.")
+	cmd.Flags().BoolVar(&yes, "yes", false, "Automatically confirm installation without prompting.")
 	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Preview mode: show what would be installed without making changes.")
 
 	return cmd
@@ -109,7 +110,7 @@ func runInstall(rootCmd *cobra.Command, specifiedShell string, force, yes, dryRu
 		return err
 	}
 
-	fmt.Println()
+	fmt.Fprintln(printer.Diagnostics)
 
 	shellType := internalShell.Shell(shell)
 
@@ -146,7 +147,7 @@ func runInstall(rootCmd *cobra.Command, specifiedShell string, force, yes, dryRu
 		}
 	}
 
-	fmt.Println()
+	fmt.Fprintln(printer.Diagnostics)
 
 	// Install
 	if err := installFunc(rootCmd); err != nil {
@@ -165,42 +166,39 @@ func runInstall(rootCmd *cobra.Command, specifiedShell string, force, yes, dryRu
 func showAlreadyInstalled(installPath string) {
 	fmt.Printf("%s Completion already installed at: %s.\n", successStyle.Render("✓"), installPath)
 	fmt.Println()
-	fmt.Println(infoStyle.Render("To reinstall, use: " + version.CliName + " completion install --force --yes"))
+	fmt.Println(infoStyle.Render("To reinstall, use: " + version.CliName + " self completion install --force --yes"))
 }
 
 func showInstallationPlan(shell, installPath string, alreadyInstalled bool) {
-	fmt.Println(infoStyle.Render("Installation Plan:"))
-	fmt.Printf("  Shell:        %s\n", shell)
-	fmt.Printf("  Install to:   %s\n", installPath)
+	fmt.Fprintln(printer.Diagnostics, infoStyle.Render("Installation Plan:"))
+	fmt.Fprintf(printer.Diagnostics, "  Shell:        %s\n", shell)
+	fmt.Fprintf(printer.Diagnostics, "  Install to:   %s\n", installPath)
 
 	if alreadyInstalled {
-		fmt.Printf("  Action:       %s (reinstall)\n", warnStyle.Render("Overwrite"))
+		fmt.Fprintf(printer.Diagnostics, "  Action:       %s (reinstall)\n", warnStyle.Render("Overwrite"))
 	} else {
-		fmt.Printf("  Action:       %s\n", successStyle.Render("Create new"))
+		fmt.Fprintf(printer.Diagnostics, "  Action:       %s\n", successStyle.Render("Create new"))
 	}
 
-	fmt.Println()
+	fmt.Fprintln(printer.Diagnostics)
 }
 
 func showDryRunMessage(shell string) {
-	fmt.Println(infoStyle.Render("🔍 Dry-run mode (no changes will be made)"))
-	fmt.Println()
-	fmt.Println("To proceed with installation, run:")
-	fmt.Println(infoStyle.Render("  " + version.CliName + " completion install " + shell + " --yes"))
+	fmt.Fprintln(printer.Diagnostics, infoStyle.Render("🔍 Dry-run mode (no changes will be made)"))
+	fmt.Fprintln(printer.Diagnostics)
+	fmt.Fprintln(printer.Diagnostics, "To proceed with installation, run:")
+	fmt.Fprintln(printer.Diagnostics, infoStyle.Render("  "+version.CliName+" self completion install "+shell+" --yes"))
 }
 
 func promptForConfirmation() (bool, error) {
-	fmt.Print("Proceed with installation? [y/N]: ")
-
-	response, err := reader.ReadString()
+	confirmed, err := confirm.Ask("Proceed with installation?")
 	if err != nil {
-		return false, fmt.Errorf("Failed to read input: %w", err)
+		return false, err
 	}
 
-	response = strings.ToLower(strings.TrimSpace(response))
-	if response != "y" && response != "yes" {
-		fmt.Println()
-		fmt.Println(infoStyle.Render("Installation cancelled."))
+	if !confirmed {
+		fmt.Fprintln(printer.Diagnostics)
+		fmt.Fprintln(printer.Diagnostics, infoStyle.Render("Installation cancelled."))
 
 		return false, nil
 	}
@@ -277,7 +275,7 @@ func installZsh(_ *cobra.Command, _ bool) (string, func(*cobra.Command) error) {
 		if !fsutil.DirExists(filepath.Join(homeDir, ".oh-my-zsh")) {
 			zshrc := filepath.Join(homeDir, ".zshrc")
 			if err := ensureFpathInZshrc(zshrc, compDir); err != nil {
-				fmt.Printf("%s %s\n", warnStyle.Render("Warning: "), err)
+				fmt.Fprintf(printer.Diagnostics, "%s %s\n", warnStyle.Render("Warning: "), err)
 			}
 		}
 
@@ -299,32 +297,32 @@ func installBash(_ *cobra.Command, _ bool) (string, func(*cobra.Command) error)
 	installFunc := func(rootCmd *cobra.Command) error {
 		// Check if bash-completion is available
 		if !isBashCompletionAvailable() {
-			fmt.Println()
-			fmt.Printf("%s Bash completion framework not detected.\n", warnStyle.Render("⚠"))
-			fmt.Println()
-			fmt.Println("Bash completions require the bash-completion package.")
-			fmt.Println()
-			fmt.Println("To install:")
-			fmt.Println()
+			fmt.Fprintln(printer.Diagnostics)
+			fmt.Fprintf(printer.Diagnostics, "%s Bash completion framework not detected.\n", warnStyle.Render("⚠"))
+			fmt.Fprintln(printer.Diagnostics)
+			fmt.Fprintln(printer.Diagnostics, "Bash completions require the bash-completion package.")
+			fmt.Fprintln(printer.Diagnostics)
+			fmt.Fprintln(printer.Diagnostics, "To install:")
+			fmt.Fprintln(printer.Diagnostics)
 
 			if runtime.GOOS == "darwin" {
-				fmt.Println(infoStyle.Render("  # macOS (Homebrew)"))
-				fmt.Println(infoStyle.Render("  brew install bash-completion@2"))
-				fmt.Println()
-				fmt.Println(infoStyle.Render("  # Then add to ~/.bash_profile:"))
-				fmt.Println(infoStyle.Render(`  export BASH_COMPLETION_COMPAT_DIR="/opt/homebrew/etc/bash_completion.d"`))
-				fmt.Println(infoStyle.Render(`  [[ -r "/opt/homebrew/etc/profile.d/bash_completion.sh" ]] && . "/opt/homebrew/etc/profile.d/bash_completion.sh"`))
+				fmt.Fprintln(printer.Diagnostics, infoStyle.Render("  # macOS (Homebrew)"))
+				fmt.Fprintln(printer.Diagnostics, infoStyle.Render("  brew install bash-completion@2"))
+				fmt.Fprintln(printer.Diagnostics)
+				fmt.Fprintln(printer.Diagnostics, infoStyle.Render("  # Then add to ~/.bash_profile:"))
+				fmt.Fprintln(printer.Diagnostics, infoStyle.Render(`  export BASH_COMPLETION_COMPAT_DIR="/opt/homebrew/etc/bash_completion.d"`))
+				fmt.Fprintln(printer.Diagnostics, infoStyle.Render(`  [[ -r "/opt/homebrew/etc/profile.d/bash_completion.sh" ]] && . "/opt/homebrew/etc/profile.d/bash_completion.sh"`))
 			} else {
-				fmt.Println(infoStyle.Render("  # Ubuntu/Debian"))
-				fmt.Println(infoStyle.Render("  sudo apt-get install bash-completion"))
-				fmt.Println()
-				fmt.Println(infoStyle.Render("  # RHEL/CentOS"))
-				fmt.Println(infoStyle.Render("  sudo yum install bash-completion"))
+				fmt.Fprintln(printer.Diagnostics, infoStyle.Render("  # Ubuntu/Debian"))
+				fmt.Fprintln(printer.Diagnostics, infoStyle.Render("  sudo apt-get install bash-completion"))
+				fmt.Fprintln(printer.Diagnostics)
+				fmt.Fprintln(printer.Diagnostics, infoStyle.Render("  # RHEL/CentOS"))
+				fmt.Fprintln(printer.Diagnostics, infoStyle.Render("  sudo yum install bash-completion"))
 			}
 
-			fmt.Println()
-			fmt.Println("After installing bash-completion, run this command again.")
-			fmt.Println()
+			fmt.Fprintln(printer.Diagnostics)
+			fmt.Fprintln(printer.Diagnostics, "After installing bash-completion, run this command again.")
+			fmt.Fprintln(printer.Diagnostics)
 
 			return errors.New("Bash-completion not available.")
 		}
@@ -349,7 +347,7 @@ func installBash(_ *cobra.Command, _ bool) (string, func(*cobra.Command) error)
 		// Add sourcing to bashrc if not already there
 		bashrc := filepath.Join(homeDir, ".bashrc")
 		if err := ensureSourceInBashrc(bashrc, installPath); err != nil {
-			fmt.Printf("%s %s\n", warnStyle.Render("Warning:"), err)
+			fmt.Fprintf(printer.Diagnostics, "%s %s\n", warnStyle.Render("Warning:"), err)
 		}
 
 		return nil
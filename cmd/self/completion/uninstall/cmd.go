@@ -23,7 +23,8 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/datarobot/cli/internal/fsutil"
-	"github.com/datarobot/cli/internal/misc/reader"
+	"github.com/datarobot/cli/internal/misc/confirm"
+	"github.com/datarobot/cli/internal/printer"
 	internalShell "github.com/datarobot/cli/internal/shell"
 	"github.com/datarobot/cli/internal/version"
 	"github.com/spf13/cobra"
@@ -53,14 +54,14 @@ This command will:
 
 By default, runs in preview mode. Use '--yes' to uninstall directly.`,
 		Example: `  # Preview what would be removed (default behavior)
-  ` + version.CliName + ` completion uninstall
+  ` + version.CliName + ` self completion uninstall
 
   # Uninstall completions for your current shell
-  ` + version.CliName + ` completion uninstall --yes
+  ` + version.CliName + ` self completion uninstall --yes
 
   # Uninstall completions for a specific shell
-  ` + version.CliName + ` completion uninstall bash --yes
-  ` + version.CliName + ` completion uninstall zsh --yes`,
+  ` + version.CliName + ` self completion uninstall bash --yes
+  ` + version.CliName + ` self completion uninstall zsh --yes`,
 		Args:      cobra.MaximumNArgs(1),
 		ValidArgs: internalShell.SupportedShells(),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -83,7 +84,7 @@ By default, runs in preview mode. Use '--yes' to uninstall directly.`,
 		},
 	}
 
-	cmd.Flags().BoolVarP(&yes, "yes", "y", false, "Automatically confirm uninstallation without prompting")
+	cmd.Flags().BoolVar(&yes, "yes", false, "Automatically confirm uninstallation without prompting")
 	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Preview mode: show what would be removed without making changes")
 
 	return cmd
@@ -95,7 +96,7 @@ func runUninstall(specifiedShell string, yes, dryRun bool) error {
 		return err
 	}
 
-	fmt.Println()
+	fmt.Fprintln(printer.Diagnostics)
 
 	existingPaths := findExistingCompletions(internalShell.Shell(shell))
 	if len(existingPaths) == 0 {
@@ -124,14 +125,14 @@ func runUninstall(specifiedShell string, yes, dryRun bool) error {
 		}
 	}
 
-	fmt.Println()
+	fmt.Fprintln(printer.Diagnostics)
 
 	return performUninstall(internalShell.Shell(shell))
 }
 
 func resolveShellForUninstall(specifiedShell string) (string, error) {
 	if specifiedShell != "" {
-		fmt.Printf("%s Uninstalling for shell: %s\n", infoStyle.Render("→"), specifiedShell)
+		fmt.Fprintf(printer.Diagnostics, "%s Uninstalling for shell: %s\n", infoStyle.Render("→"), specifiedShell)
 
 		return specifiedShell, nil
 	}
@@ -141,7 +142,7 @@ func resolveShellForUninstall(specifiedShell string) (string, error) {
 		return "", err
 	}
 
-	fmt.Printf("%s Detected shell: %s\n", infoStyle.Render("→"), shell)
+	fmt.Fprintf(printer.Diagnostics, "%s Detected shell: %s\n", infoStyle.Render("→"), shell)
 
 	return shell, nil
 }
@@ -161,22 +162,22 @@ func findExistingCompletions(shell internalShell.Shell) []string {
 }
 
 func showUninstallationPlan(shell string, existingPaths []string) {
-	fmt.Println(infoStyle.Render("Uninstallation Plan:"))
-	fmt.Printf("  Shell:        %s\n", shell)
-	fmt.Println("  Remove:")
+	fmt.Fprintln(printer.Diagnostics, infoStyle.Render("Uninstallation Plan:"))
+	fmt.Fprintf(printer.Diagnostics, "  Shell:        %s\n", shell)
+	fmt.Fprintln(printer.Diagnostics, "  Remove:")
 
 	for _, path := range existingPaths {
-		fmt.Printf("    - %s\n", path)
+		fmt.Fprintf(printer.Diagnostics, "    - %s\n", path)
 	}
 
-	fmt.Println()
+	fmt.Fprintln(printer.Diagnostics)
 }
 
 func showUninstallDryRunMessage(shell string) {
-	fmt.Println(infoStyle.Render("🔍 Dry-run mode (no changes will be made)"))
-	fmt.Println()
-	fmt.Println("To proceed with uninstallation, run:")
-	fmt.Println(infoStyle.Render("  " + version.CliName + " completion uninstall " + shell + " --yes"))
+	fmt.Fprintln(printer.Diagnostics, infoStyle.Render("🔍 Dry-run mode (no changes will be made)"))
+	fmt.Fprintln(printer.Diagnostics)
+	fmt.Fprintln(printer.Diagnostics, "To proceed with uninstallation, run:")
+	fmt.Fprintln(printer.Diagnostics, infoStyle.Render("  "+version.CliName+" self completion uninstall "+shell+" --yes"))
 }
 
 func performUninstall(shell internalShell.Shell) error {
@@ -250,17 +251,14 @@ func getUninstallPaths(shell internalShell.Shell) []string {
 }
 
 func promptForUninstallConfirmation() (bool, error) {
-	fmt.Print("Proceed with uninstallation? [y/N]: ")
-
-	response, err := reader.ReadString()
+	confirmed, err := confirm.Ask("Proceed with uninstallation?")
 	if err != nil {
-		return false, fmt.Errorf("Failed to read input: %w", err)
+		return false, err
 	}
 
-	response = strings.ToLower(strings.TrimSpace(response))
-	if response != "y" && response != "yes" {
-		fmt.Println()
-		fmt.Println(infoStyle.Render("Uninstallation cancelled."))
+	if !confirmed {
+		fmt.Fprintln(printer.Diagnostics)
+		fmt.Fprintln(printer.Diagnostics, infoStyle.Render("Uninstallation cancelled."))
 
 		return false, nil
 	}
@@ -375,7 +373,7 @@ func removePowerShellCompletionFromProfile(profilePath string) bool {
 
 	// Write back
 	if err := os.WriteFile(profilePath, []byte(newContent), 0o644); err != nil {
-		fmt.Printf("%s Failed to update: %s\n", warnStyle.Render("⚠"), profilePath)
+		fmt.Fprintf(printer.Diagnostics, "%s Failed to update: %s\n", warnStyle.Render("⚠"), profilePath)
 
 		return false
 	}
@@ -15,18 +15,40 @@
 package config
 
 import (
+	"github.com/datarobot/cli/cmd/self/config/edit"
+	"github.com/datarobot/cli/cmd/self/config/env"
+	"github.com/datarobot/cli/cmd/self/config/get"
+	"github.com/datarobot/cli/cmd/self/config/migrate"
+	"github.com/datarobot/cli/cmd/self/config/profile"
+	"github.com/datarobot/cli/cmd/self/config/set"
+	"github.com/datarobot/cli/cmd/self/config/unset"
+	"github.com/datarobot/cli/cmd/self/config/validate"
+	"github.com/datarobot/cli/cmd/self/config/view"
 	"github.com/datarobot/cli/internal/config"
 	"github.com/spf13/cobra"
 )
 
 func Cmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "config",
-		Short: "Display current configuration settings",
-		Long:  "Display all configuration settings from config file and environment variables, with sensitive data redacted.",
-		RunE:  RunE,
+		Use:     "config",
+		Short:   "Display current configuration settings",
+		Long:    "Display all configuration settings from config file and environment variables, with sensitive data redacted.",
+		Example: `  dr self config`,
+		RunE:    RunE,
 	}
 
+	cmd.AddCommand(
+		edit.Cmd(),
+		env.Cmd(),
+		get.Cmd(),
+		migrate.Cmd(),
+		profile.Cmd(),
+		set.Cmd(),
+		unset.Cmd(),
+		validate.Cmd(),
+		view.Cmd(),
+	)
+
 	return cmd
 }
 
@@ -0,0 +1,211 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package edit
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/misc/confirm"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// runEditor opens path in the external editor; it is stubbed in tests
+var runEditor = func(path string) error {
+	args := strings.Fields(viper.GetString("external-editor"))
+	if len(args) == 0 {
+		return errors.New("No editor is set. Set VISUAL or EDITOR, or the external-editor config key.")
+	}
+
+	editor := exec.Command(args[0], append(args[1:], path)...)
+	editor.Stdin, editor.Stdout, editor.Stderr = os.Stdin, os.Stdout, os.Stderr
+
+	if err := editor.Run(); err != nil {
+		return fmt.Errorf("Editor %s failed: %w", args[0], err)
+	}
+
+	return nil
+}
+
+// interactive is stubbed in tests
+var interactive = confirm.Interactive
+
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "edit",
+		Short: "Open the config file in an editor",
+		Long: `Open the active config file in the editor named by VISUAL, EDITOR, or the
+external-editor config key, falling back to vi (notepad on Windows). If the
+file doesn't exist yet, it is created from a template listing every known
+key, commented out.
+
+The file is validated once the editor exits. Errors are reported and the
+edits are kept, so you can run the command again to fix them.
+
+With --profile, only that profile's section is opened. It is written back
+into the config file once it validates; until then the edits are kept in a
+file next to the config file and reopened by the next edit. A profile that
+doesn't exist yet is created.
+
+The command runs even when the config file can't be read, so you can fix it.`,
+		Example: `  dr self config edit
+  EDITOR="code --wait" dr self config edit
+  dr self config edit --profile staging`,
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{config.LenientAnnotation: "true"},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.SilenceUsage = true
+
+			if !interactive() {
+				return errors.New("Editing the config file needs a terminal. Use 'dr self config set' instead.")
+			}
+
+			path, err := config.ConfigFilePath()
+			if err != nil {
+				return err
+			}
+
+			if cmd.Flags().Changed("profile") {
+				return editProfile(cmd.OutOrStdout(), path, config.ActiveProfile())
+			}
+
+			return editFile(cmd.OutOrStdout(), path)
+		},
+	}
+
+	return cmd
+}
+
+func editFile(w io.Writer, path string) error {
+	if err := createFromTemplate(path); err != nil {
+		return err
+	}
+
+	if err := runEditor(path); err != nil {
+		return err
+	}
+
+	issues, err := config.ValidateConfigFile(path, false)
+	if err != nil {
+		return fmt.Errorf("%w Your edits are kept; run 'dr self config edit' to fix them.", err)
+	}
+
+	return report(w, path, issues, "Your edits are kept; run 'dr self config edit' to fix them.")
+}
+
+// editProfile edits the section of profile name in a file of its own, and
+// writes it back into the config file at path once it validates
+func editProfile(w io.Writer, path, name string) error {
+	draft := profileDraftPath(path, name)
+
+	if _, err := os.Stat(draft); errors.Is(err, os.ErrNotExist) {
+		data, err := config.ProfileYAML(path, name)
+		if err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(draft, data, 0o600); err != nil {
+			return fmt.Errorf("Failed to write profile file: %w", err)
+		}
+	} else {
+		fmt.Fprintln(w, tui.DimStyle.Render("Reopening your unsaved edits in "+draft+"."))
+	}
+
+	if err := runEditor(draft); err != nil {
+		return err
+	}
+
+	kept := fmt.Sprintf("Your edits are kept in %s; run 'dr self config edit --profile %s' to fix them.", draft, name)
+
+	data, err := os.ReadFile(draft)
+	if err != nil {
+		return fmt.Errorf("Failed to read profile file: %w", err)
+	}
+
+	issues, err := config.ValidateProfileYAML(name, data, false)
+	if err != nil {
+		return fmt.Errorf("%w %s", err, kept)
+	}
+
+	if err := report(w, "Profile "+name, issues, kept); err != nil {
+		return err
+	}
+
+	if err := config.WriteProfileYAML(path, name, data); err != nil {
+		return err
+	}
+
+	if err := os.Remove(draft); err != nil {
+		return fmt.Errorf("Failed to remove profile file: %w", err)
+	}
+
+	return nil
+}
+
+// profileDraftPath is where edits to profile name wait until they validate
+func profileDraftPath(path, name string) string {
+	ext := filepath.Ext(path)
+
+	return strings.TrimSuffix(path, ext) + ".profile-" + name + ext
+}
+
+func createFromTemplate(path string) error {
+	if _, err := os.Stat(path); !errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("Failed to create config file directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, []byte(config.ConfigFileTemplate()), 0o600); err != nil {
+		return fmt.Errorf("Failed to create config file: %w", err)
+	}
+
+	return nil
+}
+
+// report prints issues found in what, and fails with hint if any is an error
+func report(w io.Writer, what string, issues []config.Issue, hint string) error {
+	errorCount := 0
+
+	for _, issue := range issues {
+		line := fmt.Sprintf("%s: %s", issue.Key, issue.Message)
+
+		if issue.Severity == config.SeverityError {
+			errorCount++
+
+			fmt.Fprintln(w, tui.ErrorStyle.Render("❌ "+line))
+		} else {
+			fmt.Fprintln(w, tui.WarningStyle.Render("⚠️ "+line))
+		}
+	}
+
+	if errorCount > 0 {
+		return fmt.Errorf("%s has %d error(s). %s", what, errorCount, hint)
+	}
+
+	fmt.Fprintln(w, tui.SuccessStyle.Render("✅ "+what+" is valid."))
+
+	return nil
+}
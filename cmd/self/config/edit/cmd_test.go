@@ -0,0 +1,128 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package edit
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubEditor replaces the editor with one that writes contents to the file
+// it opens, and returns the paths it was asked to open
+func stubEditor(t *testing.T, contents ...string) *[]string {
+	t.Helper()
+
+	var opened []string
+
+	prevEditor, prevInteractive := runEditor, interactive
+	runEditor = func(path string) error {
+		opened = append(opened, path)
+
+		return os.WriteFile(path, []byte(contents[len(opened)-1]), 0o600)
+	}
+	interactive = func() bool { return true }
+
+	t.Cleanup(func() { runEditor, interactive = prevEditor, prevInteractive })
+
+	return &opened
+}
+
+func TestEditFileCreatesFromTemplate(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "datarobot", "drconfig.yaml")
+
+	var seen string
+
+	prevEditor := runEditor
+	runEditor = func(path string) error {
+		data, err := os.ReadFile(path)
+		seen = string(data)
+
+		return err
+	}
+
+	t.Cleanup(func() { runEditor = prevEditor })
+
+	var out bytes.Buffer
+
+	require.NoError(t, editFile(&out, path))
+	assert.Equal(t, config.ConfigFileTemplate(), seen)
+	assert.Contains(t, out.String(), "is valid.")
+}
+
+func TestEditFileKeepsInvalidEdits(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "drconfig.yaml")
+	stubEditor(t, "endpoint: not a url\n")
+
+	var out bytes.Buffer
+
+	err := editFile(&out, path)
+	require.ErrorContains(t, err, "has 1 error(s). Your edits are kept")
+	assert.Contains(t, out.String(), "endpoint:")
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "endpoint: not a url\n", string(data))
+}
+
+func TestEditProfile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "drconfig.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("endpoint: https://app.datarobot.com/api/v2\n"+
+		"profiles:\n  staging:\n    endpoint: https://staging.datarobot.com/api/v2\n"), 0o600))
+
+	opened := stubEditor(t,
+		"endpoint: nope\n",
+		"endpoint: https://staging2.datarobot.com/api/v2\n")
+
+	var out bytes.Buffer
+
+	// Invalid edits wait in a draft file next to the config file
+	err := editProfile(&out, path, "staging")
+	require.ErrorContains(t, err, "Profile staging has 1 error(s)")
+
+	draft := profileDraftPath(path, "staging")
+	assert.Equal(t, filepath.Join(filepath.Dir(path), "drconfig.profile-staging.yaml"), draft)
+	assert.FileExists(t, draft)
+
+	// The next edit reopens the draft and writes it back once it validates
+	require.NoError(t, editProfile(&out, path, "staging"))
+	assert.Equal(t, []string{draft, draft}, *opened)
+	assert.Contains(t, out.String(), "Reopening your unsaved edits")
+	assert.NoFileExists(t, draft)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "endpoint: https://app.datarobot.com/api/v2\n"+
+		"profiles:\n  staging:\n    endpoint: https://staging2.datarobot.com/api/v2\n", string(data))
+}
+
+func TestEditNeedsTerminal(t *testing.T) {
+	prevInteractive := interactive
+	interactive = func() bool { return false }
+
+	t.Cleanup(func() { interactive = prevInteractive })
+
+	cmd := Cmd()
+	cmd.SetArgs([]string{})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	require.ErrorContains(t, cmd.Execute(), "needs a terminal")
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package env
+
+import (
+	"io"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+var columns = []printer.Column[config.EnvVar]{
+	{Field: "key", Header: "KEY", Value: func(v config.EnvVar) string { return v.Key }},
+	{Field: "name", Header: "ENV", Value: func(v config.EnvVar) string { return v.Name }},
+	{Field: "set", Header: "STATUS", Value: func(v config.EnvVar) string {
+		if v.Set {
+			return "set"
+		}
+
+		return "unset"
+	}},
+	{Field: "value", Header: "VALUE", Value: func(v config.EnvVar) string { return v.Value }},
+}
+
+func Run(w io.Writer) error {
+	vars := config.ConsultedEnvVars()
+
+	return printer.Print(w, vars, func(w io.Writer) error {
+		return printer.WriteTable(w, columns, vars)
+	})
+}
+
+func Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:    "env",
+		Hidden: true,
+		Short:  "List the environment variables read for each config key",
+		Long: `List every environment variable the CLI consults for a config key, in the
+order they are tried, and whether each is set in this environment.
+
+The prefixed name, such as DATAROBOT_CLI_SKIP_AUTH, comes first, then any
+aliases such as DATAROBOT_ENDPOINT. Values of secret keys are redacted.`,
+		Example: `  dr self config env
+  dr self config env -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return Run(cmd.OutOrStdout())
+		},
+	}
+}
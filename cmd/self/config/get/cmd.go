@@ -0,0 +1,104 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package get
+
+import (
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+const redactedValue = "****"
+
+// valueResult is the structured form of a single config value
+type valueResult struct {
+	Key   string `json:"key"   yaml:"key"`
+	Value any    `json:"value" yaml:"value"`
+}
+
+func Cmd() *cobra.Command {
+	var showSecrets bool
+
+	cmd := &cobra.Command{
+		Use:   "get KEY",
+		Short: "Print the effective value of a configuration key",
+		Long: `Print the value of KEY as resolved from flags, environment variables,
+the active profile, the config file, and defaults. Nested keys use dots,
+e.g. oauth.client-id.
+
+Sensitive values such as the API token are redacted unless --show-secrets is set.`,
+		Example: `  dr self config get endpoint
+  dr self config get token --show-secrets`,
+		Args: cobra.ExactArgs(1),
+		ValidArgsFunction: func(_ *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+
+			return viper.AllKeys(), cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			key := args[0]
+
+			value, err := config.GetValue(key)
+			if errors.Is(err, config.ErrKeyNotSet) {
+				return fmt.Errorf("Key %q is not set.", key)
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if !showSecrets && config.IsSecretKey(key) {
+				value = redactedValue
+			}
+
+			return printer.Print(cmd.OutOrStdout(), valueResult{Key: key, Value: value}, func(w io.Writer) error {
+				return printValue(w, value)
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&showSecrets, "show-secrets", false, "Do not redact sensitive values")
+
+	return cmd
+}
+
+// printValue prints scalars as-is and maps or lists as YAML
+func printValue(w io.Writer, value any) error {
+	switch value.(type) {
+	case map[string]any, []any:
+		data, err := yaml.Marshal(value)
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprint(w, string(data))
+
+		return err
+	}
+
+	_, err := fmt.Fprintln(w, value)
+
+	return err
+}
@@ -0,0 +1,92 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package migrate
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Upgrade the config file to the current format",
+		Long: `Rename deprecated keys in the config file, including those in profiles and
+contexts, to their current names, e.g. apikey to token, and record the
+format version in config-version. The original file is saved next to it
+with a .bak suffix. If a key is set under both names, the current name wins.
+
+Older config files are migrated this way automatically when read, with a
+warning, unless --no-auto-migrate is set.`,
+		Example: `  dr self config migrate --dry-run
+  dr self config migrate`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.SilenceUsage = true
+
+			path, err := config.ConfigFilePath()
+			if err != nil {
+				return err
+			}
+
+			result, err := config.MigrateConfigFile(path, dryRun)
+			if err != nil {
+				return err
+			}
+
+			return printer.Print(cmd.OutOrStdout(), result, func(w io.Writer) error {
+				printText(w, result, dryRun)
+
+				return nil
+			})
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show what would change without writing the file")
+
+	return cmd
+}
+
+func printText(w io.Writer, result config.MigrationResult, dryRun bool) {
+	if !result.Migrated {
+		fmt.Fprintf(w, "ℹ️ %s is already at version %d; nothing to migrate.\n", result.File, result.ToVersion)
+
+		return
+	}
+
+	for _, change := range result.Changes {
+		if change.Dropped {
+			fmt.Fprintf(w, "  %s removed (%s is already set)\n", change.Key, change.NewKey)
+		} else {
+			fmt.Fprintf(w, "  %s → %s\n", change.Key, change.NewKey)
+		}
+	}
+
+	if dryRun {
+		fmt.Fprintf(w, "ℹ️ %s would be migrated from version %d to %d.\n", result.File, result.FromVersion, result.ToVersion)
+
+		return
+	}
+
+	fmt.Fprintln(w, tui.SuccessStyle.Render(fmt.Sprintf("✅ Migrated %s from version %d to %d; the original is saved as %s.",
+		result.File, result.FromVersion, result.ToVersion, result.Backup)))
+}
@@ -0,0 +1,40 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package profile
+
+import (
+	"github.com/datarobot/cli/cmd/self/config/profile/list"
+	"github.com/datarobot/cli/cmd/self/config/profile/use"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "profile",
+		Aliases: []string{"profiles"},
+		Short:   "Manage named configuration profiles",
+		Long: `Manage named configuration profiles defined under the 'profiles' key
+of the config file. The active profile is selected with --profile,
+the DATAROBOT_CLI_PROFILE environment variable, or the default profile
+stored in the config file.`,
+	}
+
+	cmd.AddCommand(
+		list.Cmd(),
+		use.Cmd(),
+	)
+
+	return cmd
+}
@@ -0,0 +1,67 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package list
+
+import (
+	"fmt"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+)
+
+// profilesResult is the structured form of the profile list
+type profilesResult struct {
+	Active   string   `json:"active"   yaml:"active"`
+	Profiles []string `json:"profiles" yaml:"profiles"`
+}
+
+func Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List configuration profiles",
+		Example: `  dr self config profile list`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			names := config.ProfileNames()
+
+			if printer.IsStructured() {
+				return printer.Print(cmd.OutOrStdout(), profilesResult{
+					Active:   config.ActiveProfile(),
+					Profiles: names,
+				}, nil)
+			}
+
+			if len(names) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "No profiles defined in the config file.")
+
+				return nil
+			}
+
+			active := config.ActiveProfile()
+
+			for _, name := range names {
+				if name == active {
+					fmt.Fprintln(cmd.OutOrStdout(), tui.InfoStyle.Render("* "+name))
+				} else {
+					fmt.Fprintln(cmd.OutOrStdout(), "  "+name)
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package use
+
+import (
+	"fmt"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "use NAME",
+		Short: "Set the default configuration profile",
+		Long: `Persist NAME as the default profile in the config file.
+Use an empty string to clear the default profile.`,
+		Example: `  dr self config profile use staging`,
+		Args:    cobra.ExactArgs(1),
+		ValidArgsFunction: func(_ *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+
+			return config.ProfileNames(), cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			if err := config.SetDefaultProfile(args[0]); err != nil {
+				return err
+			}
+
+			if args[0] == "" {
+				fmt.Fprintln(cmd.OutOrStdout(), "Default profile cleared.")
+			} else {
+				fmt.Fprintf(cmd.OutOrStdout(), "Default profile set to %q.\n", args[0])
+			}
+
+			return nil
+		},
+	}
+}
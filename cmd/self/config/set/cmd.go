@@ -0,0 +1,74 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package set
+
+import (
+	"fmt"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "set KEY VALUE",
+		Short: "Write a configuration key to the config file",
+		Long: `Write KEY with VALUE to the active config file, creating the file if needed.
+Comments and the rest of the file are left untouched. While a profile is
+active, the key is written to that profile's section. Nested keys use dots,
+e.g. oauth.client-id.
+
+Integers and true/false are stored as numbers and booleans; other values,
+including durations such as 30s, are stored as strings.`,
+		Example: `  dr self config set endpoint https://app.datarobot.com
+  dr self config set disable-telemetry true`,
+		Args: cobra.ExactArgs(2),
+		ValidArgsFunction: func(_ *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+
+			return viper.AllKeys(), cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			if err := config.SetValue(args[0], args[1]); err != nil {
+				return err
+			}
+
+			path, err := config.ConfigFilePath()
+			if err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), tui.SuccessStyle.Render(fmt.Sprintf("✅ Set %s in %s", args[0], describeTarget(path))))
+
+			return nil
+		},
+	}
+}
+
+// describeTarget names the file and, if one is active, the profile a key
+// was written to
+func describeTarget(path string) string {
+	if profile := config.ActiveProfile(); profile != "" {
+		return fmt.Sprintf("%s (profile %q).", path, profile)
+	}
+
+	return path + "."
+}
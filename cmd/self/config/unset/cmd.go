@@ -0,0 +1,61 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unset
+
+import (
+	"fmt"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "unset KEY",
+		Short: "Remove a configuration key from the config file",
+		Long: `Remove KEY from the active config file, or from the active profile's
+section if a profile is selected. Values from flags and environment
+variables are unaffected.`,
+		Example: `  dr self config unset endpoint`,
+		Args:    cobra.ExactArgs(1),
+		ValidArgsFunction: func(_ *cobra.Command, args []string, _ string) ([]string, cobra.ShellCompDirective) {
+			if len(args) > 0 {
+				return nil, cobra.ShellCompDirectiveNoFileComp
+			}
+
+			return viper.AllKeys(), cobra.ShellCompDirectiveNoFileComp
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			removed, err := config.UnsetValue(args[0])
+			if err != nil {
+				return err
+			}
+
+			if !removed {
+				fmt.Fprintf(cmd.OutOrStdout(), "ℹ️ %s is not set in the config file; nothing to remove.\n", args[0])
+
+				return nil
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), tui.SuccessStyle.Render("✅ Removed "+args[0]+" from the config file."))
+
+			return nil
+		},
+	}
+}
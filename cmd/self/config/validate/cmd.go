@@ -0,0 +1,130 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package validate
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+)
+
+// validateResult is the structured form of the validation report
+type validateResult struct {
+	File   string         `json:"file"   yaml:"file"`
+	Valid  bool           `json:"valid"  yaml:"valid"`
+	Issues []config.Issue `json:"issues" yaml:"issues"`
+}
+
+func Cmd() *cobra.Command {
+	var strict bool
+
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Check the config file for unknown keys and invalid values",
+		Long: `Check every key in the config file, including those in profiles, against
+the settings the CLI knows about. Values are checked for the right type and
+range, e.g. endpoint must be a URL and max-retries a non-negative integer.
+
+Unknown keys, which are usually typos, are reported as warnings, or as errors
+with --strict. The command exits non-zero if any errors are found. With
+--config -, the config piped to stdin is checked.`,
+		Example: `  dr self config validate
+  dr self config validate --strict
+  cat drconfig.yaml | dr --config - self config validate`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.SilenceUsage = true
+
+			path, issues, err := validateActive(strict)
+			if err != nil {
+				return err
+			}
+
+			errorCount := 0
+
+			for _, issue := range issues {
+				if issue.Severity == config.SeverityError {
+					errorCount++
+				}
+			}
+
+			result := validateResult{File: path, Valid: errorCount == 0, Issues: issues}
+			if result.Issues == nil {
+				result.Issues = []config.Issue{}
+			}
+
+			if err := printer.Print(cmd.OutOrStdout(), result, func(w io.Writer) error {
+				printText(w, result)
+
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			if errorCount > 0 {
+				if config.FromStdin() {
+					return fmt.Errorf("The config from stdin has %d error(s).", errorCount)
+				}
+
+				return fmt.Errorf("Config file %s has %d error(s).", path, errorCount)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().BoolVar(&strict, "strict", false, "Treat unknown keys as errors")
+
+	return cmd
+}
+
+// validateActive checks the config file in use, or the config read from
+// stdin, and returns what it checked
+func validateActive(strict bool) (string, []config.Issue, error) {
+	if config.FromStdin() {
+		issues, err := config.ValidateStdinConfig(strict)
+
+		return "stdin", issues, err
+	}
+
+	path, err := config.ConfigFilePath()
+	if err != nil {
+		return "", nil, err
+	}
+
+	issues, err := config.ValidateConfigFile(path, strict)
+
+	return path, issues, err
+}
+
+func printText(w io.Writer, result validateResult) {
+	for _, issue := range result.Issues {
+		line := fmt.Sprintf("%s: %s", issue.Key, issue.Message)
+
+		if issue.Severity == config.SeverityError {
+			fmt.Fprintln(w, tui.ErrorStyle.Render("❌ "+line))
+		} else {
+			fmt.Fprintln(w, tui.WarningStyle.Render("⚠️ "+line))
+		}
+	}
+
+	if result.Valid {
+		fmt.Fprintln(w, tui.SuccessStyle.Render("✅ "+result.File+" is valid."))
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package view
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/lipgloss/table"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+type Format string
+
+var _ pflag.Value = (*Format)(nil)
+
+const (
+	FormatJSON  Format = "json"
+	FormatYAML  Format = "yaml"
+	FormatTable Format = "table"
+)
+
+func (f *Format) String() string {
+	if f == nil {
+		return ""
+	}
+
+	return string(*f)
+}
+
+func (f *Format) Set(s string) error {
+	switch s {
+	case string(FormatJSON), string(FormatYAML), string(FormatTable):
+		*f = Format(s)
+		return nil
+	}
+
+	return fmt.Errorf("Invalid format %q (must be %q, %q or %q).",
+		s, FormatJSON, FormatYAML, FormatTable)
+}
+
+func (f *Format) Type() string {
+	return "view.Format"
+}
+
+type viewOptions struct {
+	format      Format
+	showSecrets bool
+}
+
+func Cmd() *cobra.Command {
+	var options viewOptions
+
+	options.format = FormatTable
+
+	cmd := &cobra.Command{
+		Use:   "view",
+		Short: "Show effective configuration values and where they came from",
+		Long: `Show every configuration key known to the CLI with its effective value,
+the source it was resolved from (flag, env, file or default), and the
+environment variable that would be consulted for it.
+
+Sensitive values such as the API token are redacted unless --show-secrets is set.`,
+		Example: `  dr self config view
+  dr self config view --format yaml
+  dr self config view --format json --show-secrets`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			settings := config.ResolveSettings(cmd.Flags(), options.showSecrets)
+
+			format := options.format
+
+			// Without an explicit --format, follow the global --output flag
+			if !cmd.Flags().Changed("format") && printer.IsStructured() {
+				format = Format(printer.CurrentFormat())
+			}
+
+			return render(cmd.OutOrStdout(), settings, format)
+		},
+	}
+
+	cmd.Flags().VarP(
+		&options.format,
+		"format",
+		"f",
+		fmt.Sprintf("Output format (options: %s, %s, %s)", FormatJSON, FormatYAML, FormatTable),
+	)
+
+	cmd.Flags().BoolVar(&options.showSecrets, "show-secrets", false, "Do not redact sensitive values")
+
+	_ = cmd.RegisterFlagCompletionFunc("format", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return []string{string(FormatJSON), string(FormatYAML), string(FormatTable)}, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	return cmd
+}
+
+func render(w io.Writer, settings []config.Setting, format Format) error {
+	switch format {
+	case FormatJSON:
+		data, err := json.MarshalIndent(settings, "", "  ")
+		if err != nil {
+			return fmt.Errorf("Failed to marshal configuration to JSON: %w", err)
+		}
+
+		_, err = fmt.Fprintln(w, string(data))
+
+		return err
+	case FormatYAML:
+		data, err := yaml.Marshal(settings)
+		if err != nil {
+			return fmt.Errorf("Failed to marshal configuration to YAML: %w", err)
+		}
+
+		_, err = fmt.Fprint(w, string(data))
+
+		return err
+	case FormatTable:
+		_, err := fmt.Fprintln(w, renderTable(settings))
+
+		return err
+	}
+
+	return fmt.Errorf("Unsupported format %q.", format)
+}
+
+func renderTable(settings []config.Setting) string {
+	keyStyle := tui.BaseTextStyle.Padding(0, 1)
+	valueStyle := lipgloss.NewStyle().Padding(0, 1)
+	dimStyle := tui.DimStyle.Padding(0, 1)
+
+	t := table.New().
+		Border(lipgloss.RoundedBorder()).
+		BorderStyle(tui.TableBorderStyle).
+		StyleFunc(func(_, col int) lipgloss.Style {
+			switch col {
+			case 0:
+				return keyStyle
+			case 1:
+				return valueStyle
+			default:
+				return dimStyle
+			}
+		}).
+		Headers("KEY", "VALUE", "SOURCE", "ENV")
+
+	for _, s := range settings {
+		envVars := append([]string{s.EnvVar}, s.Alias...)
+
+		t.Row(s.Key, fmt.Sprintf("%v", s.Value), string(s.Source), strings.Join(envVars, ", "))
+	}
+
+	return t.Render()
+}
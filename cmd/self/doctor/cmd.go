@@ -0,0 +1,93 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doctor
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/datarobot/cli/internal/doctor"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+)
+
+// doctorResult is the structured form of the diagnosis
+type doctorResult struct {
+	OK     bool            `json:"ok"     yaml:"ok"`
+	Checks []doctor.Result `json:"checks" yaml:"checks"`
+}
+
+func Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "doctor",
+		Short: "Diagnose common setup problems",
+		Long: `Check that the CLI is ready to use and explain how to fix what is not.
+
+The checks cover the config file and whether it is valid, write access to
+the config directory, proxy and TLS settings, the configured DataRobot URL
+and whether it can be reached, the API token and whether the server accepts
+it, and the tools quickstart scripts rely on.
+
+Each check passes, warns, or fails. The command exits non-zero if any check
+fails, but not for warnings, so 'dr self doctor --output json' can gate a CI
+pipeline. In JSON, each check has a name, status (pass, warn, or fail),
+detail, and remediation.`,
+		Example: `  dr self doctor
+  dr self doctor --output json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.SilenceUsage = true
+
+			checks := doctor.Run(cmd.Context())
+			failed := doctor.FailedCount(checks)
+
+			result := doctorResult{OK: failed == 0, Checks: checks}
+
+			if err := printer.Print(cmd.OutOrStdout(), result, func(w io.Writer) error {
+				printText(w, result)
+
+				return nil
+			}); err != nil {
+				return err
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("%d check(s) failed.", failed)
+			}
+
+			return nil
+		},
+	}
+}
+
+func printText(w io.Writer, result doctorResult) {
+	for _, check := range result.Checks {
+		line := check.Name + ": " + check.Detail
+
+		switch check.Status {
+		case doctor.StatusPass:
+			fmt.Fprintln(w, tui.SuccessStyle.Render("✅ "+line))
+		case doctor.StatusWarn:
+			fmt.Fprintln(w, tui.WarningStyle.Render("⚠️ "+line))
+		case doctor.StatusFail:
+			fmt.Fprintln(w, tui.ErrorStyle.Render("❌ "+line))
+		}
+
+		if check.Remediation != "" {
+			fmt.Fprintln(w, tui.DimStyle.Render("   💡 "+check.Remediation))
+		}
+	}
+}
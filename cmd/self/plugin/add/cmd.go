@@ -56,6 +56,8 @@ Example with manual flags:
     --url my-plugin/my-plugin-1.0.0.tar.xz \
     --sha256 abc123... \
     --release-date 2026-01-28`,
+		Example: `  dr self plugin add docs/plugins/index.json --from-file dist/my-plugin.json
+  dr self plugin add docs/plugins/index.json --name my-plugin --version 1.2.0 --url my-plugin/my-plugin-1.2.0.tar.xz --sha256 3f5a1c --release-date 2026-01-15`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			indexPath := args[0]
@@ -51,6 +51,8 @@ The command will:
   2. Create a .tar.xz archive
   3. Calculate SHA256 checksum
 	  4. Output a JSON snippet for the registry`,
+		Example: `  dr self plugin package ./my-plugin
+  dr self plugin package ./my-plugin --output dist --index-output dist/my-plugin.json`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			pluginDir := args[0]
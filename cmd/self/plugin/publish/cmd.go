@@ -46,10 +46,8 @@ This is an all-in-one command that:
   1. Validates the plugin manifest
   2. Creates a .tar.xz archive
   3. Copies it to plugins/<plugin-name>/<plugin-name>-<version>.tar.xz
-  4. Updates the index.json with the new version
-
-Example:
-  dr self plugin publish ./my-plugin
+  4. Updates the index.json with the new version`,
+		Example: `  dr self plugin publish ./my-plugin
   dr self plugin publish ./my-plugin --plugins-dir docs/plugins --index docs/plugins/index.json`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
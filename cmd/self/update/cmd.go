@@ -20,150 +20,133 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
-	"strings"
 
-	"github.com/datarobot/cli/internal/fsutil"
-	"github.com/datarobot/cli/internal/log"
-	internalShell "github.com/datarobot/cli/internal/shell"
 	"github.com/datarobot/cli/internal/tools"
+	internalUpdate "github.com/datarobot/cli/internal/update"
 	"github.com/datarobot/cli/internal/version"
+	"github.com/datarobot/cli/tui"
 	"github.com/spf13/cobra"
 )
 
-func Cmd() *cobra.Command { //nolint:cyclop
-	var force bool
+func Cmd() *cobra.Command {
+	var (
+		force         bool
+		targetVersion string
+	)
 
 	cmd := &cobra.Command{
 		Use:   "update",
 		Short: "Update DataRobot CLI",
-		Long: `Updates the DataRobot CLI to latest version. This will use Homebrew
-to update if it detects the installed cask;  otherwise it will use an OS-appropriate script
-with your default shell.
+		Long: `Updates the DataRobot CLI to the latest version, or to the version given
+with --version. This will use Homebrew to update if it detects the installed cask;
+otherwise it downloads the release for your platform from GitHub, verifies it
+against the published SHA256 checksums, and replaces the running binary.
+
+Fails when self-update is disabled with the disable-self-update setting.
 `,
-		RunE: func(_ *cobra.Command, _ []string) error {
-			requirement, err := tools.GetSelfRequirement()
-			if err != nil {
-				return err
+		Example: `  dr self update
+  dr self update --version 0.2.0
+  dr self update --force --download-timeout 5m`,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.SilenceUsage = true
+
+			if internalUpdate.SelfUpdateDisabled() {
+				return internalUpdate.ErrSelfUpdateDisabled
 			}
 
-			if tools.SufficientSelfVersion(requirement.MinimumVersion) && !force {
-				if requirement.MinimumVersion != "" {
-					fmt.Fprintf(os.Stderr, "Required version: %s. ", requirement.MinimumVersion)
+			if targetVersion == "" && !force {
+				requirement, err := tools.GetSelfRequirement()
+				if err != nil {
+					return err
 				}
 
-				fmt.Fprintf(os.Stderr, "Installed version: %s.\n", version.Version)
-				fmt.Fprintln(os.Stderr, "Skipping update. To force update to latest version, add -f flag.")
-
-				return nil
-			}
-
-			// Account for when dr-cli cask has been installed - via `brew install datarobot-oss/taps/dr-cli`
-			if runtime.GOOS == "darwin" { //nolint:nestif
-				// Try to find brew and check if datarobot-oss is installed
-				brewPath, err := exec.LookPath("brew")
-				if err == nil {
-					brewCheckCmd := exec.Command(brewPath, "list", "--cask", "dr-cli")
-
-					// If we have dr-cli cask installed then attempt upgrade (err above indicates dr-cli wasn't found)
-					if err := brewCheckCmd.Run(); err == nil {
-						// Update brew first
-						brewUpdateCmd := exec.Command(brewPath, "update")
-						brewUpdateCmd.Stdout = os.Stdout
-						brewUpdateCmd.Stderr = os.Stderr
-
-						if err := brewUpdateCmd.Run(); err != nil {
-							fmt.Fprintln(os.Stderr, "Error: ", err)
-							return err
-						}
-
-						brewUpgradeCmd := exec.Command(brewPath, "upgrade", "--cask", "dr-cli")
-						brewUpgradeCmd.Stdout = os.Stdout
-						brewUpgradeCmd.Stderr = os.Stderr
-
-						if err := brewUpgradeCmd.Run(); err != nil {
-							fmt.Fprintln(os.Stderr, "Error: ", err)
-							return err
-						}
-
-						return nil
+				if tools.SufficientSelfVersion(requirement.MinimumVersion) {
+					if requirement.MinimumVersion != "" {
+						fmt.Fprintf(os.Stderr, "Required version: %s. ", requirement.MinimumVersion)
 					}
-				}
-			}
 
-			// Now, assuming we haven't upgraded via brew handle with OS specific command
-			shell, err := internalShell.DetectShell()
-			if err != nil {
-				fmt.Fprintln(os.Stderr, "Error while determining shell: ", err)
-				return err
-			}
+					fmt.Fprintf(os.Stderr, "Installed version: %s.\n", version.Version)
+					fmt.Fprintln(os.Stderr, "Skipping update. To force update to latest version, add -f flag.")
 
-			var (
-				command    string
-				executable string
-				backup     string
-			)
-
-			switch runtime.GOOS {
-			case "windows":
-				command = "irm https://raw.githubusercontent.com/datarobot-oss/cli/main/install.ps1 | iex"
-				executable, backup = backupExecutable()
-			case "darwin", "linux":
-				command = "curl -fsSL https://raw.githubusercontent.com/datarobot-oss/cli/main/install.sh | sh"
-			default:
-				log.Fatalf("Could not determine OS: %s\n", runtime.GOOS)
+					return nil
+				}
 			}
 
-			execCmd := exec.Command(shell, "-c", command)
-
-			execCmd.Stdout = os.Stdout
-			execCmd.Stderr = os.Stderr
-
-			if err := execCmd.Run(); err != nil {
-				if runtime.GOOS == "windows" {
-					// rename back if update failed
-					err = os.Rename(backup, executable)
-					if err != nil {
-						log.Errorf("Could not revert executable from backup: %s\n", backup)
-					}
+			// A pinned version can't be installed through the cask, so only
+			// defer to Homebrew when updating to the latest release
+			if targetVersion == "" {
+				upgraded, err := upgradeWithBrew()
+				if upgraded || err != nil {
+					return err
 				}
-
-				log.Fatalf("Command execution failed: %v\n", err)
-
-				return err
 			}
 
-			return nil
+			return updateFromRelease(cmd, targetVersion)
 		},
 	}
 
 	cmd.Flags().BoolVarP(&force, "force", "f", false, "Force update to latest version")
+	cmd.Flags().StringVar(&targetVersion, "version", "", "Install a specific version (e.g. 0.2.0) instead of the latest")
+	cmd.Flags().Duration(internalUpdate.DownloadTimeoutKey, internalUpdate.DefaultDownloadTimeout, "Time allowed for downloading the release archive")
 
 	return cmd
 }
 
-func backupExecutable() (string, string) {
-	executable, err := os.Executable()
+// upgradeWithBrew upgrades the dr-cli cask when it is installed, which
+// happens via `brew install datarobot-oss/taps/dr-cli`. It reports whether
+// Homebrew handled the update.
+func upgradeWithBrew() (bool, error) {
+	if runtime.GOOS != "darwin" {
+		return false, nil
+	}
+
+	brewPath, err := exec.LookPath("brew")
 	if err != nil {
-		log.Fatal("Could not determine current executable\n")
+		return false, nil
 	}
 
-	dir, file := filepath.Split(executable)
-	ext := filepath.Ext(file)
-	name := strings.TrimSuffix(file, ext)
+	// An error here means the dr-cli cask isn't installed
+	if err := exec.Command(brewPath, "list", "--cask", "dr-cli").Run(); err != nil {
+		return false, nil
+	}
 
-	backup := filepath.Join(dir, name+"_"+version.Version+ext)
+	for _, args := range [][]string{{"update"}, {"upgrade", "--cask", "dr-cli"}} {
+		brewCmd := exec.Command(brewPath, args...)
+		brewCmd.Stdout = os.Stdout
+		brewCmd.Stderr = os.Stderr
 
-	if fsutil.FileExists(backup) {
-		err = os.Remove(backup)
-		if err != nil {
-			log.Fatalf("Could not remove old backup executable: %s\n", backup)
+		if err := brewCmd.Run(); err != nil {
+			return true, err
 		}
 	}
 
-	err = os.Rename(executable, backup)
+	return true, nil
+}
+
+func updateFromRelease(cmd *cobra.Command, targetVersion string) error {
+	executable, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("Could not determine current executable: %w", err)
+	}
+
+	executable, err = filepath.EvalSymlinks(executable)
 	if err != nil {
-		log.Fatalf("Could not backup current executable\n")
+		return fmt.Errorf("Could not determine current executable: %w", err)
 	}
 
-	return executable, backup
+	release, err := internalUpdate.ResolveRelease(cmd.Context(), targetVersion)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "Downloading %s...\n", release.ArchiveName)
+
+	if err := internalUpdate.Install(cmd.Context(), release, executable); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(cmd.OutOrStdout(), tui.SuccessStyle.Render(
+		fmt.Sprintf("✅ Updated %s from %s to %s.", version.CliName, version.Version, release.Version)))
+
+	return nil
 }
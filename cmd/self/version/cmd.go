@@ -17,7 +17,10 @@ package version
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/internal/update"
 	internalVersion "github.com/datarobot/cli/internal/version"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -59,6 +62,15 @@ func (vf *Format) Type() string {
 type versionOptions struct {
 	format Format
 	short  bool
+	check  bool
+}
+
+// checkResult is the version info extended with the update check
+type checkResult struct {
+	internalVersion.InfoData `yaml:",inline"`
+
+	LatestVersion   string `json:"latest_version"   yaml:"latest_version"`
+	UpdateAvailable bool   `json:"update_available" yaml:"update_available"`
 }
 
 func Cmd() *cobra.Command {
@@ -69,8 +81,22 @@ func Cmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "version",
 		Short: "📋 Show " + internalVersion.AppName + " version information",
-		Args:  cobra.NoArgs,
+		Example: `  dr self version
+  dr self version --short
+  dr self version --check --format json`,
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			if options.check {
+				cmd.SilenceUsage = true
+
+				return runCheck(cmd, options)
+			}
+
+			// Without an explicit --format, follow the global --output flag
+			if !cmd.Flags().Changed("format") && printer.IsStructured() && !options.short {
+				return printer.Print(cmd.OutOrStdout(), internalVersion.Info, nil)
+			}
+
 			info, err := getVersion(options)
 			if err != nil {
 				return err
@@ -90,6 +116,9 @@ func Cmd() *cobra.Command {
 	)
 
 	cmd.Flags().BoolVarP(&options.short, "short", "s", false, "Short format")
+	cmd.Flags().BoolVar(&options.check, "check", false, "Check whether a newer version is available (without updating)")
+
+	cmd.MarkFlagsMutuallyExclusive("short", "check")
 
 	_ = cmd.RegisterFlagCompletionFunc("format", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 		return []string{string(FormatJSON), string(FormatText)}, cobra.ShellCompDirectiveNoFileComp
@@ -114,3 +143,36 @@ func getVersion(opts versionOptions) (string, error) {
 
 	return internalVersion.GetAppNameVersionText(), nil
 }
+
+// runCheck reports whether a newer release is available. The lookup is
+// cached for update-check-interval so repeated checks don't hit GitHub
+// rate limits.
+func runCheck(cmd *cobra.Command, opts versionOptions) error {
+	check, err := update.CheckForUpdate(cmd.Context(), false)
+	if err != nil {
+		return err
+	}
+
+	result := checkResult{
+		InfoData:        internalVersion.Info,
+		LatestVersion:   check.LatestVersion,
+		UpdateAvailable: check.UpdateAvailable,
+	}
+
+	if cmd.Flags().Changed("format") && opts.format == FormatJSON {
+		return printer.Encode(cmd.OutOrStdout(), printer.FormatJSON, result)
+	}
+
+	return printer.Print(cmd.OutOrStdout(), result, func(w io.Writer) error {
+		fmt.Fprintln(w, internalVersion.GetAppNameVersionText())
+
+		if result.UpdateAvailable {
+			fmt.Fprintf(w, "A newer version (%s) is available. Run `%s self update` to upgrade.\n",
+				result.LatestVersion, internalVersion.CliName)
+		} else {
+			fmt.Fprintf(w, "You are running the latest version (%s).\n", result.LatestVersion)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package serverinfo
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+)
+
+type capability struct {
+	Name        string `json:"name"        yaml:"name"`
+	Description string `json:"description" yaml:"description"`
+	MinVersion  string `json:"min_version" yaml:"min_version"`
+	Supported   bool   `json:"supported"   yaml:"supported"`
+}
+
+// result is the structured form of the server info
+type result struct {
+	drapi.ServerInfo `yaml:",inline"`
+
+	Capabilities []capability `json:"capabilities" yaml:"capabilities"`
+}
+
+func newResult(info drapi.ServerInfo) result {
+	res := result{ServerInfo: info, Capabilities: make([]capability, 0, len(drapi.Features))}
+
+	for _, f := range drapi.Features {
+		res.Capabilities = append(res.Capabilities, capability{
+			Name:        f.Name,
+			Description: f.Description,
+			MinVersion:  f.MinVersion,
+			Supported:   info.Supports(f.Name),
+		})
+	}
+
+	return res
+}
+
+func (r result) printText(w io.Writer) error {
+	version := r.Version
+	if r.ReleasedVersion != "" {
+		version += " (release " + r.ReleasedVersion + ")"
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Endpoint:\t%s\n", r.Endpoint)
+	fmt.Fprintf(tw, "API version:\t%s\n", version)
+	fmt.Fprintf(tw, "Detected:\t%s\n", r.FetchedAt.Local().Format(time.DateTime))
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w, "\nCapabilities:")
+
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	for _, c := range r.Capabilities {
+		mark := tui.SuccessStyle.Render("✓")
+		if !c.Supported {
+			mark = tui.ErrorStyle.Render("✗")
+		}
+
+		fmt.Fprintf(tw, "  %s %s\t%s\t%s\n", mark, c.Name, c.Description, tui.DimStyle.Render("(API "+c.MinVersion+"+)"))
+	}
+
+	return tw.Flush()
+}
+
+func Run(cmd *cobra.Command, refresh bool) error {
+	info, err := drapi.GetServerInfo(cmd.Context(), refresh)
+	if err != nil {
+		return fmt.Errorf("Failed to detect the server version: %w", err)
+	}
+
+	res := newResult(*info)
+
+	return printer.Print(cmd.OutOrStdout(), res, res.printText)
+}
+
+func Cmd() *cobra.Command {
+	var refresh bool
+
+	cmd := &cobra.Command{
+		Use:     "server-info",
+		GroupID: "advanced",
+		Short:   "🛰️  Show the DataRobot server's API version and capabilities",
+		Long: `Show the API version of the configured DataRobot server and which
+version-dependent features it supports.
+
+Self-managed installations may run an older version than DataRobot cloud.
+The CLI detects the version once per run and caches it for a day per
+endpoint, so commands can skip or explain features the server lacks
+instead of failing with a 404. Use --refresh after upgrading the server.`,
+		Example: `  dr server-info
+  dr server-info -o json`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		PreRunE:      auth.EnsureAuthenticatedE,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return Run(cmd, refresh)
+		},
+	}
+
+	cmd.Flags().BoolVar(&refresh, "refresh", false, "Detect the version again even if a cached one is still fresh")
+
+	return cmd
+}
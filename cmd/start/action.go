@@ -0,0 +1,178 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/datarobot/cli/internal/update"
+	"github.com/spf13/pflag"
+)
+
+// Action selects what 'dr start' does, bypassing the default quickstart
+// sequence when set to anything other than quickstart
+type Action string
+
+var _ pflag.Value = (*Action)(nil)
+
+const (
+	ActionQuickstart    Action = "quickstart"
+	ActionSelfUpdate    Action = "self-update"
+	ActionTemplateSetup Action = "template-setup"
+	ActionExecuteScript Action = "execute-script"
+	// ActionSelect asks which steps to run, as --select names them
+	ActionSelect Action = "select"
+)
+
+// Actions lists the valid actions, for help and completion
+var Actions = []string{
+	string(ActionQuickstart),
+	string(ActionSelfUpdate),
+	string(ActionTemplateSetup),
+	string(ActionExecuteScript),
+	string(ActionSelect),
+}
+
+func (a *Action) String() string {
+	if a == nil {
+		return ""
+	}
+
+	return string(*a)
+}
+
+func (a *Action) Set(s string) error {
+	for _, action := range Actions {
+		if s == action {
+			*a = Action(s)
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Invalid action %q (must be one of: %s).", s, strings.Join(Actions, ", "))
+}
+
+// Type is used by the shell completion generator
+func (a *Action) Type() string {
+	return "start.Action"
+}
+
+// stepsForAction returns the steps to run for the selected action. Every
+// action other than quickstart is a single step that emits the same
+// stepCompleteMsg the quickstart sequence would have reached.
+func stepsForAction(action Action) []step {
+	switch action {
+	case ActionSelfUpdate:
+		return []step{{description: "Updating DataRobot CLI...", fn: actionSelfUpdate, preview: previewSelfUpdate}}
+	case ActionTemplateSetup:
+		return []step{{description: "Launching template setup...", fn: actionTemplateSetup, preview: previewTemplateSetup}}
+	case ActionExecuteScript:
+		return []step{{description: "Finding and executing start command...", fn: actionExecuteScript, preview: previewExecuteScript}}
+	case actionOnboarding:
+		return onboardingSteps()
+	case ActionSelect:
+		// The steps are known once they have been chosen
+		return nil
+	case ActionQuickstart:
+	}
+
+	return quickstartSteps(true)
+}
+
+// quickstartSteps returns the steps of the full quickstart. The CLI version
+// check is left out when checkVersion is false, as after a selected self
+// update, which the running CLI would still report as missing.
+func quickstartSteps(checkVersion bool) []step {
+	steps := []step{
+		{description: "Starting application quickstart process...", fn: startQuickstart},
+	}
+
+	// The checks only read state, so they run at the same time
+	if checkVersion {
+		steps = append(steps, step{description: "Checking DataRobot CLI version...", fn: checkSelfVersion, independent: true, preview: previewSelfVersion})
+	}
+
+	return append(steps,
+		step{description: "Checking template prerequisites...", fn: checkPrerequisites, independent: true, preview: previewPrerequisites},
+		// TODO Implement validateEnvironment
+		// step{description: "Validating environment...", fn: validateEnvironment},
+		step{description: "Checking repository setup...", fn: checkRepository, independent: true, preview: previewRepository},
+		step{description: "Finding and executing start command...", fn: findAndExecuteStart, preview: previewFindAndExecuteStart},
+	)
+}
+
+func actionSelfUpdate(_ *Model) tea.Msg {
+	if update.SelfUpdateDisabled() {
+		return stepErrorMsg{err: update.ErrSelfUpdateDisabled}
+	}
+
+	return stepCompleteMsg{
+		selfUpdate:    true,
+		executeScript: true,
+	}
+}
+
+func actionTemplateSetup(m *Model) tea.Msg {
+	// A named template is cloned without the interactive wizard
+	if m.opts.Template != "" {
+		return stepCompleteMsg{
+			message:        fmt.Sprintf("Fetching template %s...\n", m.opts.Template),
+			selectTemplate: true,
+		}
+	}
+
+	return stepCompleteMsg{
+		message:           "Launching template setup...\n",
+		done:              true,
+		needTemplateSetup: true,
+	}
+}
+
+func actionExecuteScript(m *Model) tea.Msg {
+	if m.quickstartScriptPath != "" {
+		return stepCompleteMsg{
+			message:              fmt.Sprintf("Running quickstart script: %s\n", m.quickstartScriptPath),
+			quickstartScriptPath: m.quickstartScriptPath,
+			executeScript:        true,
+		}
+	}
+
+	if hasTask, _ := hasTaskStart(); hasTask {
+		return stepCompleteMsg{
+			message:              "Running 'task start'...\n",
+			quickstartScriptPath: "task-start",
+			executeScript:        true,
+		}
+	}
+
+	quickstartScript, err := findQuickstartScript()
+	if err != nil {
+		return stepErrorMsg{err: err}
+	}
+
+	if quickstartScript == "" {
+		return stepErrorMsg{err: errors.New("No start command or quickstart script found.")}
+	}
+
+	return stepCompleteMsg{
+		message:              fmt.Sprintf("Running quickstart script: %s\n", quickstartScript),
+		quickstartScriptPath: quickstartScript,
+		executeScript:        true,
+	}
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestActionSet(t *testing.T) {
+	var action Action
+
+	require.NoError(t, action.Set("self-update"))
+	assert.Equal(t, ActionSelfUpdate, action)
+
+	err := action.Set("deploy")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "execute-script")
+}
+
+func TestStepsForAction(t *testing.T) {
+	assert.Len(t, stepsForAction(ActionQuickstart), 5)
+	assert.Len(t, stepsForAction(""), 5)
+
+	steps := stepsForAction(ActionTemplateSetup)
+	require.Len(t, steps, 1)
+
+	msg, ok := steps[0].fn(&Model{}).(stepCompleteMsg)
+	require.True(t, ok)
+	assert.True(t, msg.needTemplateSetup)
+	assert.True(t, msg.done)
+
+	steps = stepsForAction(ActionSelfUpdate)
+	require.Len(t, steps, 1)
+
+	msg, ok = steps[0].fn(&Model{}).(stepCompleteMsg)
+	require.True(t, ok)
+	assert.True(t, msg.selfUpdate)
+	assert.True(t, msg.executeScript)
+}
+
+func TestActionExecuteScriptUsesSeededPath(t *testing.T) {
+	msg, ok := actionExecuteScript(&Model{quickstartScriptPath: "/tmp/quickstart.sh"}).(stepCompleteMsg)
+	require.True(t, ok)
+	assert.Equal(t, "/tmp/quickstart.sh", msg.quickstartScriptPath)
+	assert.True(t, msg.executeScript)
+}
+
+func TestResolveQuickstartScript(t *testing.T) {
+	dir := t.TempDir()
+	t.Chdir(dir)
+
+	script := filepath.Join(dir, "quickstart.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\n"), 0o755))
+
+	resolved, err := resolveQuickstartScript("quickstart.sh", "")
+	require.NoError(t, err)
+	assert.True(t, filepath.IsAbs(resolved))
+	assert.Equal(t, "quickstart.sh", filepath.Base(resolved))
+
+	_, err = resolveQuickstartScript("missing.sh", "")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+
+	_, err = resolveQuickstartScript(dir, "")
+	require.Error(t, err)
+
+	if runtime.GOOS != "windows" {
+		notExecutable := filepath.Join(dir, "notes.txt")
+		require.NoError(t, os.WriteFile(notExecutable, []byte("hi"), 0o644))
+
+		_, err = resolveQuickstartScript(notExecutable, "")
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "not executable")
+	}
+}
@@ -15,96 +15,385 @@
 package start
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/datarobot/cli/cmd/templates/setup"
 	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/misc/confirm"
+	"github.com/datarobot/cli/internal/printer"
 	"github.com/datarobot/cli/tui"
 	"github.com/spf13/cobra"
 )
 
+// exitCodeInterrupted is the conventional exit code after SIGINT
+const exitCodeInterrupted = 130
+
 type Options struct {
-	AnswerYes bool
+	AnswerYes       bool
+	DryRun          bool
+	ListSteps       bool
+	ExitOnError     bool
+	ContinueOnError bool
+	FailFast        bool
+	TimeoutPerStep  time.Duration
+	NoInjectCreds   bool
+	Template        string
+	Dir             string
+	Force           bool
+	Upgrade         bool
+	Action          Action
+	// Select names the steps to run, in place of Action
+	Select           []string
+	QuickstartScript string
+	Interpreter      string
+	WorkingDir       string
+	ProgressFormat   ProgressFormat
+	ForceUpdateCheck bool
+	Resume           bool
+	Restart          bool
+	Then             string
+	// ScriptArgs are the arguments given after --, passed on to the
+	// quickstart script
+	ScriptArgs []string
+	EnvFiles   []string
+
+	// fileEnv holds the variables read from EnvFiles
+	fileEnv []string
 }
 
 func Cmd() *cobra.Command { //nolint: cyclop
-	var opts Options
+	opts := Options{Action: ActionQuickstart, ProgressFormat: ProgressFormatTUI}
 
 	cmd := &cobra.Command{
-		Use:     "start",
+		Use:     "start [-- SCRIPT_ARGS...]",
 		Aliases: []string{"quickstart"},
+		Example: `  dr start
+  dr start --template talk-to-my-docs --dir ./docs-app
+  dr start --yes --timeout-per-step 10m
+  dr start --dry-run --list-steps
+  dr start -- --env staging`,
 		GroupID: "core",
 		Short:   "🚀 Run the application quickstart process",
 		Long: `Run the application quickstart process for the current template.
 The following actions will be performed:
 - Checking for prerequisite tooling
-- Executing the start script associated with the template, if available.`,
-		PreRunE: auth.EnsureAuthenticatedE,
+- Executing the start script associated with the template, if available.
+
+Arguments after -- are passed on to the quickstart script.`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			scriptArgs, err := scriptArguments(cmd, args, opts.Action)
+			if err != nil {
+				return err
+			}
+
+			opts.ScriptArgs = scriptArgs
+
+			if cmd.Flags().Changed("select") {
+				if opts.Select, err = resolveSelection(opts.Select, opts); err != nil {
+					return err
+				}
+			}
+
+			if err := checkSelectMenu(opts); err != nil {
+				return err
+			}
+
+			if opts.TimeoutPerStep < 0 {
+				return errors.New("--timeout-per-step must not be negative.")
+			}
+
+			// Relative paths in the other flags resolve against the
+			// working directory, so change to it first
+			if opts.WorkingDir != "" {
+				if err := changeWorkingDir(opts.WorkingDir); err != nil {
+					return err
+				}
+			}
+
+			// Validate the script before authenticating or starting the TUI
+			if opts.QuickstartScript != "" {
+				scriptPath, err := resolveQuickstartScript(opts.QuickstartScript, opts.Interpreter)
+				if err != nil {
+					return err
+				}
+
+				opts.QuickstartScript = scriptPath
+			}
+
+			fileEnv, err := loadEnvFiles(opts.EnvFiles)
+			if err != nil {
+				return err
+			}
+
+			opts.fileEnv = fileEnv
+
+			// The global --assume-yes answers the same prompts as --yes
+			opts.AnswerYes = opts.AnswerYes || confirm.AssumeYes()
+
+			// The active context may name the template to clone
+			if opts.Template == "" {
+				opts.Template = config.ContextTemplate()
+			}
+
+			// Listing the steps runs none of them, so needs no login
+			if opts.ListSteps {
+				return nil
+			}
+
+			return auth.EnsureAuthenticatedE(cmd, args)
+		},
 		RunE: func(cmd *cobra.Command, _ []string) error {
-			m := NewStartModel(opts)
+			if opts.ListSteps {
+				return listSteps(cmd.OutOrStdout(), opts)
+			}
+
+			// SIGINT and SIGTERM cancel the TUI, which restores the terminal,
+			// or stop the script of a headless run
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			if printer.IsStructured() || opts.ProgressFormat == ProgressFormatJSON || tui.Headless() {
+				return runHeadless(ctx, cmd, opts)
+			}
 
-			finalModel, err := tui.Run(m)
+			innerModel, ok, err := runModel(ctx, NewStartModel(opts))
 			if err != nil {
 				return err
 			}
 
-			innerModel, ok := getInnerModel(finalModel)
 			if !ok {
 				return nil
 			}
 
 			if innerModel.err != nil {
-				os.Exit(1)
+				os.Exit(failureExitCode(innerModel.err, opts.ExitOnError || opts.FailFast))
+			}
+
+			if err := innerModel.failedStepsError(); err != nil {
+				return err
+			}
+
+			if !innerModel.needTemplateSetup {
+				return runThenAfter(innerModel, opts)
 			}
 
 			// Check if we do not need to launch template setup after quitting
-			if !innerModel.needTemplateSetup || !innerModel.done || innerModel.quitting {
+			if !innerModel.done || innerModel.quitting {
 				return nil
 			}
 
 			// Need to run template setup
 			// After it completes, we'll be in the cloned directory,
 			// so we can just run start again
-			sm := setup.NewModel(true)
-
-			finalSetupModel, err := tui.Run(sm, tea.WithAltScreen(), tea.WithContext(cmd.Context()))
-			if err != nil {
+			if err := runTemplateSetup(ctx); err != nil {
 				return err
 			}
 
-			innerSetupModel, ok := setup.InnerModel(finalSetupModel)
-			if ok && innerSetupModel.ExitMessage != "" {
-				os.Exit(1)
+			// Only template setup was requested
+			if opts.Action == ActionTemplateSetup {
+				return runThenAfter(Model{done: true}, opts)
+			}
+
+			// Of the selected steps, only those after the template setup
+			// are left
+			nextOpts := opts
+
+			if len(innerModel.opts.Select) > 0 {
+				nextOpts.Action = ActionQuickstart
+				nextOpts.Select = innerModel.remainingSelection()
+
+				if len(nextOpts.Select) == 0 {
+					return runThenAfter(Model{done: true}, opts)
+				}
 			}
 
 			// Now run start again - we're in the cloned repo directory
 			// Create a new start model and run it
-			m2 := NewStartModel(opts)
-
-			finalModel2, err := tui.Run(m2)
+			innerModel2, ok, err := runModel(ctx, NewStartModel(nextOpts))
 			if err != nil {
 				return err
 			}
 
-			innerModel2, ok := getInnerModel(finalModel2)
 			if !ok {
 				return nil
 			}
 
 			if innerModel2.err != nil {
-				os.Exit(1)
+				os.Exit(failureExitCode(innerModel2.err, opts.ExitOnError || opts.FailFast))
+			}
+
+			if err := innerModel2.failedStepsError(); err != nil {
+				return err
 			}
 
-			return nil
+			return runThenAfter(innerModel2, opts)
 		},
 	}
 
-	cmd.Flags().BoolVarP(&opts.AnswerYes, "yes", "y", false, "Assume \"yes\" as answer to all prompts.")
+	cmd.PersistentFlags().StringVarP(&opts.WorkingDir, "working-dir", "C", "",
+		"Run as if started in this directory instead of the current one")
+	cmd.Flags().BoolVar(&opts.AnswerYes, "yes", false, "Assume \"yes\" as answer to all prompts (same as the global --assume-yes).")
+	cmd.Flags().Var(&opts.Action, "action",
+		fmt.Sprintf("Run a single action instead of the full quickstart (options: %s)", strings.Join(Actions, ", ")))
+
+	cmd.Flags().StringSliceVar(&opts.Select, "select", nil,
+		fmt.Sprintf("Run only these steps, always in the order %s, instead of the full quickstart (--action select asks with a menu)",
+			strings.Join(selectNames(), ", ")))
+	cmd.MarkFlagsMutuallyExclusive("action", "select")
+
+	cmd.Flags().BoolVar(&opts.DryRun, "dry-run", false,
+		"Show the script or update that would run without executing it")
+	cmd.Flags().BoolVar(&opts.ListSteps, "list-steps", false,
+		"List the steps that would run with the given flags, and why any are skipped, then exit")
+	cmd.Flags().StringVar(&opts.QuickstartScript, "quickstart-script", "",
+		"Path to the quickstart script to run, skipping auto-detection")
+	cmd.Flags().StringVar(&opts.Interpreter, "interpreter", "",
+		"Command to run the quickstart script with, such as \"python3 -u\" (default: from its shebang line or extension)")
+	cmd.Flags().StringVar(&opts.Template, "template", "",
+		"Name or ID of the template to clone when not in a DataRobot repository")
+	cmd.Flags().StringVar(&opts.Dir, "dir", "",
+		"Directory to clone the template into (default: named after the template repository)")
+	cmd.Flags().BoolVar(&opts.Force, "force", false,
+		"Replace the contents of a non-empty --dir (implied by --yes and --assume-yes, except for a template you have changed)")
+	cmd.Flags().BoolVar(&opts.Upgrade, "upgrade", false,
+		"Replace a template an earlier run set up in --dir with the catalog's current version (implied by --yes and --assume-yes)")
+	cmd.Flags().StringArrayVar(&opts.EnvFiles, "env-file", nil,
+		"Dotenv file of variables to pass to the quickstart script (repeatable; later files override earlier ones)")
+	cmd.Flags().BoolVar(&opts.NoInjectCreds, "no-inject-creds", false,
+		"Do not pass the DataRobot API token and endpoint to the quickstart script")
+	cmd.Flags().BoolVar(&opts.ExitOnError, "exit-on-error", false,
+		"Exit with the quickstart script's exit code when it fails (always the case with --output json or yaml)")
+	cmd.Flags().DurationVar(&opts.TimeoutPerStep, "timeout-per-step", 0,
+		"Fail a step, including the quickstart script, that runs longer than this (0 disables)")
+	cmd.Flags().BoolVar(&opts.FailFast, "fail-fast", false,
+		"Stop at the first step that fails or times out and exit with its exit code, as with --exit-on-error (stopping is the default)")
+	cmd.Flags().BoolVar(&opts.ContinueOnError, "continue-on-error", false,
+		"Go on with the remaining steps after one fails or times out, then summarize each step's status; the run still exits non-zero")
+	cmd.MarkFlagsMutuallyExclusive("fail-fast", "continue-on-error")
+
+	cmd.Flags().BoolVar(&opts.Resume, "resume", false,
+		"Skip the steps an interrupted run in this directory already completed")
+	cmd.Flags().BoolVar(&opts.Restart, "restart", false,
+		"Discard the saved progress of an interrupted run and start from the beginning")
+	cmd.MarkFlagsMutuallyExclusive("resume", "restart")
+
+	cmd.Flags().StringVar(&opts.Then, "then", "",
+		"Shell command to run once every step has completed successfully, with the same credentials as the quickstart script")
+
+	cmd.Flags().BoolVar(&opts.ForceUpdateCheck, "force-update-check", false,
+		"Check for a newer CLI release even if the last check is more recent than update-check-interval")
+	cmd.Flags().Var(&opts.ProgressFormat, "progress-format",
+		fmt.Sprintf("How to report progress (options: %s); json writes one event per step to stdout instead of the TUI",
+			strings.Join(ProgressFormats, ", ")))
+
+	_ = cmd.RegisterFlagCompletionFunc("action", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return Actions, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	_ = cmd.RegisterFlagCompletionFunc("select", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return selectNames(), cobra.ShellCompDirectiveNoFileComp
+	})
+
+	_ = cmd.RegisterFlagCompletionFunc("progress-format", func(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+		return ProgressFormats, cobra.ShellCompDirectiveNoFileComp
+	})
 
 	return cmd
 }
 
+// scriptArguments returns the arguments given after --. They are only
+// accepted when the selected action may run the quickstart script.
+func scriptArguments(cmd *cobra.Command, args []string, action Action) ([]string, error) {
+	dash := cmd.ArgsLenAtDash()
+
+	if dash != 0 && len(args) > 0 {
+		return nil, fmt.Errorf("Unexpected argument %q. Put arguments for the quickstart script after --.", args[0])
+	}
+
+	if len(args) > 0 && (action == ActionSelfUpdate || action == ActionTemplateSetup) {
+		return nil, fmt.Errorf("Arguments after -- are passed to the quickstart script, which --action %s does not run.", action)
+	}
+
+	return args, nil
+}
+
+// changeWorkingDir makes dir the working directory, failing if it does not
+// exist or cannot be read
+func changeWorkingDir(dir string) error {
+	info, err := os.Stat(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("Working directory %s does not exist.", dir)
+	}
+
+	if err != nil {
+		return fmt.Errorf("Cannot access working directory %s: %w", dir, err)
+	}
+
+	if !info.IsDir() {
+		return fmt.Errorf("Working directory %s is not a directory.", dir)
+	}
+
+	if _, err := os.ReadDir(dir); err != nil {
+		return fmt.Errorf("Cannot access working directory %s: %w", dir, err)
+	}
+
+	if err := os.Chdir(dir); err != nil {
+		return fmt.Errorf("Cannot change to working directory %s: %w", dir, err)
+	}
+
+	log.Debug("start: changed working directory", "dir", dir)
+
+	return nil
+}
+
+// runTemplateSetup runs the interactive template setup, which leaves the
+// working directory in the cloned template. It exits with status 1 if the
+// setup failed.
+func runTemplateSetup(ctx context.Context) error {
+	finalSetupModel, err := tui.Run(setup.NewModel(true), tea.WithAltScreen(), tea.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	innerSetupModel, ok := setup.InnerModel(finalSetupModel)
+	if ok && innerSetupModel.ExitMessage != "" {
+		os.Exit(1)
+	}
+
+	return nil
+}
+
+// runModel runs the start TUI until it quits or ctx is cancelled. A script
+// still running at that point is interrupted, and an interrupted run exits
+// with exitCodeInterrupted once the terminal has been restored.
+func runModel(ctx context.Context, m Model) (Model, bool, error) {
+	m.runCtx = ctx
+
+	finalModel, err := tui.Run(m, tea.WithContext(ctx), tea.WithoutSignalHandler())
+
+	innerModel, ok := getInnerModel(finalModel)
+
+	if ctx.Err() != nil || (ok && innerModel.interrupted) {
+		os.Exit(exitCodeInterrupted)
+	}
+
+	if err != nil {
+		return Model{}, false, err
+	}
+
+	return innerModel, ok, nil
+}
+
 func getInnerModel(finalModel tea.Model) (Model, bool) {
 	startModel, ok := finalModel.(tui.InterruptibleModel)
 	if !ok {
@@ -0,0 +1,173 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/datarobot/cli/internal/update"
+	"github.com/datarobot/cli/internal/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// parseScriptArguments parses argv like 'dr start' would and returns the
+// arguments for the quickstart script
+func parseScriptArguments(t *testing.T, action Action, argv ...string) ([]string, error) {
+	t.Helper()
+
+	var (
+		got []string
+		err error
+	)
+
+	cmd := &cobra.Command{
+		Use: "start",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			got, err = scriptArguments(cmd, args, action)
+
+			return nil
+		},
+	}
+	cmd.Flags().Bool("yes", false, "")
+	cmd.SetArgs(argv)
+
+	require.NoError(t, cmd.Execute())
+
+	return got, err
+}
+
+func TestScriptArguments(t *testing.T) {
+	args, err := parseScriptArguments(t, ActionQuickstart, "--yes", "--", "--flag", "value", "two words", "--yes")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"--flag", "value", "two words", "--yes"}, args)
+
+	args, err = parseScriptArguments(t, ActionQuickstart, "--yes")
+	require.NoError(t, err)
+	assert.Empty(t, args)
+
+	_, err = parseScriptArguments(t, ActionQuickstart, "value")
+	require.ErrorContains(t, err, `Unexpected argument "value"`)
+
+	_, err = parseScriptArguments(t, ActionQuickstart, "value", "--", "--flag")
+	require.ErrorContains(t, err, `Unexpected argument "value"`)
+
+	_, err = parseScriptArguments(t, ActionSelfUpdate, "--", "--flag")
+	require.ErrorContains(t, err, "--action self-update does not run")
+
+	args, err = parseScriptArguments(t, ActionExecuteScript, "--", "--flag")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"--flag"}, args)
+}
+
+func TestChangeWorkingDir(t *testing.T) {
+	root := t.TempDir()
+	t.Chdir(root)
+
+	project := filepath.Join(root, "my-project")
+	require.NoError(t, os.Mkdir(project, 0o755))
+
+	file := filepath.Join(root, "notes.txt")
+	require.NoError(t, os.WriteFile(file, []byte("notes"), 0o644))
+
+	assert.ErrorContains(t, changeWorkingDir(filepath.Join(root, "missing")), "does not exist")
+	assert.ErrorContains(t, changeWorkingDir(file), "is not a directory")
+
+	require.NoError(t, changeWorkingDir("my-project"))
+
+	cwd, err := os.Getwd()
+	require.NoError(t, err)
+
+	resolved, err := filepath.EvalSymlinks(project)
+	require.NoError(t, err)
+	assert.Equal(t, resolved, cwd)
+
+	// Relative paths now resolve against the new working directory
+	dir, err := templateDir(drapi.Template{Name: "Talk to My Data"}, "app")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(cwd, "app"), dir)
+}
+
+func TestUpdateNoticeUsesCachedCheck(t *testing.T) {
+	testutil.SetTestHomeDir(t, t.TempDir())
+
+	hits := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits++
+
+		_ = json.NewEncoder(w).Encode(map[string]string{"tag_name": "v0.3.0"})
+	}))
+	t.Cleanup(server.Close)
+
+	originalURL, originalVersion := update.ReleasesURL, version.Version
+	update.ReleasesURL, version.Version = server.URL, "v0.2.0"
+
+	t.Cleanup(func() { update.ReleasesURL, version.Version = originalURL, originalVersion })
+
+	m := NewStartModel(Options{})
+	assert.Contains(t, m.updateNotice(), "v0.3.0 is available (installed: v0.2.0)")
+	assert.Contains(t, m.updateNotice(), "dr self update")
+	assert.Equal(t, 1, hits, "the second check should come from the cache")
+
+	m = NewStartModel(Options{ForceUpdateCheck: true})
+	assert.NotEmpty(t, m.updateNotice())
+	assert.Equal(t, 2, hits, "--force-update-check should bypass the cache")
+
+	version.Version = "dev"
+	assert.Empty(t, m.updateNotice())
+	assert.Equal(t, 2, hits, "development builds should not be checked")
+}
+
+func TestDisableSelfUpdate(t *testing.T) {
+	home := t.TempDir()
+	testutil.SetTestHomeDir(t, home)
+
+	viper.Set(update.DisableSelfUpdateKey, true)
+	t.Cleanup(viper.Reset)
+
+	originalVersion := version.Version
+	version.Version = "v0.1.0"
+
+	t.Cleanup(func() { version.Version = originalVersion })
+
+	repoDir := filepath.Join(home, "app")
+	require.NoError(t, os.MkdirAll(filepath.Join(repoDir, ".datarobot", "answers"), 0o755))
+	require.NoError(t, os.MkdirAll(filepath.Join(repoDir, ".datarobot", "cli"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, ".datarobot", "cli", "versions.yaml"),
+		[]byte("dr:\n  name: DataRobot CLI\n  minimum-version: 0.2.0\n"), 0o644))
+	t.Chdir(repoDir)
+
+	m := NewStartModel(Options{})
+
+	msg, ok := checkSelfVersion(&m).(stepCompleteMsg)
+	require.True(t, ok)
+	assert.False(t, msg.selfUpdate, "no update should be offered")
+	assert.False(t, msg.waiting)
+	assert.Contains(t, msg.message, "Self-update is disabled")
+	assert.Empty(t, m.updateNotice())
+
+	errMsg, ok := actionSelfUpdate(&m).(stepErrorMsg)
+	require.True(t, ok)
+	assert.ErrorIs(t, errMsg.err, update.ErrSelfUpdateDisabled)
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const stepLatency = 100 * time.Millisecond
+
+func slowStep(_ *Model) tea.Msg {
+	time.Sleep(stepLatency)
+
+	return stepCompleteMsg{}
+}
+
+// runSteps drives m like the Bubble Tea runtime does: commands run in
+// goroutines and their messages are applied one at a time
+func runSteps(t *testing.T, m Model) Model {
+	t.Helper()
+
+	msgs := make(chan tea.Msg, 16)
+
+	var run func(tea.Cmd)
+
+	run = func(cmd tea.Cmd) {
+		if cmd == nil {
+			return
+		}
+
+		go func() {
+			msg := cmd()
+			if batch, ok := msg.(tea.BatchMsg); ok {
+				for _, c := range batch {
+					run(c)
+				}
+
+				return
+			}
+
+			msgs <- msg
+		}()
+	}
+
+	run(m.Init())
+
+	var model tea.Model = m
+
+	for {
+		select {
+		case msg := <-msgs:
+			if _, ok := msg.(tea.QuitMsg); ok {
+				return model.(Model)
+			}
+
+			var cmd tea.Cmd
+
+			model, cmd = model.Update(msg)
+			run(cmd)
+		case <-time.After(5 * time.Second):
+			t.Fatal("steps did not finish")
+		}
+	}
+}
+
+func independentSteps(fns ...func(*Model) tea.Msg) []step {
+	steps := []step{{description: "start", fn: startQuickstart}}
+
+	for _, fn := range fns {
+		steps = append(steps, step{description: "check", fn: fn, independent: true})
+	}
+
+	return append(steps, step{description: "finish", fn: slowStep})
+}
+
+func TestIndependentStepsRunConcurrently(t *testing.T) {
+	m := Model{steps: independentSteps(slowStep, slowStep, slowStep), opts: Options{DryRun: true}}
+
+	start := time.Now()
+	final := runSteps(t, m)
+	elapsed := time.Since(start)
+
+	require.NoError(t, final.err)
+	assert.True(t, final.done)
+	assert.Equal(t, len(m.steps)-1, final.current)
+
+	// Three overlapping checks and the dependent last step, rather than
+	// four steps in a row
+	assert.Less(t, elapsed, 3*stepLatency)
+}
+
+func TestIndependentStepResultsApplyInOrder(t *testing.T) {
+	failFast := func(_ *Model) tea.Msg {
+		return stepErrorMsg{err: errors.New("Missing prerequisites.")}
+	}
+
+	m := Model{steps: independentSteps(slowStep, failFast), opts: Options{DryRun: true}}
+
+	start := time.Now()
+	final := runSteps(t, m)
+
+	// The failure finished first but is reported for its own step, once
+	// the slower step before it completed
+	require.EqualError(t, final.err, "Missing prerequisites.")
+	assert.Equal(t, 2, final.current)
+	assert.GreaterOrEqual(t, time.Since(start), stepLatency)
+}
+
+func TestHeadlessRunsIndependentStepsConcurrently(t *testing.T) {
+	m := Model{steps: independentSteps(slowStep, slowStep, slowStep), opts: Options{DryRun: true}, headless: true}
+
+	start := time.Now()
+	result := startResult{}
+
+	require.NoError(t, m.runStepsHeadless(&result))
+	assert.Less(t, time.Since(start), 3*stepLatency)
+	assert.Len(t, result.Steps, 5)
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"strings"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/viper"
+)
+
+// Config keys naming the environment variables the quickstart script
+// receives the DataRobot credentials in
+const (
+	tokenEnvKey    = "start.token-env"
+	endpointEnvKey = "start.endpoint-env"
+)
+
+const (
+	defaultTokenEnv    = "DATAROBOT_API_TOKEN"
+	defaultEndpointEnv = "DATAROBOT_ENDPOINT"
+	redactedValue      = "****"
+)
+
+// envName returns the environment variable name configured under key
+func envName(key, fallback string) string {
+	if name := strings.TrimSpace(viper.GetString(key)); name != "" {
+		return name
+	}
+
+	return fallback
+}
+
+// credentialsEnv returns the environment entries that pass the resolved
+// token and API endpoint to the quickstart script. Nothing is injected with
+// --no-inject-creds or --skip-auth.
+func credentialsEnv(opts Options) []string {
+	if opts.NoInjectCreds || viper.GetBool("skip-auth") {
+		return nil
+	}
+
+	creds, err := auth.ResolveCredentials()
+	if err != nil {
+		log.Warn("Not passing DataRobot credentials to the quickstart script", "error", err)
+
+		return nil
+	}
+
+	var env []string
+
+	if endpoint, err := apiclient.APIURL(creds.Endpoint, ""); err == nil {
+		env = append(env, envName(endpointEnvKey, defaultEndpointEnv)+"="+endpoint)
+	}
+
+	if creds.Token != "" {
+		// The script may echo its environment into the --log-file transcript
+		log.AddSecret(creds.Token)
+
+		env = append(env, envName(tokenEnvKey, defaultTokenEnv)+"="+creds.Token)
+	}
+
+	return env
+}
+
+// colorEnv asks scripts not to color their output when --no-color is set.
+// NO_COLOR itself is inherited from the CLI's environment.
+func colorEnv() []string {
+	if viper.GetBool(tui.NoColorKey) {
+		return []string{"NO_COLOR=1"}
+	}
+
+	return nil
+}
+
+// redactValues masks every value in env. Env files often hold secrets of
+// their own, so only the names of their variables are shown.
+func redactValues(env []string) []string {
+	redacted := make([]string, 0, len(env))
+
+	for _, entry := range env {
+		name, _, _ := strings.Cut(entry, "=")
+		redacted = append(redacted, name+"="+redactedValue)
+	}
+
+	return redacted
+}
+
+// redactEnv masks the token in env so it can be shown to the user
+func redactEnv(env []string) []string {
+	tokenEnv := envName(tokenEnvKey, defaultTokenEnv)
+	redacted := make([]string, 0, len(env))
+
+	for _, entry := range env {
+		if name, _, _ := strings.Cut(entry, "="); name == tokenEnv {
+			entry = name + "=" + redactedValue
+		}
+
+		redacted = append(redacted, entry)
+	}
+
+	return redacted
+}
@@ -0,0 +1,166 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+func setupCredentials(t *testing.T) {
+	t.Helper()
+
+	keyring.MockInit()
+	testutil.SetTestHomeDir(t, t.TempDir())
+	t.Setenv("DATAROBOT_API_TOKEN", "")
+	t.Setenv("DATAROBOT_ENDPOINT", "")
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	config.ConfigureEnv(viper.GetViper())
+	viper.Set(config.DataRobotURL, "https://app.datarobot.com")
+	viper.Set(config.DataRobotAPIKey, "secret-token")
+}
+
+func TestCredentialsEnv(t *testing.T) {
+	setupCredentials(t)
+
+	assert.Equal(t, []string{
+		"DATAROBOT_ENDPOINT=https://app.datarobot.com/api/v2",
+		"DATAROBOT_API_TOKEN=secret-token",
+	}, credentialsEnv(Options{}))
+
+	assert.Empty(t, credentialsEnv(Options{NoInjectCreds: true}))
+
+	viper.Set("skip-auth", true)
+
+	assert.Empty(t, credentialsEnv(Options{}))
+}
+
+func TestCredentialsEnvConfigurableNames(t *testing.T) {
+	setupCredentials(t)
+	viper.Set(tokenEnvKey, "DR_TOKEN")
+	viper.Set(endpointEnvKey, "DR_URL")
+
+	env := credentialsEnv(Options{})
+
+	assert.Equal(t, []string{"DR_URL=https://app.datarobot.com/api/v2", "DR_TOKEN=secret-token"}, env)
+	assert.Equal(t, []string{"DR_URL=https://app.datarobot.com/api/v2", "DR_TOKEN=****"}, redactEnv(env))
+}
+
+func TestQuickstartPlanRedactsToken(t *testing.T) {
+	setupCredentials(t)
+
+	m := Model{quickstartScriptPath: "/tmp/quickstart.sh"}
+	plan, err := m.planQuickstart()
+	require.NoError(t, err)
+
+	assert.Contains(t, plan.Env, "DATAROBOT_API_TOKEN=****")
+	assert.NotContains(t, plan.String(), "secret-token")
+
+	cmd, err := m.quickstartCommand()
+	require.NoError(t, err)
+	assert.Contains(t, cmd.Env, "DATAROBOT_API_TOKEN=secret-token")
+}
+
+func TestColorEnv(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	assert.Empty(t, colorEnv())
+
+	viper.Set(tui.NoColorKey, true)
+
+	assert.Equal(t, []string{"NO_COLOR=1"}, colorEnv())
+}
+
+func TestScriptOutputMasksToken(t *testing.T) {
+	setupCredentials(t)
+
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell script")
+	}
+
+	transcript := filepath.Join(t.TempDir(), "session.log")
+	require.NoError(t, log.StartTranscript(transcript, false))
+	t.Cleanup(log.StopTranscript)
+
+	path := filepath.Join(t.TempDir(), "quickstart.sh")
+	body := "#!/bin/sh\necho \"token is $DATAROBOT_API_TOKEN\"\necho 'curl -H \"Authorization: Bearer abcdef123456\"'\n"
+	require.NoError(t, os.WriteFile(path, []byte(body), 0o755))
+
+	var out terminal
+
+	runScriptModel(t, Model{quickstartScriptPath: path}, nil, &out)
+
+	assert.Equal(t, "token is ****\ncurl -H \"Authorization: Bearer ****\"\n", out.String())
+
+	log.StopTranscript()
+
+	data, err := os.ReadFile(transcript)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "script | token is ****")
+	assert.NotContains(t, string(data), "secret-token")
+	assert.NotContains(t, string(data), "abcdef123456")
+}
+
+func TestHeadlessScriptOutputMasksToken(t *testing.T) {
+	setupCredentials(t)
+
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell script")
+	}
+
+	path := filepath.Join(t.TempDir(), "quickstart.sh")
+	body := "#!/bin/sh\necho \"token is $DATAROBOT_API_TOKEN\"\nprintf 'curl -H \"Authorization: Bearer abcdef123456\"'\n"
+	require.NoError(t, os.WriteFile(path, []byte(body), 0o755))
+
+	// The script's output goes to stderr, which is the CI log
+	r, w, err := os.Pipe()
+	require.NoError(t, err)
+
+	realStderr := os.Stderr
+	os.Stderr = w
+
+	t.Cleanup(func() { os.Stderr = realStderr })
+
+	m := NewStartModel(Options{Action: ActionExecuteScript, QuickstartScript: path, AnswerYes: true})
+	runErr := m.runStepsHeadless(&startResult{})
+
+	require.NoError(t, w.Close())
+
+	os.Stderr = realStderr
+
+	out, err := io.ReadAll(r)
+	require.NoError(t, err)
+	require.NoError(t, runErr)
+
+	assert.Contains(t, string(out), "token is ****\n")
+	assert.Contains(t, string(out), `curl -H "Authorization: Bearer ****"`, "a last line without a newline is masked too")
+	assert.NotContains(t, string(out), "secret-token")
+	assert.NotContains(t, string(out), "abcdef123456")
+}
@@ -0,0 +1,182 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// envNamePattern matches the variable names an env file may set
+var envNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// loadEnvFiles reads the --env-file files in order and returns their
+// variables as NAME=value entries. A variable set by a later file replaces
+// the value from an earlier one.
+func loadEnvFiles(paths []string) ([]string, error) {
+	var (
+		names  []string
+		values = make(map[string]string)
+	)
+
+	for _, path := range paths {
+		vars, err := readEnvFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range vars {
+			if _, ok := values[v.name]; !ok {
+				names = append(names, v.name)
+			}
+
+			values[v.name] = v.value
+		}
+	}
+
+	env := make([]string, 0, len(names))
+
+	for _, name := range names {
+		env = append(env, name+"="+values[name])
+	}
+
+	return env, nil
+}
+
+type envVar struct {
+	name  string
+	value string
+}
+
+func readEnvFile(path string) ([]envVar, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("Cannot read env file: %w", err)
+	}
+
+	defer file.Close()
+
+	return parseEnvFile(file, path)
+}
+
+// parseEnvFile parses dotenv lines of the form NAME=value, optionally
+// preceded by "export". Values may be double-quoted, with Go escapes such
+// as \n, or single-quoted to be taken literally; an unquoted value ends at
+// a " #" comment. Blank lines and lines starting with # are ignored, and
+// any other line is an error naming the file and line.
+func parseEnvFile(r io.Reader, path string) ([]envVar, error) {
+	var vars []envVar
+
+	scanner := bufio.NewScanner(r)
+
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		v, err := parseEnvLine(line)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid line %d in env file %s: %s.", lineNo, path, err)
+		}
+
+		vars = append(vars, v)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("Cannot read env file %s: %w", path, err)
+	}
+
+	return vars, nil
+}
+
+func parseEnvLine(line string) (envVar, error) {
+	if rest, ok := strings.CutPrefix(line, "export "); ok {
+		line = strings.TrimSpace(rest)
+	}
+
+	name, raw, ok := strings.Cut(line, "=")
+	if !ok {
+		return envVar{}, errors.New("expected NAME=value")
+	}
+
+	name = strings.TrimSpace(name)
+	if !envNamePattern.MatchString(name) {
+		return envVar{}, fmt.Errorf("%q is not a valid variable name", name)
+	}
+
+	value, err := parseEnvValue(strings.TrimSpace(raw))
+	if err != nil {
+		return envVar{}, err
+	}
+
+	return envVar{name: name, value: value}, nil
+}
+
+func parseEnvValue(raw string) (string, error) {
+	if raw == "" {
+		return "", nil
+	}
+
+	quote := raw[0]
+	if quote != '"' && quote != '\'' {
+		if i := strings.Index(raw, " #"); i >= 0 {
+			raw = raw[:i]
+		}
+
+		return strings.TrimSpace(raw), nil
+	}
+
+	end := closingQuote(raw, quote)
+	if end < 0 {
+		return "", errors.New("unterminated quoted value")
+	}
+
+	if rest := strings.TrimSpace(raw[end+1:]); rest != "" && !strings.HasPrefix(rest, "#") {
+		return "", fmt.Errorf("unexpected text %q after the quoted value", rest)
+	}
+
+	if quote == '\'' {
+		return raw[1:end], nil
+	}
+
+	value, err := strconv.Unquote(raw[:end+1])
+	if err != nil {
+		return "", errors.New("invalid escape in quoted value")
+	}
+
+	return value, nil
+}
+
+// closingQuote returns the index of the quote that ends the value opened
+// at raw[0], or -1. Only double quotes can be escaped.
+func closingQuote(raw string, quote byte) int {
+	for i := 1; i < len(raw); i++ {
+		switch {
+		case quote == '"' && raw[i] == '\\':
+			i++
+		case raw[i] == quote:
+			return i
+		}
+	}
+
+	return -1
+}
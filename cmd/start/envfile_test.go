@@ -0,0 +1,121 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseEnvFile(t *testing.T) {
+	input := `# Project settings
+
+APP_NAME=my-app
+export REGION = us-east-1
+EMPTY=
+SPACED =  padded value   # trailing comment
+HASH=color#fff
+DOUBLE="line one\nline two" # comment
+SINGLE='literal \n $HOME'
+QUOTED_HASH="a # b"
+EQUALS=a=b
+`
+
+	vars, err := parseEnvFile(strings.NewReader(input), ".env")
+	require.NoError(t, err)
+
+	assert.Equal(t, []envVar{
+		{name: "APP_NAME", value: "my-app"},
+		{name: "REGION", value: "us-east-1"},
+		{name: "EMPTY", value: ""},
+		{name: "SPACED", value: "padded value"},
+		{name: "HASH", value: "color#fff"},
+		{name: "DOUBLE", value: "line one\nline two"},
+		{name: "SINGLE", value: `literal \n $HOME`},
+		{name: "QUOTED_HASH", value: "a # b"},
+		{name: "EQUALS", value: "a=b"},
+	}, vars)
+}
+
+func TestParseEnvFileRejectsMalformedLines(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"A=1\nnot a variable\n", "Invalid line 2 in env file .env: expected NAME=value."},
+		{"1ST=value\n", `Invalid line 1 in env file .env: "1ST" is not a valid variable name.`},
+		{"MY VAR=value\n", `"MY VAR" is not a valid variable name`},
+		{"=value\n", `"" is not a valid variable name`},
+		{"A=\"unterminated\n", "unterminated quoted value"},
+		{"A='unterminated\n", "unterminated quoted value"},
+		{"A=\"quoted\" extra\n", `unexpected text "extra" after the quoted value`},
+		{"A=\"bad \\q escape\"\n", "invalid escape"},
+	}
+
+	for _, tt := range tests {
+		_, err := parseEnvFile(strings.NewReader(tt.input), ".env")
+		assert.ErrorContains(t, err, tt.want, tt.input)
+	}
+}
+
+func TestLoadEnvFilesLaterOverrides(t *testing.T) {
+	dir := t.TempDir()
+
+	base := filepath.Join(dir, "base.env")
+	require.NoError(t, os.WriteFile(base, []byte("A=1\nB=2\n"), 0o644))
+
+	local := filepath.Join(dir, "local.env")
+	require.NoError(t, os.WriteFile(local, []byte("B=3\nC=4\n"), 0o644))
+
+	env, err := loadEnvFiles([]string{base, local})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"A=1", "B=3", "C=4"}, env)
+
+	_, err = loadEnvFiles([]string{filepath.Join(dir, "missing.env")})
+	assert.ErrorContains(t, err, "Cannot read env file")
+}
+
+func TestEnvFileIsLayeredUnderCredentials(t *testing.T) {
+	setupCredentials(t)
+
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell script")
+	}
+
+	script := filepath.Join(t.TempDir(), "quickstart.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\necho \"$APP_NAME $DATAROBOT_API_TOKEN\"\n"), 0o755))
+
+	m := Model{
+		opts:                 Options{fileEnv: []string{"APP_NAME=my-app", "DATAROBOT_API_TOKEN=from-file"}},
+		quickstartScriptPath: script,
+	}
+
+	plan, err := m.planQuickstart()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"APP_NAME=****", "DATAROBOT_API_TOKEN=****"}, plan.Env[:2], "env file values are not shown")
+
+	cmd, err := m.quickstartCommand()
+	require.NoError(t, err)
+
+	output, err := cmd.Output()
+	require.NoError(t, err)
+	assert.Equal(t, "my-app secret-token\n", string(output), "the credentials override the env file")
+}
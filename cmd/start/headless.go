@@ -0,0 +1,403 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/datarobot/cli/internal/errs"
+	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/internal/state"
+	"github.com/spf13/cobra"
+)
+
+// Step statuses reported in structured output
+const (
+	stepStatusCompleted = "completed"
+	stepStatusFailed    = "failed"
+	stepStatusSkipped   = "skipped"
+)
+
+type stepResult struct {
+	Description string `json:"description"       yaml:"description"`
+	Status      string `json:"status"            yaml:"status"`
+	Message     string `json:"message,omitempty" yaml:"message,omitempty"`
+}
+
+// startResult is the structured result of the quickstart process
+type startResult struct {
+	Steps              []stepResult `json:"steps"                yaml:"steps"`
+	Script             string       `json:"script,omitempty"     yaml:"script,omitempty"`
+	TemplateDir        string       `json:"template_dir,omitempty" yaml:"template_dir,omitempty"`
+	NeedsTemplateSetup bool         `json:"needs_template_setup" yaml:"needs_template_setup"`
+	UpdateAvailable    bool         `json:"update_available"     yaml:"update_available"`
+	DryRun             bool         `json:"dry_run"              yaml:"dry_run"`
+	Plan               *scriptPlan  `json:"plan,omitempty"       yaml:"plan,omitempty"`
+	Update             *updatePlan  `json:"update,omitempty"     yaml:"update,omitempty"`
+	ExitCode           int          `json:"exit_code,omitempty"  yaml:"exit_code,omitempty"`
+	Then               *thenResult  `json:"then,omitempty"       yaml:"then,omitempty"`
+	Success            bool         `json:"success"              yaml:"success"`
+}
+
+// runHeadless runs the quickstart steps without the TUI, for use with
+// --output json or yaml and --progress-format json. Prompts cannot be
+// answered, so anything that would wait for confirmation is skipped unless
+// --yes or --assume-yes is set. Script output goes to stderr to keep stdout parseable, and
+// a failing script's exit code becomes the exit code of the CLI. Progress
+// events replace the final result, so stdout stays one JSON object per line.
+// With text output, as when JSON logs go to a collector, the result is a
+// table of the steps and their status.
+func runHeadless(ctx context.Context, cmd *cobra.Command, opts Options) error {
+	m := NewStartModel(opts)
+	m.headless = true
+	m.runCtx = ctx
+	result := startResult{Steps: make([]stepResult, 0, len(m.steps)), DryRun: opts.DryRun}
+
+	if opts.ProgressFormat == ProgressFormatJSON {
+		m.progress = newProgressEncoder(cmd.OutOrStdout())
+	}
+
+	err := m.runStepsHeadless(&result)
+
+	if err == nil && opts.Then != "" && !opts.DryRun && allStepsCompleted(result) {
+		// Like the script's, the command's output goes to stderr
+		then := thenResult{Command: opts.Then}
+		then.ExitCode, err = runThen(opts, os.Stdin, os.Stderr, os.Stderr)
+		result.Then = &then
+	}
+
+	result.Success = err == nil
+
+	if m.progress == nil {
+		if printErr := printer.Print(cmd.OutOrStdout(), result, func(w io.Writer) error {
+			return writeSummary(w, result.Steps)
+		}); printErr != nil {
+			return printErr
+		}
+	}
+
+	return err
+}
+
+func (m *Model) runStepsHeadless(result *startResult) error {
+	for m.current = 0; m.current < m.resumed; m.current++ {
+		step := stepResult{Description: m.currentStep().description, Status: stepStatusSkipped, Message: "Completed in a previous run."}
+		result.Steps = append(result.Steps, step)
+		m.emitProgress(step, false)
+	}
+
+	running := map[int]<-chan tea.Msg{}
+
+	for ; m.current < len(m.steps); m.current++ {
+		if m.runContext().Err() != nil {
+			return errRunInterrupted
+		}
+
+		currentStep := m.currentStep()
+		step := stepResult{Description: currentStep.description, Status: stepStatusCompleted}
+
+		switch msg := m.stepResultHeadless(running).(type) {
+		case stepErrorMsg:
+			step.Status = stepStatusFailed
+			step.Message = msg.err.Error()
+			result.Steps = append(result.Steps, step)
+			log.Debug("start: step failed", "idx", m.current, "error", msg.err)
+
+			if m.continueAfterFailure() {
+				m.recordFailure(msg.err)
+				m.emitProgress(step, false)
+
+				continue
+			}
+
+			m.emitProgress(step, true)
+
+			return msg.err
+		case stepCompleteMsg:
+			step.Message = msg.message
+
+			done, err := m.handleStepCompleteHeadless(msg, &step, result)
+
+			result.Steps = append(result.Steps, step)
+			log.Debug("start: step complete", "idx", m.current, "status", step.Status)
+			m.emitProgress(step, err != nil || done || m.current == len(m.steps)-1)
+
+			if err != nil {
+				return err
+			}
+
+			if done || m.current == len(m.steps)-1 {
+				if step.Status == stepStatusCompleted {
+					m.finishSession()
+				}
+
+				return m.failedStepsError()
+			}
+
+			m.recordStep()
+		}
+	}
+
+	return m.failedStepsError()
+}
+
+func (m *Model) handleStepCompleteHeadless(msg stepCompleteMsg, step *stepResult, result *startResult) (bool, error) {
+	if msg.updateVersion != "" {
+		m.updateVersion = msg.updateVersion
+	}
+
+	if msg.selfUpdate && m.opts.DryRun {
+		plan := m.planSelfUpdate()
+		result.Update = &plan
+		step.Status = stepStatusSkipped
+
+		return msg.executeScript && !m.selectionContinues(), nil
+	}
+
+	if msg.selfUpdate && (msg.executeScript || m.opts.AnswerYes) {
+		// A pre-selected or confirmed self-update runs without asking
+		update := exec.Command("dr", "self", "update")
+		update.Stdout = os.Stderr
+		update.Stderr = os.Stderr
+
+		if err := update.Run(); err != nil {
+			step.Status = stepStatusFailed
+			step.Message = err.Error()
+
+			return true, err
+		}
+
+		// The selected steps after the update go on with this run
+		return !m.selectionContinues(), nil
+	}
+
+	if msg.selfUpdate {
+		// Updating requires an interactive prompt; report it and move on
+		result.UpdateAvailable = true
+		step.Status = stepStatusSkipped
+
+		return false, nil
+	}
+
+	if msg.selectTemplate {
+		return m.setupTemplateHeadless(msg.catalog, step, result)
+	}
+
+	if msg.needTemplateSetup {
+		result.NeedsTemplateSetup = true
+
+		if m.opts.Action == ActionTemplateSetup || m.currentStep().selected == selectTemplate {
+			return true, errors.New("Template setup is interactive and cannot run with structured output.")
+		}
+
+		return true, errors.New("Not in a DataRobot repository. Run 'dr templates setup' first.")
+	}
+
+	if msg.quickstartScriptPath == "" {
+		return msg.done, nil
+	}
+
+	m.quickstartScriptPath = msg.quickstartScriptPath
+	result.Script = msg.quickstartScriptPath
+
+	if m.opts.DryRun {
+		plan, err := m.planQuickstart()
+		if err != nil {
+			step.Status = stepStatusFailed
+			step.Message = err.Error()
+
+			return true, err
+		}
+
+		result.Plan = &plan
+		step.Status = stepStatusSkipped
+
+		return true, nil
+	}
+
+	if msg.waiting && !m.opts.AnswerYes {
+		step.Status = stepStatusSkipped
+		step.Message = "Confirmation required to run " + msg.quickstartScriptPath + "; rerun with --assume-yes."
+
+		return true, nil
+	}
+
+	transcript := log.OutputWriter("script")
+	defer transcript.Close()
+
+	script, err := m.quickstartCommand()
+	if err != nil {
+		step.Status = stepStatusFailed
+		step.Message = err.Error()
+
+		return true, err
+	}
+
+	script.Stdin = os.Stdin
+
+	// Without the TUI, the output is shown as is, so mask secrets here
+	stderr := log.RedactLines(os.Stderr)
+	defer stderr.Close()
+
+	script.Stdout = io.MultiWriter(stderr, transcript)
+	script.Stderr = script.Stdout
+
+	if err := scriptFailure(runScriptWithTimeout(m.runContext(), script, m.opts.TimeoutPerStep, interruptGrace)); err != nil {
+		step.Status = stepStatusFailed
+		step.Message = err.Error()
+
+		var scriptErr *errs.ScriptError
+		if errors.As(err, &scriptErr) {
+			result.ExitCode = scriptErr.ExitCode()
+		}
+
+		return true, err
+	}
+
+	if m.repoRoot != "" {
+		_ = state.UpdateAfterSuccessfulRun(m.repoRoot)
+	}
+
+	return true, nil
+}
+
+// errRunInterrupted reports a script stopped by SIGINT, SIGTERM, or
+// Ctrl-C, which exits with exitCodeInterrupted like an interrupted TUI
+var errRunInterrupted error = interruptedError{}
+
+type interruptedError struct{}
+
+func (interruptedError) Error() string {
+	return "Quickstart interrupted."
+}
+
+func (interruptedError) ExitCode() int {
+	return exitCodeInterrupted
+}
+
+// runContext is the context of the run, which is cancelled when the CLI is
+// interrupted or terminated
+func (m *Model) runContext() context.Context {
+	if m.runCtx == nil {
+		return context.Background()
+	}
+
+	return m.runCtx
+}
+
+// stepResultHeadless runs the current step, or waits for it if it is
+// already running. Entering a group of independent steps starts the rest
+// of the group in the background, as the TUI does.
+func (m *Model) stepResultHeadless(running map[int]<-chan tea.Msg) tea.Msg {
+	if result, ok := running[m.current]; ok {
+		return <-result
+	}
+
+	log.Info("start: execute step ", "idx", m.current, "desc", m.currentStep().description)
+
+	for i := m.current + 1; i <= m.independentGroupEnd(); i++ {
+		log.Info("start: execute step ", "idx", i, "desc", m.steps[i].description)
+
+		result := make(chan tea.Msg, 1)
+		snapshot := *m
+		index := i
+
+		go func() { result <- snapshot.runStep(index) }()
+
+		running[i] = result
+	}
+
+	return m.runStep(m.current)
+}
+
+// setupTemplateHeadless clones the template named with --template. Without
+// the TUI there is no list to choose one from.
+func (m *Model) setupTemplateHeadless(catalog *prefetchedCatalog, step *stepResult, result *startResult) (bool, error) {
+	if m.opts.Template == "" {
+		result.NeedsTemplateSetup = true
+
+		return true, errors.New("Not in a DataRobot repository. Pass --template to clone one, or run 'dr templates setup' first.")
+	}
+
+	if m.opts.DryRun {
+		step.Status = stepStatusSkipped
+		step.Message = strings.TrimSpace(m.planTemplate())
+
+		return !m.selectionContinues(), nil
+	}
+
+	setup, message, err := m.cloneNamedTemplate(catalog)
+	if err != nil {
+		step.Status = stepStatusFailed
+		step.Message = err.Error()
+
+		return true, err
+	}
+
+	m.repoRoot = setup.dir
+	result.TemplateDir = setup.dir
+	step.Message = strings.TrimSpace(message)
+
+	if setup.outcome == templateOutdated {
+		step.Message += " Rerun with --upgrade to install it."
+	}
+
+	return false, nil
+}
+
+// cloneNamedTemplate sets up the template named with --template and enters
+// its directory. Without a prompt, an older version already there is kept
+// unless --upgrade is set.
+func (m *Model) cloneNamedTemplate(catalog *prefetchedCatalog) (templateSetup, string, error) {
+	templates, err := templatesFrom(catalog)
+	if err != nil {
+		return templateSetup{}, "", err
+	}
+
+	template, err := drapi.FindTemplate(templates, m.opts.Template)
+	if err != nil {
+		return templateSetup{}, "", err
+	}
+
+	setup, err := cloneTemplate(template, m.opts)
+	if err != nil {
+		return templateSetup{}, "", err
+	}
+
+	if err := os.Chdir(setup.dir); err != nil {
+		return templateSetup{}, "", fmt.Errorf("Failed to enter %s: %w", setup.dir, err)
+	}
+
+	return setup, setup.message(template), nil
+}
+
+// allStepsCompleted reports whether the run reached and completed its last
+// step, rather than stopping early at a prompt or a skipped script
+func allStepsCompleted(result startResult) bool {
+	if len(result.Steps) == 0 {
+		return false
+	}
+
+	return result.Steps[len(result.Steps)-1].Status == stepStatusCompleted
+}
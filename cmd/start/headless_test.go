@@ -0,0 +1,48 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/datarobot/cli/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeadlessPropagatesScriptExitCode(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell script")
+	}
+
+	script := filepath.Join(t.TempDir(), "quickstart.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\nexit 42\n"), 0o755))
+
+	m := NewStartModel(Options{Action: ActionExecuteScript, QuickstartScript: script, AnswerYes: true})
+	result := startResult{}
+
+	err := m.runStepsHeadless(&result)
+	require.Error(t, err)
+
+	assert.Equal(t, 42, result.ExitCode)
+	assert.Equal(t, 42, failureExitCode(err, true))
+	assert.Equal(t, 42, errs.ExitCode(err))
+	require.Len(t, result.Steps, 1)
+	assert.Equal(t, stepStatusFailed, result.Steps[0].Status)
+	assert.Equal(t, "Quickstart script exited with code 42.", result.Steps[0].Message)
+}
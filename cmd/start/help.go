@@ -0,0 +1,156 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/datarobot/cli/tui"
+)
+
+type keyMap struct {
+	Up      key.Binding
+	Down    key.Binding
+	Select  key.Binding
+	Toggle  key.Binding
+	Confirm key.Binding
+	Cancel  key.Binding
+	Quit    key.Binding
+	Help    key.Binding
+}
+
+func (k keyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Help, k.Quit}
+}
+
+func (k keyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Select, k.Toggle},
+		{k.Confirm, k.Cancel},
+		{k.Quit, k.Help},
+	}
+}
+
+func newKeyMap() keyMap {
+	return keyMap{
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "move up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "move down"),
+		),
+		Select: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "select"),
+		),
+		Toggle: key.NewBinding(
+			key.WithKeys(" ", "x"),
+			key.WithHelp("space", "tick a step"),
+		),
+		Confirm: key.NewBinding(
+			key.WithKeys("y"),
+			key.WithHelp("y", "confirm"),
+		),
+		Cancel: key.NewBinding(
+			key.WithKeys("n"),
+			key.WithHelp("n", "cancel"),
+		),
+		Quit: key.NewBinding(
+			key.WithKeys("q", "esc", "ctrl+c"),
+			key.WithHelp("q/ctrl+c", "quit"),
+		),
+		Help: key.NewBinding(
+			key.WithKeys("?"),
+			key.WithHelp("?", "toggle help"),
+		),
+	}
+}
+
+// helpKeys returns the key bindings with only those that do something in
+// the current state enabled, so the overlay lists just those
+func (m Model) helpKeys() keyMap {
+	keys := m.keys
+
+	choosing := m.choosingSteps || m.selectingTemplate || (m.selectingEndpoint && !m.enteringEndpoint)
+	prompting := m.waitingToExecute || m.offeringTemplate || m.pendingUpgrade != nil
+
+	keys.Up.SetEnabled(choosing)
+	keys.Down.SetEnabled(choosing)
+	keys.Select.SetEnabled(choosing || m.enteringEndpoint || prompting)
+	keys.Toggle.SetEnabled(m.choosingSteps)
+	keys.Confirm.SetEnabled(prompting)
+	keys.Cancel.SetEnabled(prompting)
+	return keys
+}
+
+// handleHelpKey opens and closes the help overlay. While it is open, other
+// keys are ignored so that nothing happens behind it.
+func (m Model) handleHelpKey(msg tea.KeyMsg) (Model, bool) {
+	if m.showHelp {
+		switch msg.String() {
+		case "?", "q", "esc":
+			m.showHelp = false
+		}
+
+		return m, true
+	}
+
+	// A custom endpoint URL may contain a question mark
+	if m.enteringEndpoint || !key.Matches(msg, m.keys.Help) {
+		return m, false
+	}
+
+	m.showHelp = true
+
+	return m, true
+}
+
+// helpOverlay draws the key bindings in a box over the middle of base,
+// replacing the lines under it. Whole lines are replaced so that styled
+// text in base is never cut mid-sequence.
+func (m Model) helpOverlay(base string) string {
+	width := m.outputWidth()
+
+	h := m.help
+	h.ShowAll = true
+	h.Width = max(0, width-tui.BoxStyle.GetHorizontalFrameSize())
+
+	box := tui.BoxStyle.Render(strings.Join([]string{
+		tui.InfoStyle.Render("Keyboard shortcuts"),
+		"",
+		h.View(m.helpKeys()),
+		"",
+		tui.DimStyle.Render("Press ? or esc to close"),
+	}, "\n"))
+
+	lines := strings.Split(base, "\n")
+	boxLines := strings.Split(box, "\n")
+	top := max(0, (len(lines)-len(boxLines))/2)
+
+	for len(lines) < top+len(boxLines) {
+		lines = append(lines, "")
+	}
+
+	for i, line := range boxLines {
+		lines[top+i] = lipgloss.PlaceHorizontal(width, lipgloss.Center, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
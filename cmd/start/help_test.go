@@ -0,0 +1,135 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pressKey(t *testing.T, m Model, k string) Model {
+	t.Helper()
+
+	msg := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+	if k == "esc" {
+		msg = tea.KeyMsg{Type: tea.KeyEsc}
+	}
+
+	updated, _ := m.Update(msg)
+
+	next, ok := updated.(Model)
+	require.True(t, ok)
+
+	return next
+}
+
+func TestHelpOverlayToggle(t *testing.T) {
+	m := NewStartModel(Options{Action: actionOnboarding})
+	m.selectingEndpoint = true
+
+	m = pressKey(t, m, "?")
+	assert.True(t, m.showHelp)
+	assert.Contains(t, m.View(), "Keyboard shortcuts")
+
+	// Keys don't reach the endpoint list behind the overlay
+	m = pressKey(t, m, "j")
+	assert.Equal(t, 0, m.endpointCursor)
+	assert.False(t, m.quitting)
+
+	m = pressKey(t, m, "q")
+	assert.False(t, m.showHelp)
+	assert.False(t, m.quitting)
+	assert.NotContains(t, m.View(), "Keyboard shortcuts")
+
+	m = pressKey(t, m, "j")
+	assert.Equal(t, 1, m.endpointCursor)
+
+	m = pressKey(t, m, "?")
+	m = pressKey(t, m, "esc")
+	assert.False(t, m.showHelp)
+	assert.Equal(t, 1, m.endpointCursor)
+}
+
+func TestHelpKeyTypedIntoEndpointURL(t *testing.T) {
+	m := NewStartModel(Options{Action: actionOnboarding})
+	m.selectingEndpoint = true
+	m.endpointCursor = len(config.Regions)
+
+	updated, _ := m.handleEndpointKey(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	require.True(t, m.enteringEndpoint)
+
+	m = pressKey(t, m, "?")
+	assert.False(t, m.showHelp)
+	assert.Equal(t, "?", m.endpointInput.Value())
+}
+
+func TestHelpKeysFollowState(t *testing.T) {
+	m := NewStartModel(Options{})
+
+	keys := m.helpKeys()
+	assert.False(t, keys.Up.Enabled())
+	assert.False(t, keys.Confirm.Enabled())
+	assert.True(t, keys.Quit.Enabled())
+
+	m.selectingTemplate = true
+	keys = m.helpKeys()
+	assert.True(t, keys.Up.Enabled())
+	assert.True(t, keys.Select.Enabled())
+
+	m.selectingTemplate = false
+	m.waitingToExecute = true
+	keys = m.helpKeys()
+	assert.True(t, keys.Confirm.Enabled())
+	assert.False(t, keys.Up.Enabled())
+}
+
+func TestHelpOverlayFollowsResize(t *testing.T) {
+	m := NewStartModel(Options{})
+	m.current = 2
+
+	updated, _ := m.Update(tea.WindowSizeMsg{Width: 60, Height: 20})
+	m = updated.(Model)
+	m = pressKey(t, m, "?")
+
+	base := m.view()
+	overlay := m.View()
+
+	assert.Contains(t, overlay, "Keyboard shortcuts")
+	assert.Equal(t, strings.Count(base, "\n"), strings.Count(overlay, "\n"))
+
+	for line := range strings.SplitSeq(overlay, "\n") {
+		if strings.Contains(line, "Keyboard shortcuts") {
+			assert.Equal(t, 60, lipgloss.Width(line))
+		}
+	}
+
+	updated, _ = m.Update(tea.WindowSizeMsg{Width: 100, Height: 20})
+	m = updated.(Model)
+	assert.True(t, m.showHelp)
+	assert.Equal(t, 2, m.current)
+
+	for line := range strings.SplitSeq(m.View(), "\n") {
+		if strings.Contains(line, "Keyboard shortcuts") {
+			assert.Equal(t, 100, lipgloss.Width(line))
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"bufio"
+	"os"
+	"slices"
+	"strings"
+)
+
+// shellKey names the shell that runs .sh scripts on Windows, such as Git
+// Bash's bash.exe or "wsl bash"
+const shellKey = "start.shell"
+
+// scriptCommand returns the command line that runs the script at path with
+// args, and the interpreter it runs with, if any. An override given with
+// --interpreter is always used; otherwise a script the OS can execute runs
+// directly, and any other is run by the interpreter the platform finds for
+// it.
+func scriptCommand(path, override string, args []string) ([]string, string, error) {
+	if fields := strings.Fields(override); len(fields) > 0 {
+		return slices.Concat(fields, []string{path}, args), override, nil
+	}
+
+	return platformScriptCommand(path, args)
+}
+
+// canRun reports whether a detected script can be run without an
+// --interpreter override: it is executable, or the platform knows an
+// interpreter for it
+func canRun(path string, info os.FileInfo) bool {
+	return isExecutable(path, info) || hasInterpreter(path)
+}
+
+// shebangInterpreter returns the interpreter named by the script's shebang
+// line, if it has one
+func shebangInterpreter(path string) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+
+	defer file.Close()
+
+	line, _ := bufio.NewReader(file).ReadString('\n')
+	if !strings.HasPrefix(line, "#!") {
+		return ""
+	}
+
+	return strings.TrimSpace(strings.TrimPrefix(line, "#!"))
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+
+package start
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// extensionInterpreters run scripts that are not executable and have no
+// shebang line, by file extension
+var extensionInterpreters = map[string]string{
+	".py":   "python3",
+	".sh":   "sh",
+	".bash": "bash",
+	".ps1":  "pwsh",
+}
+
+// platformScriptCommand runs an executable script directly, leaving its
+// shebang line to the kernel. Any other script runs with the interpreter
+// its shebang line or extension names.
+func platformScriptCommand(path string, args []string) ([]string, string, error) {
+	shebang := shebangInterpreter(path)
+
+	if info, err := os.Stat(path); err == nil && isExecutable(path, info) {
+		return append([]string{path}, args...), shebang, nil
+	}
+
+	interpreter := shebang
+	if interpreter == "" {
+		interpreter = extensionInterpreters[strings.ToLower(filepath.Ext(path))]
+	}
+
+	if interpreter == "" {
+		return nil, "", fmt.Errorf("Cannot run quickstart script %s: it is not executable and has no shebang line or known extension. Make it executable, or choose an interpreter with --interpreter.", path)
+	}
+
+	return slices.Concat(strings.Fields(interpreter), []string{path}, args), interpreter, nil
+}
+
+func hasInterpreter(path string) bool {
+	return shebangInterpreter(path) != "" || extensionInterpreters[strings.ToLower(filepath.Ext(path))] != ""
+}
+
+// isExecutable reports whether any execute permission bit is set
+func isExecutable(_ string, info os.FileInfo) bool {
+	return info.Mode()&0o111 != 0
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+
+package start
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datarobot/cli/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlatformScriptCommand(t *testing.T) {
+	executable := writeScript(t, "quickstart", "#!/bin/sh\n", 0o755)
+	command, interpreter, err := scriptCommand(executable, "", []string{"--flag"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{executable, "--flag"}, command, "executables run directly")
+	assert.Equal(t, "/bin/sh", interpreter)
+
+	shebang := writeScript(t, "quickstart", "#!/usr/bin/env bash\n", 0o644)
+	command, interpreter, err = scriptCommand(shebang, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/usr/bin/env", "bash", shebang}, command)
+	assert.Equal(t, "/usr/bin/env bash", interpreter)
+
+	python := writeScript(t, "quickstart.py", "print('hi')\n", 0o644)
+	command, _, err = scriptCommand(python, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"python3", python}, command)
+
+	notes := writeScript(t, "quickstart.txt", "hi\n", 0o644)
+	_, _, err = scriptCommand(notes, "", nil)
+	require.ErrorContains(t, err, "not executable and has no shebang line or known extension")
+}
+
+func TestFindQuickstartScriptWithInterpreter(t *testing.T) {
+	t.Chdir(t.TempDir())
+	require.NoError(t, os.MkdirAll(repo.QuickstartScriptPath, 0o755))
+
+	notes := filepath.Join(repo.QuickstartScriptPath, "quickstart.md")
+	require.NoError(t, os.WriteFile(notes, []byte("# Notes\n"), 0o644))
+
+	found, err := findQuickstartScript()
+	require.NoError(t, err)
+	assert.Empty(t, found, "files without an interpreter are not scripts")
+
+	python := filepath.Join(repo.QuickstartScriptPath, "quickstart.py")
+	require.NoError(t, os.WriteFile(python, []byte("print('hi')\n"), 0o644))
+
+	found, err = findQuickstartScript()
+	require.NoError(t, err)
+	assert.Equal(t, python, found)
+}
@@ -0,0 +1,48 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeScript(t *testing.T, name, body string, mode os.FileMode) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(body), mode))
+
+	return path
+}
+
+func TestScriptCommandWithInterpreterOverride(t *testing.T) {
+	python := writeScript(t, "quickstart.py", "print('hi')\n", 0o644)
+
+	command, interpreter, err := scriptCommand(python, "uv run", []string{"--flag"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"uv", "run", python, "--flag"}, command)
+	assert.Equal(t, "uv run", interpreter)
+
+	notes := writeScript(t, "quickstart.txt", "hi\n", 0o644)
+
+	command, _, err = scriptCommand(notes, "cat", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"cat", notes}, command, "an override runs any file")
+}
@@ -0,0 +1,95 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// extensionInterpreters run scripts Windows cannot execute itself. Windows
+// ignores shebang lines, and has no execute bit, so only the extension
+// counts. Shell scripts go to the configured shell instead.
+var extensionInterpreters = map[string][]string{
+	".py":  {"python"},
+	".ps1": {"powershell", "-NoProfile", "-ExecutionPolicy", "Bypass", "-File"},
+}
+
+// platformScriptCommand runs executables and batch files directly, and
+// other scripts with the interpreter for their extension
+func platformScriptCommand(path string, args []string) ([]string, string, error) {
+	ext := strings.ToLower(filepath.Ext(path))
+
+	switch ext {
+	case ".exe", ".com":
+		return append([]string{path}, args...), "", nil
+	case ".bat", ".cmd":
+		return append([]string{path}, args...), "cmd.exe", nil
+	case ".sh", ".bash":
+		shell, err := windowsShell(path)
+		if err != nil {
+			return nil, "", err
+		}
+
+		// Git Bash and WSL both accept forward slashes
+		return slices.Concat(shell, []string{filepath.ToSlash(path)}, args), strings.Join(shell, " "), nil
+	}
+
+	if interpreter, ok := extensionInterpreters[ext]; ok {
+		return slices.Concat(interpreter, []string{path}, args), interpreter[0], nil
+	}
+
+	return nil, "", fmt.Errorf("Cannot run quickstart script %s: Windows has no interpreter for %q files. Choose one with --interpreter.", path, ext)
+}
+
+// windowsShell returns the command for .sh scripts: the configured shell,
+// split into words so it can be "wsl bash", or bash if it is on the PATH,
+// as it is with Git for Windows. A path found on the PATH is kept whole,
+// since it is often under C:\Program Files.
+func windowsShell(path string) ([]string, error) {
+	if shell := viper.GetString(shellKey); shell != "" {
+		return strings.Fields(shell), nil
+	}
+
+	if bash, err := exec.LookPath("bash"); err == nil {
+		return []string{bash}, nil
+	}
+
+	return nil, fmt.Errorf("Cannot run quickstart script %s: no shell found. Install Git Bash, set %s in the config file (for example, to \"wsl bash\"), or choose an interpreter with --interpreter.", path, shellKey)
+}
+
+func hasInterpreter(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	_, ok := extensionInterpreters[ext]
+
+	return ok || ext == ".sh" || ext == ".bash"
+}
+
+// isExecutable reports whether Windows runs the file itself, judging by
+// its extension
+func isExecutable(path string, _ os.FileInfo) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".exe", ".com", ".bat", ".cmd":
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,120 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/datarobot/cli/internal/repo"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlatformScriptCommand(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	command, interpreter, err := scriptCommand(`bin\quickstart.ps1`, "", []string{"-Name", "app"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"powershell", "-NoProfile", "-ExecutionPolicy", "Bypass", "-File", `bin\quickstart.ps1`, "-Name", "app"}, command)
+	assert.Equal(t, "powershell", interpreter)
+
+	command, interpreter, err = scriptCommand(`bin\quickstart.cmd`, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{`bin\quickstart.cmd`}, command)
+	assert.Equal(t, "cmd.exe", interpreter)
+
+	viper.Set(shellKey, "wsl bash")
+
+	command, interpreter, err = scriptCommand(`bin\quickstart.sh`, "", []string{"--flag"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"wsl", "bash", "bin/quickstart.sh", "--flag"}, command)
+	assert.Equal(t, "wsl bash", interpreter)
+
+	_, _, err = scriptCommand(`bin\quickstart.rb`, "", nil)
+	require.ErrorContains(t, err, `no interpreter for ".rb" files`)
+}
+
+func TestWindowsShellPathWithSpaces(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	dir := filepath.Join(t.TempDir(), "Program Files", "Git", "bin")
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+
+	bash := filepath.Join(dir, "bash.exe")
+	require.NoError(t, os.WriteFile(bash, nil, 0o755))
+
+	t.Setenv("PATH", dir)
+
+	command, interpreter, err := scriptCommand(`bin\quickstart.sh`, "", []string{"--flag"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{bash, "bin/quickstart.sh", "--flag"}, command)
+	assert.Equal(t, bash, interpreter)
+}
+
+func TestWindowsShellWithoutBash(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	t.Setenv("PATH", t.TempDir())
+
+	_, err := windowsShell("quickstart.sh")
+	require.ErrorContains(t, err, "no shell found")
+	assert.ErrorContains(t, err, shellKey)
+}
+
+func TestFindQuickstartScriptByExtension(t *testing.T) {
+	t.Chdir(t.TempDir())
+	require.NoError(t, os.MkdirAll(repo.QuickstartScriptPath, 0o755))
+
+	notes := filepath.Join(repo.QuickstartScriptPath, "quickstart.md")
+	require.NoError(t, os.WriteFile(notes, []byte("# Notes\n"), 0o644))
+
+	found, err := findQuickstartScript()
+	require.NoError(t, err)
+	assert.Empty(t, found, "files without an interpreter are not scripts")
+
+	script := filepath.Join(repo.QuickstartScriptPath, "quickstart.ps1")
+	require.NoError(t, os.WriteFile(script, []byte("Write-Output hi\n"), 0o644))
+
+	found, err = findQuickstartScript()
+	require.NoError(t, err)
+	assert.Equal(t, script, found)
+}
+
+func TestBatchScriptOutputAndExitCode(t *testing.T) {
+	path := writeScript(t, "quickstart.cmd", "@echo off\r\necho first\r\necho second\r\nexit /b 3\r\n", 0o644)
+
+	command, _, err := scriptCommand(path, "", nil)
+	require.NoError(t, err)
+
+	var out bytes.Buffer
+
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Stdout = &out
+	cmd.Stderr = &out
+
+	err = runScriptWithTimeout(context.Background(), cmd, 0, interruptGrace)
+
+	assert.Equal(t, "first\r\nsecond\r\n", out.String())
+	assert.Equal(t, 3, cmd.ProcessState.ExitCode())
+	assert.ErrorContains(t, scriptFailure(err), "exited with code 3")
+}
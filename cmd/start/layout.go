@@ -0,0 +1,51 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	minTemplateListLines = 3 // Fewest templates listed, however short the terminal
+	chromeHeight         = 8 // Lines around the step list: title, message, footer, and spacing
+)
+
+// handleResize records the new terminal size and lays the view out again
+func (m Model) handleResize(msg tea.WindowSizeMsg) Model {
+	m.width = max(0, msg.Width)
+	m.height = max(0, msg.Height)
+	m.help.Width = m.width
+
+	return m
+}
+
+// outputWidth is the width the view is laid out for
+func (m Model) outputWidth() int {
+	if m.width == 0 {
+		return defaultOutputWidth
+	}
+
+	return m.width
+}
+
+// templateListLines is how many templates the selection list shows at once
+func (m Model) templateListLines() int {
+	if m.height == 0 {
+		return templateListHeight
+	}
+
+	return max(minTemplateListLines, min(templateListHeight, m.height-chromeHeight-len(m.steps)))
+}
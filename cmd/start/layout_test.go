@@ -0,0 +1,71 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func resize(t *testing.T, m Model, width, height int) Model {
+	t.Helper()
+
+	next, cmd := m.Update(tea.WindowSizeMsg{Width: width, Height: height})
+	assert.Nil(t, cmd)
+
+	resized, ok := next.(Model)
+	require.True(t, ok)
+
+	return resized
+}
+
+func TestResizeSequence(t *testing.T) {
+	m := NewStartModel(Options{})
+
+	sizes := []struct{ width, height int }{
+		{120, 40}, {40, 10}, {1, 1}, {0, 0}, {200, 60}, {80, 24},
+	}
+
+	for _, size := range sizes {
+		m = resize(t, m, size.width, size.height)
+
+		assert.NotPanics(t, func() { _ = m.View() })
+		assert.Equal(t, m.width, m.help.Width)
+	}
+
+	assert.Equal(t, 80, m.outputWidth())
+}
+
+func TestResizeShrinksTemplateList(t *testing.T) {
+	m := NewStartModel(Options{})
+	m.selectingTemplate = true
+
+	for range 20 {
+		m.templates = append(m.templates, drapi.Template{Name: "template"})
+	}
+
+	assert.Equal(t, templateListHeight, strings.Count(m.templateListView(), "\n"))
+
+	m = resize(t, m, 80, 12)
+	assert.Equal(t, minTemplateListLines, strings.Count(m.templateListView(), "\n"))
+
+	m = resize(t, m, 80, 100)
+	assert.Equal(t, templateListHeight, strings.Count(m.templateListView(), "\n"))
+}
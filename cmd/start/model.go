@@ -15,21 +15,27 @@
 package start
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
-	"runtime"
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/datarobot/cli/internal/drapi"
 	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/misc/confirm"
 	"github.com/datarobot/cli/internal/repo"
 	"github.com/datarobot/cli/internal/state"
 	"github.com/datarobot/cli/internal/tools"
+	"github.com/datarobot/cli/internal/update"
 	"github.com/datarobot/cli/internal/version"
 	"github.com/datarobot/cli/tui"
 )
@@ -40,6 +46,13 @@ type step struct {
 	description string
 	// fn is the function that performs the step's Update action
 	fn func(*Model) tea.Msg
+	// independent steps only read state, so consecutive ones run at the
+	// same time; their results are still applied in order
+	independent bool
+	// preview explains what the step would do, for --list-steps
+	preview func(*Model) (stepStatus, string)
+	// selected is the --select entry the step belongs to, if any
+	selected string
 }
 
 type Model struct {
@@ -55,21 +68,68 @@ type Model struct {
 	selfUpdate           bool   // Whether to ask for self update
 	waitingToExecute     bool   // Whether to wait for user input before proceeding
 	needTemplateSetup    bool   // Whether we need to run template setup after quitting
+	quiet                bool   // Whether --quiet suppresses progress output
+	updateVersion        string // Version a self update would install, if known
+	dryRunReport         string // What would have been executed in --dry-run mode
 	repoRoot             string
+	width                int  // Terminal width, once known
+	height               int  // Terminal height, once known
+	exitCode             int  // Exit code of the quickstart script
+	interrupted          bool // Whether the user interrupted the quickstart
+	templates            []drapi.Template
+	templateCursor       int              // Template highlighted in the selection list
+	selectingTemplate    bool             // Whether the user is choosing a template to clone
+	progress             *json.Encoder    // Where progress events go with --progress-format json
+	session              session          // Steps completed so far, saved for --resume
+	resumed              int              // Steps skipped because a previous run completed them
+	pending              map[int]tea.Msg  // Results of independent steps that finished ahead of their turn
+	headless             bool             // Whether steps run without the TUI
+	selectingEndpoint    bool             // Whether the user is choosing a DataRobot environment
+	endpointCursor       int              // Environment highlighted in the endpoint list
+	enteringEndpoint     bool             // Whether the user is typing a custom endpoint URL
+	endpointInput        textinput.Model  // The custom endpoint URL
+	offeringTemplate     bool             // Whether the user is asked to set up a template
+	stepCtx              context.Context  // Context of the running step, set by runStep
+	runCtx               context.Context  // Context of the run, cancelled by SIGINT or SIGTERM
+	failedSteps          map[int]error    // Steps that failed with --continue-on-error
+	pendingUpgrade       *templateUpgrade // Template upgrade the user is asked about
+	choosingSteps        bool             // Whether the user is ticking the steps to run
+	stepMenuCursor       int              // Step highlighted in the --action select menu
+	stepMenuTicked       []string         // Steps ticked in the --action select menu
+	help                 help.Model
+	keys                 keyMap
+	showHelp             bool // Whether the key binding overlay is open
 }
 
 type stepCompleteMsg struct {
-	message              string // Optional message to display to the user
-	waiting              bool   // Whether to wait for user input before proceeding
-	done                 bool   // Whether the quickstart process is complete
-	hideMenu             bool   // Do not show menu
-	quickstartScriptPath string // Path to quickstart script found (if any)
-	selfUpdate           bool   // Whether to ask for self update
-	executeScript        bool   // Whether to execute the script immediately
-	needTemplateSetup    bool   // Whether we need to run template setup
+	message              string             // Optional message to display to the user
+	waiting              bool               // Whether to wait for user input before proceeding
+	done                 bool               // Whether the quickstart process is complete
+	hideMenu             bool               // Do not show menu
+	quickstartScriptPath string             // Path to quickstart script found (if any)
+	selfUpdate           bool               // Whether to ask for self update
+	executeScript        bool               // Whether to execute the script immediately
+	needTemplateSetup    bool               // Whether we need to run template setup
+	updateVersion        string             // Version a self update would install, if known
+	scriptExited         bool               // Whether the quickstart script has exited
+	exitCode             int                // Exit code of the quickstart script
+	scriptErr            error              // Error the quickstart script exited with, if any
+	selectTemplate       bool               // Whether to choose and clone a template
+	templateDir          string             // Directory a template was cloned into
+	catalog              *prefetchedCatalog // Templates fetched along with the step, if any
+	selectEndpoint       bool               // Whether to choose a DataRobot environment
+	login                bool               // Whether to run the browser login
+	offerTemplateSetup   bool               // Whether to ask about setting up a template
+	upgrade              *templateUpgrade   // A newer template version to offer, if any
+	updated              bool               // Whether a self update has finished
 }
 
-type startScriptCompleteMsg struct{ err error }
+// stepResultMsg carries the result of the step at index, which may finish
+// before the steps ahead of it when it is independent
+type stepResultMsg struct {
+	index int
+	msg   tea.Msg
+}
 
 type stepErrorMsg struct {
 	err error // Error encountered during step execution
@@ -79,29 +139,60 @@ type stepErrorMsg struct {
 const (
 	errScriptSearchFailed = "Failed to search for quickstart script: %w"
 	preExecutionDelay     = 200 * time.Millisecond // Brief delay before executing scripts to avoid glitchy screen resets
+	updateCheckTimeout    = 2 * time.Second        // Longest the update check may delay the quickstart
+	defaultOutputWidth    = 80                     // Width of the view until the terminal size is known
 )
 
 var (
 	checkMark = lipgloss.NewStyle().Foreground(lipgloss.Color("42")).SetString("✓")
+	crossMark = lipgloss.NewStyle().Foreground(tui.DrRed).SetString("✗")
 	arrow     = lipgloss.NewStyle().Foreground(tui.DrPurple).SetString("→")
+	skipMark  = lipgloss.NewStyle().Foreground(tui.DrPurple).SetString("↷")
+
+	dryRunBanner = lipgloss.NewStyle().Bold(true).Foreground(tui.DrYellow).Reverse(true).Padding(0, 1)
 )
 
 func NewStartModel(opts Options) Model {
 	repoRoot, _ := repo.FindRepoRoot()
 
-	return Model{
-		steps: []step{
-			{description: "Starting application quickstart process...", fn: startQuickstart},
-			{description: "Checking DataRobot CLI version...", fn: checkSelfVersion},
-			{description: "Checking template prerequisites...", fn: checkPrerequisites},
-			// TODO Implement validateEnvironment
-			// {description: "Validating environment...", fn: validateEnvironment},
-			{description: "Checking repository setup...", fn: checkRepository},
-			{description: "Finding and executing start command...", fn: findAndExecuteStart},
-		},
-		opts:     opts,
-		repoRoot: repoRoot,
+	m := Model{
+		steps:                stepsForAction(opts.Action),
+		opts:                 opts,
+		quickstartScriptPath: opts.QuickstartScript,
+		quiet:                log.IsQuiet(),
+		repoRoot:             repoRoot,
+		session:              newSession(sessionAction(opts)),
+		help:                 help.New(),
+		keys:                 newKeyMap(),
+	}
+
+	switch {
+	case len(opts.Select) > 0:
+		m.steps = stepsForSelection(opts.Select)
+	case opts.Action == ActionSelect:
+		m.choosingSteps = true
+		m.stepCompleteMessage = "Choose the steps to run:\n"
 	}
+
+	switch {
+	case opts.Restart && !opts.DryRun:
+		m.session.remove()
+	case opts.Resume:
+		saved, ok := loadSession(sessionAction(opts))
+		if !ok {
+			m.stepCompleteMessage = "No interrupted session to resume; starting from the beginning.\n"
+
+			break
+		}
+
+		m.session = saved
+		m.resumed = saved.resumeIndex(m.steps)
+		m.current = m.resumed
+
+		log.Info("start: resuming session", "skipped", m.resumed, "updated_at", saved.UpdatedAt)
+	}
+
+	return m
 }
 
 func (m Model) Init() tea.Cmd {
@@ -115,12 +206,44 @@ func (m Model) executeCurrentStep() tea.Cmd {
 		return nil
 	}
 
-	currentStep := m.currentStep()
-	log.Info("start: execute step ", "idx", m.current, "desc", currentStep.description)
+	// Entering a group of independent steps starts all of them
+	cmds := make([]tea.Cmd, 0, m.independentGroupEnd()-m.current+1)
+
+	for i := m.current; i <= m.independentGroupEnd(); i++ {
+		log.Info("start: execute step ", "idx", i, "desc", m.steps[i].description)
+
+		index := i
+		snapshot := m
+
+		cmds = append(cmds, func() tea.Msg {
+			return stepResultMsg{index: index, msg: snapshot.runStep(index)}
+		})
+	}
+
+	return tea.Batch(cmds...)
+}
+
+// independentGroupEnd returns the index of the last step that starts along
+// with the current one: the end of its run of independent steps, or the
+// current step itself
+func (m Model) independentGroupEnd() int {
+	last := m.current
+
+	if !m.steps[last].independent {
+		return last
+	}
 
-	return func() tea.Msg {
-		return currentStep.fn(&m)
+	for last+1 < len(m.steps) && m.steps[last+1].independent {
+		last++
 	}
+
+	return last
+}
+
+// startedEarly reports whether the current step was started along with
+// the one before it
+func (m Model) startedEarly() bool {
+	return m.current > m.resumed && m.steps[m.current].independent && m.steps[m.current-1].independent
 }
 
 func (m Model) executeNextStep() (Model, tea.Cmd) {
@@ -130,13 +253,27 @@ func (m Model) executeNextStep() (Model, tea.Cmd) {
 		log.Info("start: all steps complete", "current", m.current, "steps", len(m.steps))
 
 		m.done = true
+		m.finishSession()
 
 		return m, tea.Quit
 	}
 
 	// Move to next step and execute it
+	m.recordStep()
 	m.current++
 
+	// An independent step may already be running, or even done
+	if m.startedEarly() {
+		msg, ok := m.pending[m.current]
+		if !ok {
+			return m, nil
+		}
+
+		delete(m.pending, m.current)
+
+		return m, func() tea.Msg { return msg }
+	}
+
 	return m, m.executeCurrentStep()
 }
 
@@ -144,39 +281,70 @@ func (m Model) currentStep() step {
 	return m.steps[m.current]
 }
 
+// quickstartCommand builds the command that runs the quickstart script
+func (m Model) quickstartCommand() (*exec.Cmd, error) {
+	plan, err := m.planQuickstart()
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := exec.Command(plan.Command[0], plan.Command[1:]...)
+	cmd.Dir = plan.Dir
+
+	if len(plan.environ) > 0 {
+		cmd.Env = append(os.Environ(), plan.environ...)
+	}
+
+	return cmd, nil
+}
+
+// execQuickstartScript hands the terminal to the quickstart script until
+// it exits, so it can read input and Ctrl-C reaches it. Once it has exited,
+// the step completes with its exit code.
 func (m Model) execQuickstartScript() tea.Cmd {
-	// Special case: if the path is "task-start", run 'task start' directly
-	if m.quickstartScriptPath == "task-start" {
-		// Run 'task start' - use the task binary directly
-		taskPath, err := exec.LookPath("task")
-		if err != nil {
-			// Fallback to just "task" and let the system find it
-			taskPath = "task"
-		}
+	script, err := m.quickstartExec()
+	if err != nil {
+		return func() tea.Msg { return stepErrorMsg{err: err} }
+	}
 
-		cmd := exec.Command(taskPath, "start")
+	return tea.Exec(script, script.exited)
+}
 
-		return tea.ExecProcess(cmd, func(e error) tea.Msg {
-			return startScriptCompleteMsg{err: e}
-		})
+// quickstartExec prepares the quickstart script to run with the terminal
+func (m Model) quickstartExec() (*scriptExec, error) {
+	cmd, err := m.quickstartCommand()
+	if err != nil {
+		return nil, err
 	}
 
-	// Regular quickstart script execution
-	cmd := exec.Command(m.quickstartScriptPath)
+	return &scriptExec{ctx: m.runContext(), cmd: cmd, timeout: m.opts.TimeoutPerStep}, nil
+}
 
-	return tea.ExecProcess(cmd, func(e error) tea.Msg {
-		return startScriptCompleteMsg{err: e}
-	})
+// Interrupt handles Ctrl-C, which quits and exits with exitCodeInterrupted.
+// While the quickstart script runs, Ctrl-C goes to the script instead.
+func (m Model) Interrupt() (tea.Model, tea.Cmd) {
+	log.Info("start: interrupted")
+
+	m.interrupted = true
+	m.quitting = true
+
+	return m, tea.Quit
 }
 
 func (m Model) execSelfUpdate() tea.Cmd {
 	cmd := exec.Command("dr", "self", "update")
+	continues := m.selectionContinues()
 
 	return tea.ExecProcess(cmd, func(err error) tea.Msg {
 		if err != nil {
 			return stepErrorMsg{err: err}
 		}
 
+		// The selected steps after the update go on with this run
+		if continues {
+			return stepCompleteMsg{message: "Update finished.\n", updated: true}
+		}
+
 		return stepCompleteMsg{
 			message:  "Update finished. Please start last command again.",
 			hideMenu: true,
@@ -190,37 +358,65 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		return m.handleKey(msg)
 
+	case stepResultMsg:
+		return m.handleStepResult(msg)
+
 	case stepCompleteMsg:
 		return m.handleStepComplete(msg)
 
 	case stepErrorMsg:
 		log.Debug("start: step error", "error", msg.err)
 
-		m.err = msg.err
-
-		return m, tea.Quit
+		if m.continueAfterFailure() {
+			m.recordFailure(msg.err)
 
-	case startScriptCompleteMsg:
-		log.Debug("start: script complete")
+			return m.executeNextStep()
+		}
 
 		m.err = msg.err
 
-		if m.err != nil {
-			return m, tea.Quit
-		}
+		return m, tea.Quit
 
-		// Script execution completed successfully, update state and quit
-		if m.repoRoot != "" {
-			_ = state.UpdateAfterSuccessfulRun(m.repoRoot)
-		}
+	case tea.WindowSizeMsg:
+		return m.handleResize(msg), nil
 
-		return m, tea.Quit
+	case templatesLoadedMsg:
+		m.templates = msg.templates
+		m.templateCursor = 0
+		m.selectingTemplate = true
+		m.stepCompleteMessage = "Choose a template to clone:\n"
+
+		return m, nil
 	}
 
 	return m, nil
 }
 
-func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) { //nolint: cyclop
+	if next, handled := m.handleHelpKey(msg); handled {
+		return next, nil
+	}
+
+	if m.choosingSteps {
+		return m.handleStepMenuKey(msg)
+	}
+
+	if m.selectingTemplate {
+		return m.handleTemplateKey(msg)
+	}
+
+	if m.selectingEndpoint {
+		return m.handleEndpointKey(msg)
+	}
+
+	if m.offeringTemplate {
+		return m.handleTemplateOfferKey(msg)
+	}
+
+	if m.pendingUpgrade != nil {
+		return m.handleTemplateUpgradeKey(msg)
+	}
+
 	// If there's an error, any key press quits
 	if m.err != nil {
 		log.Debug("start: key ignored due to error", "key", msg.String(), "error", m.err)
@@ -232,19 +428,7 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if m.waitingToExecute {
 		switch msg.String() {
 		case "y", "Y", "enter":
-			// Punch it, Chewie!
-			m.waitingToExecute = false
-			m.stepCompleteMessage = ""
-
-			if m.selfUpdate {
-				return m, m.execSelfUpdate()
-			}
-
-			if m.quickstartScriptPath != "" {
-				return m, m.execQuickstartScript()
-			}
-
-			return m.executeNextStep()
+			return m.confirmExecute()
 		case "n", "N", "q", "esc":
 			// Just hang on. Hang on, Dak.
 			if m.selfUpdate {
@@ -278,9 +462,58 @@ func (m Model) handleKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// confirmExecute goes ahead with what the user was asked to confirm: the
+// self update, or the quickstart script
+func (m Model) confirmExecute() (tea.Model, tea.Cmd) {
+	// Punch it, Chewie!
+	m.waitingToExecute = false
+	m.stepCompleteMessage = ""
+
+	if m.selfUpdate {
+		return m, m.execSelfUpdate()
+	}
+
+	if m.quickstartScriptPath != "" {
+		return m, m.execQuickstartScript()
+	}
+
+	return m.executeNextStep()
+}
+
+// confirmQuestion is what the user is asked to confirm, to explain why
+// the prompt can't be shown
+func (m Model) confirmQuestion() string {
+	if m.selfUpdate {
+		return "Update the CLI now?"
+	}
+
+	return fmt.Sprintf("Run %s?", m.quickstartScriptPath)
+}
+
+// handleStepResult applies the result of the current step, and keeps the
+// result of a later one until the steps ahead of it are done
+func (m Model) handleStepResult(msg stepResultMsg) (tea.Model, tea.Cmd) {
+	if msg.index == m.current {
+		return m.Update(msg.msg)
+	}
+
+	if msg.index > m.current {
+		log.Debug("start: step finished early", "idx", msg.index, "current", m.current)
+
+		if m.pending == nil {
+			m.pending = map[int]tea.Msg{}
+		}
+
+		m.pending[msg.index] = msg.msg
+	}
+
+	return m, nil
+}
+
 func (m Model) handleStepComplete(msg stepCompleteMsg) (tea.Model, tea.Cmd) {
 	log.Debug(
 		"start: step complete",
+		"idx", m.current,
 		"message", msg.message,
 		"waiting", msg.waiting,
 		"done", msg.done,
@@ -289,8 +522,58 @@ func (m Model) handleStepComplete(msg stepCompleteMsg) (tea.Model, tea.Cmd) {
 		"execute_script", msg.executeScript,
 		"quickstart_script_path", msg.quickstartScriptPath,
 		"need_template_setup", msg.needTemplateSetup,
+		"script_exited", msg.scriptExited,
+		"exit_code", msg.exitCode,
+		"select_template", msg.selectTemplate,
+		"template_dir", msg.templateDir,
 	)
 
+	if msg.scriptExited {
+		return m.handleScriptExit(msg)
+	}
+
+	if msg.selectEndpoint {
+		m.stepCompleteMessage = msg.message
+		m.selectingEndpoint = true
+
+		return m, nil
+	}
+
+	if msg.login {
+		return m, m.execLogin()
+	}
+
+	if msg.offerTemplateSetup {
+		m.stepCompleteMessage = msg.message
+		m.offeringTemplate = true
+
+		return m, nil
+	}
+
+	if msg.selectTemplate && !m.opts.DryRun {
+		m.stepCompleteMessage = msg.message
+
+		return m, m.loadTemplates(msg.catalog)
+	}
+
+	if msg.upgrade != nil {
+		m.stepCompleteMessage = msg.message
+		m.pendingUpgrade = msg.upgrade
+
+		return m, nil
+	}
+
+	// Later steps run inside the cloned template
+	if msg.templateDir != "" {
+		if err := os.Chdir(msg.templateDir); err != nil {
+			m.err = fmt.Errorf("Failed to enter %s: %w", msg.templateDir, err)
+
+			return m, tea.Quit
+		}
+
+		m.repoRoot = msg.templateDir
+	}
+
 	// Store any message from the completed step
 	if msg.message != "" {
 		m.stepCompleteMessage = msg.message
@@ -304,6 +587,14 @@ func (m Model) handleStepComplete(msg stepCompleteMsg) (tea.Model, tea.Cmd) {
 		m.selfUpdate = msg.selfUpdate
 	}
 
+	if msg.updated {
+		m.selfUpdate = false
+	}
+
+	if msg.updateVersion != "" {
+		m.updateVersion = msg.updateVersion
+	}
+
 	// Store quickstart script path if provided
 	if msg.quickstartScriptPath != "" {
 		m.quickstartScriptPath = msg.quickstartScriptPath
@@ -314,20 +605,45 @@ func (m Model) handleStepComplete(msg stepCompleteMsg) (tea.Model, tea.Cmd) {
 		m.needTemplateSetup = true
 	}
 
+	if m.opts.DryRun {
+		if dryRunModel, cmd, handled := m.handleDryRun(msg); handled {
+			return dryRunModel, cmd
+		}
+	}
+
+	// A pre-selected self-update runs without asking
+	if msg.executeScript && msg.selfUpdate {
+		return m, m.execSelfUpdate()
+	}
+
 	// If this step requires executing a script, do it now
 	if msg.executeScript && m.quickstartScriptPath != "" {
 		return m, m.execQuickstartScript()
 	}
 
-	// If this step requires waiting for user input, set the flag and stop
+	// If this step requires waiting for user input, set the flag and stop.
+	// With --assume-yes the answer is yes, and without a terminal there is
+	// no one to give it.
 	if msg.waiting {
+		if m.opts.AnswerYes {
+			return m.confirmExecute()
+		}
+
+		if err := confirm.Check(m.confirmQuestion()); err != nil {
+			m.err = err
+
+			return m, tea.Quit
+		}
+
 		m.waitingToExecute = true
+
 		return m, nil
 	}
 
 	// If this step marks completion, we're done
 	if msg.done {
 		m.done = true
+		m.finishSession()
 
 		return m, tea.Quit
 	}
@@ -336,16 +652,70 @@ func (m Model) handleStepComplete(msg stepCompleteMsg) (tea.Model, tea.Cmd) {
 	return m.executeNextStep()
 }
 
-func (m Model) View() string { //nolint: cyclop
+// handleScriptExit ends the run once the quickstart script has exited
+func (m Model) handleScriptExit(msg stepCompleteMsg) (tea.Model, tea.Cmd) {
+	m.exitCode = msg.exitCode
+
+	// Ctrl-C stopped the script, or the CLI was interrupted while it ran
+	if errors.Is(msg.scriptErr, errRunInterrupted) {
+		m.interrupted = true
+		m.quitting = true
+
+		return m, tea.Quit
+	}
+
+	if err := scriptFailure(msg.scriptErr); err != nil {
+		m.err = err
+
+		return m, tea.Quit
+	}
+
+	// Script execution completed successfully, update state and quit
+	if m.repoRoot != "" {
+		_ = state.UpdateAfterSuccessfulRun(m.repoRoot)
+	}
+
+	m.done = true
+	m.finishSession()
+
+	return m, tea.Quit
+}
+
+func (m Model) View() string {
+	if m.showHelp {
+		return m.helpOverlay(m.view())
+	}
+
+	return m.view()
+}
+
+func (m Model) view() string { //nolint: cyclop
 	var sb strings.Builder
 
-	if !m.hideMenu {
+	// With --quiet only errors and prompts are shown
+	if m.quiet && m.err == nil && !m.waitingToExecute {
+		return ""
+	}
+
+	if !m.hideMenu && !m.quiet {
 		sb.WriteString("\n")
-		sb.WriteString(tui.WelcomeStyle.Render("🚀 DataRobot AI Application Quickstart"))
+		sb.WriteString(tui.WelcomeStyle.Render(m.title()))
+
+		if m.opts.DryRun {
+			sb.WriteString("  ")
+			sb.WriteString(dryRunBanner.Render("DRY RUN"))
+		}
+
 		sb.WriteString("\n\n")
 
 		for i, step := range m.steps {
-			if i < m.current {
+			if i == m.current && m.err != nil {
+				sb.WriteString(fmt.Sprintf("  %s %s\n", crossMark, tui.ErrorStyle.Render(m.failedStepDescription(step, m.err))))
+			} else if err, failed := m.failedSteps[i]; failed {
+				sb.WriteString(fmt.Sprintf("  %s %s\n", crossMark, tui.ErrorStyle.Render(m.failedStepDescription(step, err))))
+			} else if i < m.resumed {
+				sb.WriteString(fmt.Sprintf("  %s %s\n", skipMark, tui.DimStyle.Render(step.description+" (done in a previous run)")))
+			} else if i < m.current {
 				sb.WriteString(fmt.Sprintf("  %s %s\n", checkMark, tui.DimStyle.Render(step.description)))
 			} else if i == m.current {
 				sb.WriteString(fmt.Sprintf("  %s %s\n", arrow, step.description))
@@ -357,6 +727,17 @@ func (m Model) View() string { //nolint: cyclop
 		sb.WriteString("\n")
 	}
 
+	// Steps that failed with --continue-on-error, summed up once the run
+	// is over, then any error that ended the run
+	if m.showSummary() {
+		sb.WriteString(m.summaryView())
+		sb.WriteString("\n")
+	} else {
+		for _, i := range m.failedStepIndexes() {
+			sb.WriteString(fmt.Sprintf("%s %s\n", tui.ErrorStyle.Render("Error: "), m.failedSteps[i].Error()))
+		}
+	}
+
 	// Display error or status message
 	if m.err != nil {
 		sb.WriteString(fmt.Sprintf("%s %s\n", tui.ErrorStyle.Render("Error: "), m.err.Error()))
@@ -364,21 +745,55 @@ func (m Model) View() string { //nolint: cyclop
 		return sb.String()
 	}
 
+	if m.dryRunReport != "" {
+		sb.WriteString(tui.BaseTextStyle.Render("Nothing was executed. This is what would run:"))
+		sb.WriteString("\n\n")
+		sb.WriteString(m.dryRunReport)
+	}
+
 	// Display step message if available
 	if m.stepCompleteMessage != "" {
 		sb.WriteString(tui.BaseTextStyle.Render(m.stepCompleteMessage))
 		sb.WriteString("\n")
 	}
 
+	if m.choosingSteps {
+		sb.WriteString(m.stepMenuView())
+	}
+
+	if m.selectingTemplate {
+		sb.WriteString(m.templateListView())
+	}
+
+	if m.selectingEndpoint {
+		sb.WriteString(m.endpointListView())
+	}
+
 	// Display footer if not done
 	if !m.done && !m.quitting {
 		sb.WriteString("\n")
 
-		if m.waitingToExecute {
+		if m.choosingSteps {
+			sb.WriteString(tui.DimStyle.Render("Use ↑/↓ to move, SPACE to tick a step, ENTER to run the ticked steps, q to quit"))
+		} else if m.selectingTemplate {
+			sb.WriteString(tui.DimStyle.Render("Use ↑/↓ to choose a template, ENTER to clone it, q to quit"))
+		} else if m.enteringEndpoint {
+			sb.WriteString(tui.DimStyle.Render("Type your DataRobot URL and press ENTER, or ESC to go back"))
+		} else if m.selectingEndpoint {
+			sb.WriteString(tui.DimStyle.Render("Use ↑/↓ to choose an environment, ENTER to select it, q to quit"))
+		} else if m.offeringTemplate {
+			sb.WriteString(tui.DimStyle.Render("Press 'y' or ENTER to set up a template, 'n' to skip"))
+		} else if m.pendingUpgrade != nil {
+			sb.WriteString(tui.DimStyle.Render("Press 'y' or ENTER to upgrade, 'n' to keep the installed version"))
+		} else if m.waitingToExecute {
 			sb.WriteString(tui.DimStyle.Render("Press 'y' or ENTER to confirm, 'n' to cancel"))
 		} else if !m.selfUpdate {
 			sb.WriteString(tui.Footer())
 		}
+
+		if !m.enteringEndpoint && (m.waitingToExecute || !m.selfUpdate) {
+			sb.WriteString(tui.DimStyle.Render(" · ? for help"))
+		}
 	}
 
 	sb.WriteString("\n")
@@ -386,6 +801,97 @@ func (m Model) View() string { //nolint: cyclop
 	return sb.String()
 }
 
+// title is the heading of the step list
+func (m Model) title() string {
+	if m.opts.Action == actionOnboarding {
+		return "👋 Welcome to the DataRobot CLI"
+	}
+
+	return "🚀 DataRobot AI Application Quickstart"
+}
+
+// failedStepDescription describes a step that failed with err, noting a
+// timeout or the exit code of the quickstart script if it was the cause
+func (m Model) failedStepDescription(s step, err error) string {
+	var timeout *stepTimeoutError
+	if errors.As(err, &timeout) {
+		return fmt.Sprintf("%s (timed out after %s)", s.description, timeout.timeout)
+	}
+
+	if m.exitCode > 0 && err == m.err {
+		return fmt.Sprintf("%s (exit code %d)", s.description, m.exitCode)
+	}
+
+	return s.description
+}
+
+// handleDryRun reports what a step would execute instead of running it.
+// A self update is reported and the remaining steps continue, so the
+// quickstart script can be previewed as well; the script ends the run.
+func (m Model) handleDryRun(msg stepCompleteMsg) (Model, tea.Cmd, bool) {
+	if msg.selectTemplate {
+		m.stepCompleteMessage = ""
+		m.dryRunReport += m.planTemplate()
+
+		return m.finishDryRunStep()
+	}
+
+	if msg.needTemplateSetup {
+		m.needTemplateSetup = false
+		m.stepCompleteMessage = ""
+		m.dryRunReport += "Would launch the interactive template setup ('dr templates setup').\n"
+
+		return m.finishDryRunStep()
+	}
+
+	if msg.selfUpdate {
+		m.selfUpdate = false
+		m.stepCompleteMessage = ""
+		m.dryRunReport += m.planSelfUpdate().String()
+
+		if msg.executeScript && !m.selectionContinues() {
+			m.done = true
+
+			return m, tea.Quit, true
+		}
+
+		m, cmd := m.executeNextStep()
+
+		return m, cmd, true
+	}
+
+	if (msg.executeScript || msg.waiting) && m.quickstartScriptPath != "" {
+		plan, err := m.planQuickstart()
+		if err != nil {
+			m.err = err
+
+			return m, tea.Quit, true
+		}
+
+		m.stepCompleteMessage = ""
+		m.dryRunReport += plan.String()
+		m.done = true
+
+		return m, tea.Quit, true
+	}
+
+	return m, nil, false
+}
+
+// finishDryRunStep ends a dry run at a step that would have ended the run,
+// unless more selected steps follow it
+func (m Model) finishDryRunStep() (Model, tea.Cmd, bool) {
+	if m.selectionContinues() {
+		m, cmd := m.executeNextStep()
+
+		return m, cmd, true
+	}
+
+	m.done = true
+
+	return m, tea.Quit, true
+}
+
 // Step functions
 
 func startQuickstart(_ *Model) tea.Msg {
@@ -395,7 +901,7 @@ func startQuickstart(_ *Model) tea.Msg {
 	return stepCompleteMsg{}
 }
 
-func checkSelfVersion(_ *Model) tea.Msg {
+func checkSelfVersion(m *Model) tea.Msg {
 	// Do we have the required self version?
 	tool, err := tools.GetSelfRequirement()
 	if err != nil {
@@ -404,17 +910,55 @@ func checkSelfVersion(_ *Model) tea.Msg {
 
 	if !tools.SufficientSelfVersion(tool.MinimumVersion) {
 		log.Info("start: insufficient CLI version", "minimal", tool.MinimumVersion, "installed", version.Version)
+
+		// Without self-update there is nothing to offer; carry on and let
+		// the template fail if it really needs the newer version
+		if update.SelfUpdateDisabled() {
+			return stepCompleteMsg{
+				message: fmt.Sprintf("%s (minimal: v%s, installed: %s)\nSelf-update is disabled; ask your administrator to install a newer version.\n",
+					tool.Name, tool.MinimumVersion, version.Version),
+			}
+		}
+
 		missing := fmt.Sprintf("%s (minimal: v%s, installed: %s)\nDo you want to update it now?",
 			tool.Name, tool.MinimumVersion, version.Version)
 
 		return stepCompleteMsg{
-			waiting:    true,
-			selfUpdate: true,
-			message:    missing,
+			waiting:       true,
+			selfUpdate:    true,
+			message:       missing,
+			updateVersion: "v" + tool.MinimumVersion,
 		}
 	}
 
-	return stepCompleteMsg{}
+	return stepCompleteMsg{message: m.updateNotice()}
+}
+
+// updateNotice returns a note about a newer release, if there is one. The
+// check is cached for update-check-interval, so most runs don't touch the
+// network, and a check that fails or is slow is silently skipped. Nothing
+// is checked when self-update is disabled.
+func (m *Model) updateNotice() string {
+	if !update.IsReleaseBuild() || update.SelfUpdateDisabled() {
+		return ""
+	}
+
+	ctx, cancel := context.WithTimeout(m.stepContext(), updateCheckTimeout)
+	defer cancel()
+
+	check, err := update.CheckForUpdate(ctx, m.opts.ForceUpdateCheck)
+	if err != nil {
+		log.Debug("start: update check failed", "error", err)
+
+		return ""
+	}
+
+	if !check.UpdateAvailable {
+		return ""
+	}
+
+	return fmt.Sprintf("%s %s is available (installed: %s). Run 'dr self update' to upgrade.\n",
+		version.AppName, check.LatestVersion, check.CurrentVersion)
 }
 
 func checkPrerequisites(_ *Model) tea.Msg {
@@ -454,12 +998,20 @@ func checkRepository(m *Model) tea.Msg {
 	if !repo.IsInRepo() {
 		pwd, _ := os.Getwd()
 		log.Info("start: pwd " + pwd + " is not a DataRobot repository")
-		// Not in a repo, signal that we need to run templates setup and quit
-		return stepCompleteMsg{
-			message:           "Not in a DataRobot repository. Launching template setup...\n",
-			done:              true,
-			needTemplateSetup: true,
+		// Not in a repo, so choose a template and clone it first
+		msg := stepCompleteMsg{
+			message:        "Not in a DataRobot repository. Fetching templates...\n",
+			selectTemplate: true,
 		}
+
+		// Fetching the catalog here overlaps it with the other checks.
+		// Without --template a headless run has no use for it.
+		if !m.opts.DryRun && (!m.headless || m.opts.Template != "") {
+			templates, err := fetchTemplates()
+			msg.catalog = &prefetchedCatalog{templates: templates, err: err}
+		}
+
+		return msg
 	}
 
 	// We're in a repo, continue to next step
@@ -467,6 +1019,15 @@ func checkRepository(m *Model) tea.Msg {
 }
 
 func findAndExecuteStart(m *Model) tea.Msg {
+	// A script given with --quickstart-script skips auto-detection
+	if m.opts.QuickstartScript != "" {
+		return stepCompleteMsg{
+			message:              fmt.Sprintf("Using quickstart script: %s\n", m.opts.QuickstartScript),
+			waiting:              !m.opts.AnswerYes,
+			quickstartScriptPath: m.opts.QuickstartScript,
+		}
+	}
+
 	// Try to find and execute either 'dr task run start' or a quickstart script
 	// Prefer 'dr task run start' if available
 
@@ -553,7 +1114,8 @@ func findQuickstartScript() (string, error) {
 		return "", fmt.Errorf(errScriptSearchFailed, err)
 	}
 
-	// Find the first executable file
+	// Find the first file that can be run: an executable, or a script
+	// whose interpreter is known
 	for _, match := range matches {
 		info, err := os.Stat(match)
 		if err != nil {
@@ -565,25 +1127,41 @@ func findQuickstartScript() (string, error) {
 			continue
 		}
 
-		// Check if file is executable
-		if isExecutable(match, info) {
+		if canRun(match, info) {
 			return match, nil
 		}
 	}
 
-	// No executable script found - this is not an error
+	// No runnable script found - this is not an error
 	return "", nil
 }
 
-// isExecutable determines if a file is executable based on platform-specific rules
-func isExecutable(path string, info os.FileInfo) bool {
-	// On Windows, check for common executable extensions
-	if runtime.GOOS == "windows" {
-		ext := strings.ToLower(filepath.Ext(path))
-		return ext == ".exe" || ext == ".bat" || ext == ".cmd" || ext == ".ps1"
+// resolveQuickstartScript validates a script given with --quickstart-script
+// and returns its absolute path. Relative paths are resolved against the
+// working directory. With an interpreter, the script need not be
+// executable.
+func resolveQuickstartScript(path, interpreter string) (string, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return "", fmt.Errorf("Failed to resolve quickstart script path: %w", err)
+	}
+
+	info, err := os.Stat(absPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", fmt.Errorf("Quickstart script not found: %s.", absPath)
+		}
+
+		return "", fmt.Errorf("Failed to read quickstart script: %w", err)
+	}
+
+	if info.IsDir() {
+		return "", fmt.Errorf("Quickstart script is a directory: %s.", absPath)
+	}
+
+	if interpreter == "" && !canRun(absPath, info) {
+		return "", fmt.Errorf("Quickstart script is not executable and has no shebang line or known extension: %s. Make it executable, or choose an interpreter with --interpreter.", absPath)
 	}
 
-	// On Unix-like systems, check execute permission bits
-	// 0o111 checks if any execute bit is set (user, group, or other)
-	return info.Mode()&0o111 != 0
+	return absPath, nil
 }
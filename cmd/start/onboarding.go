@@ -0,0 +1,297 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/errs"
+	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+)
+
+// SkipOnboardingKey suppresses the first-run wizard. The wizard sets it in
+// the config file once it has run, so it is only offered once.
+const SkipOnboardingKey = "skip-onboarding"
+
+// actionOnboarding runs the first-run wizard. It isn't one of Actions: the
+// wizard is reached by running dr without a command, not with --action.
+const actionOnboarding Action = "onboarding"
+
+func onboardingSteps() []step {
+	return []step{
+		{description: "Choosing your DataRobot environment...", fn: chooseEndpoint},
+		{description: "Logging in to DataRobot...", fn: onboardingLogin},
+		{description: "Setting up an application template...", fn: offerTemplateSetup},
+	}
+}
+
+// NeedsOnboarding reports whether running dr without a command should
+// start the first-run wizard: there is no config file, no endpoint from
+// the environment, and the wizard hasn't been skipped.
+func NeedsOnboarding() bool {
+	if viper.GetBool(SkipOnboardingKey) || viper.ConfigFileUsed() != "" || config.GetBaseURL() != "" {
+		return false
+	}
+
+	path, err := config.ConfigFilePath()
+	if err != nil {
+		return false
+	}
+
+	_, err = os.Stat(path)
+
+	return os.IsNotExist(err)
+}
+
+// RunOnboarding walks a new user through choosing an endpoint, logging in,
+// and optionally setting up a template. Without a terminal to run the
+// wizard in, the same steps are printed as instructions.
+func RunOnboarding(cmd *cobra.Command) error {
+	if !term.IsTerminal(int(os.Stdin.Fd())) || !term.IsTerminal(int(os.Stdout.Fd())) {
+		return PrintSetupInstructions(cmd.OutOrStdout())
+	}
+
+	ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+	defer stop()
+
+	innerModel, ok, err := runModel(ctx, NewStartModel(Options{Action: actionOnboarding}))
+	if err != nil {
+		return err
+	}
+
+	// Whatever happened, the wizard isn't offered again
+	if err := config.SetConfigFileValue([]string{SkipOnboardingKey}, true); err != nil {
+		log.Debug("Failed to record that onboarding ran", "error", err)
+	}
+
+	if !ok || innerModel.err != nil {
+		return innerModel.err
+	}
+
+	if !innerModel.done || !innerModel.needTemplateSetup {
+		return nil
+	}
+
+	return runTemplateSetup(ctx)
+}
+
+// PrintSetupInstructions prints the steps the wizard would walk through
+func PrintSetupInstructions(w io.Writer) error {
+	_, err := fmt.Fprint(w, `Welcome to the DataRobot CLI! To get started:
+
+  1. Choose your DataRobot environment:  dr auth set-url https://app.datarobot.com
+  2. Log in:                             dr auth login
+  3. Set up an application template:     dr templates setup
+
+Run 'dr --help' to see every command, or run 'dr' in a terminal for a guided setup.
+`)
+
+	return err
+}
+
+func chooseEndpoint(_ *Model) tea.Msg {
+	if url := config.GetBaseURL(); url != "" {
+		return stepCompleteMsg{message: "Using " + url + "\n"}
+	}
+
+	return stepCompleteMsg{
+		message:        "Choose your DataRobot environment. Don't know which one? Check the URL of your DataRobot login page.\n",
+		selectEndpoint: true,
+	}
+}
+
+func onboardingLogin(_ *Model) tea.Msg {
+	if _, err := config.GetAPIKey(); err == nil {
+		return stepCompleteMsg{message: "Already logged in.\n"}
+	}
+
+	return stepCompleteMsg{login: true}
+}
+
+func offerTemplateSetup(_ *Model) tea.Msg {
+	return stepCompleteMsg{
+		message:            "Do you want to set up an application template now?",
+		offerTemplateSetup: true,
+	}
+}
+
+// endpointChoices are the entries of the endpoint list: the known regions,
+// then a custom URL.
+func endpointChoices() []string {
+	choices := make([]string, 0, len(config.Regions)+1)
+
+	// Titles are measured in terminal cells, since flags take two
+	width := 0
+	for _, region := range config.Regions {
+		width = max(width, lipgloss.Width(region.Title()))
+	}
+
+	for _, region := range config.Regions {
+		title := region.Title()
+		title += strings.Repeat(" ", width-lipgloss.Width(title))
+
+		choices = append(choices, fmt.Sprintf("%s  %s", title, tui.DimStyle.Render(region.URL)))
+	}
+
+	return append(choices, "🏢 Custom URL")
+}
+
+func (m Model) handleEndpointKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.enteringEndpoint {
+		switch msg.Type { //nolint: exhaustive
+		case tea.KeyEnter:
+			return m.saveEndpoint(m.endpointInput.Value())
+		case tea.KeyEsc:
+			m.enteringEndpoint = false
+			m.endpointInput.Blur()
+
+			return m, nil
+		}
+
+		var cmd tea.Cmd
+
+		m.endpointInput, cmd = m.endpointInput.Update(msg)
+
+		return m, cmd
+	}
+
+	switch msg.String() {
+	case "up", "k":
+		if m.endpointCursor > 0 {
+			m.endpointCursor--
+		}
+	case "down", "j":
+		if m.endpointCursor < len(config.Regions) {
+			m.endpointCursor++
+		}
+	case "enter":
+		if m.endpointCursor < len(config.Regions) {
+			return m.saveEndpoint(config.Regions[m.endpointCursor].URL)
+		}
+
+		m.enteringEndpoint = true
+		m.endpointInput = textinput.New()
+		m.endpointInput.Placeholder = "https://datarobot.example.com"
+		m.endpointInput.CharLimit = 256
+
+		return m, m.endpointInput.Focus()
+	case "q", "esc":
+		m.quitting = true
+
+		return m, tea.Quit
+	}
+
+	return m, nil
+}
+
+// saveEndpoint writes the chosen endpoint to the config file and moves on
+// to logging in; an invalid URL can be corrected.
+func (m Model) saveEndpoint(url string) (tea.Model, tea.Cmd) {
+	if strings.TrimSpace(url) == "" {
+		return m, nil
+	}
+
+	if err := config.SaveURLToConfig(url); err != nil {
+		m.stepCompleteMessage = fmt.Sprintf("%s Verify your URL and try again.\n", err)
+
+		return m, nil
+	}
+
+	m.selectingEndpoint = false
+	m.enteringEndpoint = false
+	m.stepCompleteMessage = "Using " + config.GetBaseURL() + "\n"
+
+	return m.executeNextStep()
+}
+
+// endpointListView renders the endpoint choices, or the custom URL input
+func (m Model) endpointListView() string {
+	if m.enteringEndpoint {
+		return "  " + m.endpointInput.View() + "\n"
+	}
+
+	var sb strings.Builder
+
+	for i, choice := range endpointChoices() {
+		if i == m.endpointCursor {
+			sb.WriteString(fmt.Sprintf("  %s %s\n", arrow, tui.InfoStyle.Render(choice)))
+		} else {
+			sb.WriteString(fmt.Sprintf("    %s\n", choice))
+		}
+	}
+
+	return sb.String()
+}
+
+func (m Model) handleTemplateOfferKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "y", "Y", "enter":
+		m.needTemplateSetup = true
+		m.stepCompleteMessage = "Launching template setup...\n"
+	case "n", "N", "q", "esc":
+		m.stepCompleteMessage = "You're all set. Run 'dr templates setup' or 'dr start' whenever you're ready.\n"
+	default:
+		return m, nil
+	}
+
+	m.offeringTemplate = false
+	m.done = true
+
+	return m, tea.Quit
+}
+
+// execLogin runs the browser login outside the TUI, since it prints the
+// login link and waits for the browser
+func (m Model) execLogin() tea.Cmd {
+	return tea.Exec(loginCommand{}, func(err error) tea.Msg {
+		if err != nil {
+			return stepErrorMsg{err: err}
+		}
+
+		return stepCompleteMsg{message: "Logged in.\n"}
+	})
+}
+
+// loginCommand runs the regular login flow as a tea.ExecCommand
+type loginCommand struct{}
+
+func (loginCommand) Run() error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if !auth.EnsureAuthenticated(ctx) {
+		return errs.NewAuthError("Authentication failed. Run 'dr auth login' to try again.", nil)
+	}
+
+	return nil
+}
+
+func (loginCommand) SetStdin(io.Reader)  {}
+func (loginCommand) SetStdout(io.Writer) {}
+func (loginCommand) SetStderr(io.Writer) {}
@@ -0,0 +1,156 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setOnboardingHome(t *testing.T) string {
+	t.Helper()
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	home := t.TempDir()
+	testutil.SetTestHomeDir(t, home)
+
+	return home
+}
+
+func TestNeedsOnboarding(t *testing.T) {
+	setOnboardingHome(t)
+
+	assert.True(t, NeedsOnboarding())
+
+	viper.Set(SkipOnboardingKey, true)
+	assert.False(t, NeedsOnboarding())
+}
+
+func TestNeedsOnboardingWithEndpoint(t *testing.T) {
+	setOnboardingHome(t)
+
+	viper.Set(config.DataRobotURL, "https://app.datarobot.com/api/v2")
+	assert.False(t, NeedsOnboarding())
+}
+
+func TestNeedsOnboardingWithConfigFile(t *testing.T) {
+	setOnboardingHome(t)
+
+	path, err := config.ConfigFilePath()
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o700))
+	require.NoError(t, os.WriteFile(path, []byte("verbose: 0\n"), 0o600))
+
+	assert.False(t, NeedsOnboarding())
+}
+
+func TestPrintSetupInstructions(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, PrintSetupInstructions(&buf))
+	assert.Contains(t, buf.String(), "dr auth set-url")
+	assert.Contains(t, buf.String(), "dr auth login")
+	assert.Contains(t, buf.String(), "dr templates setup")
+}
+
+func TestOnboardingSteps(t *testing.T) {
+	setOnboardingHome(t)
+
+	steps := stepsForAction(actionOnboarding)
+	require.Len(t, steps, 3)
+
+	msg, ok := steps[0].fn(&Model{}).(stepCompleteMsg)
+	require.True(t, ok)
+	assert.True(t, msg.selectEndpoint)
+
+	msg, ok = steps[1].fn(&Model{}).(stepCompleteMsg)
+	require.True(t, ok)
+	assert.True(t, msg.login)
+
+	msg, ok = steps[2].fn(&Model{}).(stepCompleteMsg)
+	require.True(t, ok)
+	assert.True(t, msg.offerTemplateSetup)
+}
+
+func TestChooseEndpointSkipsConfiguredEndpoint(t *testing.T) {
+	setOnboardingHome(t)
+
+	viper.Set(config.DataRobotURL, "https://app.datarobot.com/api/v2")
+
+	msg, ok := chooseEndpoint(&Model{}).(stepCompleteMsg)
+	require.True(t, ok)
+	assert.False(t, msg.selectEndpoint)
+	assert.Contains(t, msg.message, "https://app.datarobot.com")
+}
+
+func TestHandleEndpointKey(t *testing.T) {
+	m := Model{selectingEndpoint: true}
+
+	updated, _ := m.handleEndpointKey(tea.KeyMsg{Type: tea.KeyUp})
+	m = updated.(Model)
+	assert.Equal(t, 0, m.endpointCursor)
+
+	for range len(config.Regions) + 2 {
+		updated, _ = m.handleEndpointKey(tea.KeyMsg{Type: tea.KeyDown})
+		m = updated.(Model)
+	}
+
+	assert.Equal(t, len(config.Regions), m.endpointCursor)
+	assert.Contains(t, m.endpointListView(), "Custom URL")
+
+	updated, _ = m.handleEndpointKey(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	assert.True(t, m.enteringEndpoint)
+
+	updated, _ = m.handleEndpointKey(tea.KeyMsg{Type: tea.KeyEsc})
+	m = updated.(Model)
+	assert.False(t, m.enteringEndpoint)
+	assert.True(t, m.selectingEndpoint)
+}
+
+func TestHandleTemplateOfferKey(t *testing.T) {
+	updated, cmd := Model{offeringTemplate: true}.handleTemplateOfferKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	m := updated.(Model)
+	assert.True(t, m.needTemplateSetup)
+	assert.True(t, m.done)
+	assert.NotNil(t, cmd)
+
+	updated, _ = Model{offeringTemplate: true}.handleTemplateOfferKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	m = updated.(Model)
+	assert.False(t, m.needTemplateSetup)
+	assert.True(t, m.done)
+	assert.Contains(t, m.stepCompleteMessage, "dr templates setup")
+
+	updated, cmd = Model{offeringTemplate: true}.handleTemplateOfferKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	m = updated.(Model)
+	assert.True(t, m.offeringTemplate)
+	assert.Nil(t, cmd)
+}
+
+func TestOnboardingTitle(t *testing.T) {
+	assert.Contains(t, NewStartModel(Options{Action: actionOnboarding}).title(), "Welcome")
+	assert.NotContains(t, NewStartModel(Options{}).title(), "Welcome")
+}
@@ -0,0 +1,141 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/datarobot/cli/internal/version"
+)
+
+// scriptPlan describes how the quickstart script will be run. It is used
+// both to build the command and to report it in --dry-run mode.
+type scriptPlan struct {
+	Path        string   `json:"path"                  yaml:"path"`
+	Command     []string `json:"command"               yaml:"command"`
+	Interpreter string   `json:"interpreter,omitempty" yaml:"interpreter,omitempty"`
+	Dir         string   `json:"dir"                   yaml:"dir"`
+	Env         []string `json:"env"                   yaml:"env"`
+
+	// environ is Env with the token unredacted, for running the script
+	environ []string
+}
+
+// updatePlan describes the self-update that would run
+type updatePlan struct {
+	CurrentVersion string `json:"current_version" yaml:"current_version"`
+	TargetVersion  string `json:"target_version"  yaml:"target_version"`
+}
+
+func (m Model) planQuickstart() (scriptPlan, error) {
+	dir, _ := os.Getwd()
+
+	// The injected variables come last, so they win over the env files
+	injected := append(credentialsEnv(m.opts), colorEnv()...)
+	environ := slices.Concat(m.opts.fileEnv, injected)
+
+	plan := scriptPlan{
+		Path:    m.quickstartScriptPath,
+		Dir:     dir,
+		Env:     slices.Concat(redactValues(m.opts.fileEnv), redactEnv(injected)),
+		environ: environ,
+	}
+
+	// Special case: if the path is "task-start", run 'task start' directly
+	if m.quickstartScriptPath == "task-start" {
+		// Run 'task start' - use the task binary directly
+		taskPath, err := exec.LookPath("task")
+		if err != nil {
+			// Fallback to just "task" and let the system find it
+			taskPath = "task"
+		}
+
+		plan.Path = taskPath
+		plan.Command = []string{taskPath, "start"}
+
+		// Task passes what follows -- to the task as CLI_ARGS
+		if len(m.opts.ScriptArgs) > 0 {
+			plan.Command = append(append(plan.Command, "--"), m.opts.ScriptArgs...)
+		}
+
+		return plan, nil
+	}
+
+	command, interpreter, err := scriptCommand(m.quickstartScriptPath, m.opts.Interpreter, m.opts.ScriptArgs)
+	if err != nil {
+		return scriptPlan{}, err
+	}
+
+	plan.Command = command
+	plan.Interpreter = interpreter
+
+	return plan, nil
+}
+
+// planTemplate describes the template that would be cloned, and where
+func (m Model) planTemplate() string {
+	dir := m.opts.Dir
+	if dir == "" {
+		dir = "a directory named after the template"
+	} else if absDir, err := filepath.Abs(dir); err == nil {
+		dir = absDir
+	}
+
+	if m.opts.Template == "" {
+		return fmt.Sprintf("Would ask which template to clone into %s.\n", dir)
+	}
+
+	return fmt.Sprintf("Would clone template %q into %s.\n", m.opts.Template, dir)
+}
+
+func (m Model) planSelfUpdate() updatePlan {
+	target := m.updateVersion
+	if target == "" {
+		target = "latest"
+	}
+
+	return updatePlan{CurrentVersion: version.Version, TargetVersion: target}
+}
+
+func (p scriptPlan) String() string {
+	var sb strings.Builder
+
+	interpreter := p.Interpreter
+	if interpreter == "" {
+		interpreter = "(executed directly)"
+	}
+
+	env := "no additional variables (inherits the current environment)"
+	if len(p.Env) > 0 {
+		env = strings.Join(p.Env, "\n             ")
+	}
+
+	fmt.Fprintf(&sb, "Script:      %s\n", p.Path)
+	fmt.Fprintf(&sb, "Command:     %s\n", strings.Join(p.Command, " "))
+	fmt.Fprintf(&sb, "Interpreter: %s\n", interpreter)
+	fmt.Fprintf(&sb, "Working dir: %s\n", p.Dir)
+	fmt.Fprintf(&sb, "Environment: %s\n", env)
+
+	return sb.String()
+}
+
+func (p updatePlan) String() string {
+	return fmt.Sprintf("Would update the DataRobot CLI from %s to %s.\n", p.CurrentVersion, p.TargetVersion)
+}
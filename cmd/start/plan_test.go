@@ -0,0 +1,101 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScriptInterpreterFromShebang(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shebang lines are not used on Windows")
+	}
+
+	script := filepath.Join(t.TempDir(), "quickstart.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/usr/bin/env bash\necho hi\n"), 0o755))
+
+	assert.Equal(t, "/usr/bin/env bash", shebangInterpreter(script))
+}
+
+func TestQuickstartScriptReceivesArguments(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell script")
+	}
+
+	script := filepath.Join(t.TempDir(), "quickstart.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\nfor arg in \"$@\"; do echo \"[$arg]\"; done\n"), 0o755))
+
+	m := Model{
+		opts:                 Options{NoInjectCreds: true, ScriptArgs: []string{"--flag", "value", "two words", "", "$HOME"}},
+		quickstartScriptPath: script,
+	}
+
+	cmd, err := m.quickstartCommand()
+	require.NoError(t, err)
+
+	output, err := cmd.Output()
+	require.NoError(t, err)
+	assert.Equal(t, "[--flag]\n[value]\n[two words]\n[]\n[$HOME]\n", string(output))
+}
+
+func TestTaskStartReceivesArguments(t *testing.T) {
+	m := Model{
+		opts:                 Options{ScriptArgs: []string{"--flag", "value"}},
+		quickstartScriptPath: "task-start",
+	}
+
+	plan, err := m.planQuickstart()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"start", "--", "--flag", "value"}, plan.Command[1:])
+}
+
+func TestDryRunReportsScriptWithoutExecuting(t *testing.T) {
+	m := Model{
+		opts:  Options{DryRun: true},
+		steps: stepsForAction(ActionExecuteScript),
+	}
+
+	next, cmd := m.handleStepComplete(stepCompleteMsg{
+		quickstartScriptPath: "/tmp/quickstart.sh",
+		executeScript:        true,
+	})
+
+	result, ok := next.(Model)
+	require.True(t, ok)
+	assert.NotNil(t, cmd)
+	assert.True(t, result.done)
+	assert.Contains(t, result.dryRunReport, "Script:      /tmp/quickstart.sh")
+	assert.Contains(t, result.View(), "DRY RUN")
+}
+
+func TestDryRunReportsSelfUpdateVersion(t *testing.T) {
+	m := Model{
+		opts:  Options{DryRun: true},
+		steps: stepsForAction(ActionSelfUpdate),
+	}
+
+	next, _ := m.handleStepComplete(stepCompleteMsg{selfUpdate: true, executeScript: true, updateVersion: "v1.2.3"})
+
+	result, ok := next.(Model)
+	require.True(t, ok)
+	assert.False(t, result.selfUpdate)
+	assert.Contains(t, result.dryRunReport, "to v1.2.3")
+}
@@ -0,0 +1,107 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+
+package start
+
+import (
+	"errors"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// processGroup is the process group a script leads. It outlives the script
+// while any process the script started is still running.
+type processGroup struct {
+	pgid int
+}
+
+// setProcessGroup makes cmd the leader of a new process group
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// newProcessGroup returns the group led by process, which was started with
+// setProcessGroup
+func newProcessGroup(process *os.Process) (*processGroup, error) {
+	return &processGroup{pgid: process.Pid}, nil
+}
+
+// setForeground makes the group cmd will lead the foreground process group
+// of the terminal stdin is, if the CLI is in the foreground itself. The
+// script can then read from the terminal, and Ctrl-C and other keys that
+// send signals reach the script and every process it starts. The returned
+// function gives the terminal back to the CLI once the script has exited.
+func setForeground(cmd *exec.Cmd, stdin io.Reader) func() {
+	tty, ok := stdin.(*os.File)
+	if !ok {
+		return func() {}
+	}
+
+	fd := int(tty.Fd())
+
+	foreground, err := unix.IoctlGetInt(fd, unix.TIOCGPGRP)
+	if err != nil || foreground != syscall.Getpgrp() {
+		return func() {}
+	}
+
+	cmd.SysProcAttr.Foreground = true
+	cmd.SysProcAttr.Ctty = fd
+
+	return func() {
+		// Until it has the terminal back, the CLI is in the background,
+		// where taking the terminal would stop it with SIGTTOU
+		signal.Ignore(syscall.SIGTTOU)
+		defer signal.Reset(syscall.SIGTTOU)
+
+		_ = unix.IoctlSetPointerInt(fd, unix.TIOCSPGRP, foreground)
+	}
+}
+
+// exitedOnInterrupt reports whether a script was stopped by SIGINT, or
+// exited with the code shells use for it
+func exitedOnInterrupt(state *os.ProcessState) bool {
+	if status, ok := state.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+		return status.Signal() == syscall.SIGINT
+	}
+
+	return state.ExitCode() == exitCodeInterrupted
+}
+
+func (g *processGroup) interrupt() error {
+	return g.signal(syscall.SIGINT)
+}
+
+func (g *processGroup) kill() error {
+	return g.signal(syscall.SIGKILL)
+}
+
+func (g *processGroup) release() {}
+
+// signal sends sig to every process in the group. A group whose processes
+// have all exited is not an error.
+func (g *processGroup) signal(sig syscall.Signal) error {
+	err := syscall.Kill(-g.pgid, sig)
+	if errors.Is(err, syscall.ESRCH) {
+		return nil
+	}
+
+	return err
+}
@@ -0,0 +1,229 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !windows
+
+package start
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readPIDs reads the process IDs a test script wrote to path, one per line
+func readPIDs(t *testing.T, path string) []int {
+	t.Helper()
+
+	var pids []int
+
+	require.Eventually(t, func() bool {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return false
+		}
+
+		pids = nil
+
+		for _, field := range strings.Fields(string(data)) {
+			pid, err := strconv.Atoi(field)
+			require.NoError(t, err)
+
+			pids = append(pids, pid)
+		}
+
+		return len(pids) == 2
+	}, 5*time.Second, 10*time.Millisecond)
+
+	return pids
+}
+
+// processGone reports whether pid has exited. A zombie waiting to be reaped
+// by an init that never does so counts as exited.
+func processGone(pid int) bool {
+	if err := syscall.Kill(pid, 0); errors.Is(err, syscall.ESRCH) {
+		return true
+	}
+
+	stat, err := os.ReadFile(filepath.Join("/proc", strconv.Itoa(pid), "stat"))
+	if err != nil {
+		return false
+	}
+
+	// The state follows the command name, which is in parentheses
+	fields := strings.Fields(string(stat[strings.LastIndexByte(string(stat), ')')+1:]))
+
+	return len(fields) > 0 && fields[0] == "Z"
+}
+
+// forkingScript starts a child that forks a grandchild, both in the
+// background with their output redirected, as a script that launches a dev
+// server would. A non-interactive shell starts background jobs ignoring
+// SIGINT, so only signalling the whole group stops them.
+func forkingScript(pidFile string) string {
+	return "sh -c 'sleep 30 & echo $$ $! > \"$1\"; wait' sh " + pidFile + " >/dev/null 2>&1 &\n" +
+		"echo started\nsleep 30\n"
+}
+
+func TestScriptInterruptStopsGrandchildren(t *testing.T) {
+	pidFile := filepath.Join(t.TempDir(), "pids")
+	script := writeTestScript(t, forkingScript(pidFile))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	result := make(chan error, 1)
+
+	go func() { result <- runScriptWithTimeout(ctx, exec.Command(script), 0, interruptGrace) }()
+
+	pids := readPIDs(t, pidFile)
+
+	cancel()
+	require.ErrorIs(t, <-result, errRunInterrupted)
+
+	for _, pid := range pids {
+		assert.Eventually(t, func() bool { return processGone(pid) }, 5*time.Second, 10*time.Millisecond, "process %d is still running", pid)
+	}
+}
+
+func TestScriptStoppedByCtrlCStopsGrandchildren(t *testing.T) {
+	pidFile := filepath.Join(t.TempDir(), "pids")
+
+	// The background processes ignore SIGINT, as they would in a script
+	// stopped by Ctrl-C
+	script := writeTestScript(t, strings.Replace(forkingScript(pidFile), "echo started\nsleep 30\n",
+		"while [ ! -s "+pidFile+" ]; do sleep 0.01; done\nkill -INT $$\nsleep 30\n", 1))
+
+	err := runScriptWithTimeout(context.Background(), exec.Command(script), 0, interruptGrace)
+	require.ErrorIs(t, err, errRunInterrupted)
+
+	for _, pid := range readPIDs(t, pidFile) {
+		assert.Eventually(t, func() bool { return processGone(pid) }, 5*time.Second, 10*time.Millisecond, "process %d is still running", pid)
+	}
+}
+
+func TestScriptExitLeavesBackgroundProcesses(t *testing.T) {
+	pidFile := filepath.Join(t.TempDir(), "pids")
+	script := writeTestScript(t, "sh -c 'sleep 30 & echo $$ $! > \"$1\"; wait' sh "+pidFile+" >/dev/null 2>&1 &\n"+
+		"while [ ! -s "+pidFile+" ]; do sleep 0.01; done\n")
+
+	require.NoError(t, runScriptWithTimeout(context.Background(), exec.Command(script), 0, interruptGrace))
+
+	pids := readPIDs(t, pidFile)
+
+	t.Cleanup(func() {
+		for _, pid := range pids {
+			_ = syscall.Kill(pid, syscall.SIGKILL)
+		}
+	})
+
+	for _, pid := range pids {
+		assert.False(t, processGone(pid), "process %d was stopped", pid)
+	}
+}
+
+func TestHeadlessScriptTimeoutStopsChildren(t *testing.T) {
+	dir := t.TempDir()
+	pidFile := filepath.Join(dir, "pids")
+
+	script := filepath.Join(dir, "quickstart.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\n"+forkingScript(pidFile)), 0o755))
+
+	m := NewStartModel(Options{
+		Action:           ActionExecuteScript,
+		QuickstartScript: script,
+		AnswerYes:        true,
+		TimeoutPerStep:   time.Second,
+	})
+
+	err := m.runStepsHeadless(&startResult{})
+	require.EqualError(t, err, "Quickstart script timed out after 1s.")
+
+	for _, pid := range readPIDs(t, pidFile) {
+		assert.Eventually(t, func() bool { return processGone(pid) }, 5*time.Second, 10*time.Millisecond, "process %d is still running", pid)
+	}
+}
+
+func TestHeadlessScriptCancelStopsChildren(t *testing.T) {
+	dir := t.TempDir()
+	pidFile := filepath.Join(dir, "pids")
+
+	script := filepath.Join(dir, "quickstart.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\n"+forkingScript(pidFile)), 0o755))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	m := NewStartModel(Options{
+		Action:           ActionExecuteScript,
+		QuickstartScript: script,
+		AnswerYes:        true,
+	})
+	m.runCtx = ctx
+
+	result := make(chan error, 1)
+
+	go func() { result <- m.runStepsHeadless(&startResult{}) }()
+
+	pids := readPIDs(t, pidFile)
+
+	cancel()
+
+	select {
+	case err := <-result:
+		require.ErrorIs(t, err, errRunInterrupted)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the run did not stop after it was cancelled")
+	}
+
+	for _, pid := range pids {
+		assert.Eventually(t, func() bool { return processGone(pid) }, 5*time.Second, 10*time.Millisecond, "process %d is still running", pid)
+	}
+}
+
+func TestRunScriptKillsAfterGrace(t *testing.T) {
+	ready := filepath.Join(t.TempDir(), "ready")
+	script := writeTestScript(t, "trap '' INT\ntouch "+ready+"\nsleep 30\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	go func() {
+		for {
+			if _, err := os.Stat(ready); err == nil {
+				cancel()
+
+				return
+			}
+
+			time.Sleep(10 * time.Millisecond)
+		}
+	}()
+
+	start := time.Now()
+
+	err := runScriptWithTimeout(ctx, exec.Command(script), 0, 200*time.Millisecond)
+	require.ErrorIs(t, err, errRunInterrupted)
+	assert.Less(t, time.Since(start), interruptGrace)
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"syscall"
+
+	"golang.org/x/sys/windows"
+)
+
+// processGroup is the job object holding a script and every process it
+// starts, so the whole tree can be terminated at once. Without a job, only
+// the script itself can be stopped.
+type processGroup struct {
+	mu      sync.Mutex
+	process *os.Process
+	job     windows.Handle
+}
+
+// setProcessGroup starts cmd in a new process group, so console control
+// events for the CLI are not delivered to it
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+}
+
+// setForeground does nothing on Windows, where a script in its own process
+// group reads from the console all the same. Ctrl-C reaches the CLI, which
+// passes it on as Ctrl-Break.
+func setForeground(_ *exec.Cmd, _ io.Reader) func() {
+	return func() {}
+}
+
+// exitedOnInterrupt reports whether a script exited because of Ctrl-C or
+// Ctrl-Break
+func exitedOnInterrupt(state *os.ProcessState) bool {
+	return uint32(state.ExitCode()) == uint32(windows.STATUS_CONTROL_C_EXIT)
+}
+
+// newProcessGroup assigns process to a new job object. Processes it starts
+// from then on join the job too.
+func newProcessGroup(process *os.Process) (*processGroup, error) {
+	g := &processGroup{process: process}
+
+	job, err := windows.CreateJobObject(nil, nil)
+	if err != nil {
+		return g, err
+	}
+
+	handle, err := windows.OpenProcess(windows.PROCESS_SET_QUOTA|windows.PROCESS_TERMINATE, false, uint32(process.Pid))
+	if err != nil {
+		_ = windows.CloseHandle(job)
+
+		return g, err
+	}
+
+	defer windows.CloseHandle(handle)
+
+	if err := windows.AssignProcessToJobObject(job, handle); err != nil {
+		_ = windows.CloseHandle(job)
+
+		return g, err
+	}
+
+	g.job = job
+
+	return g, nil
+}
+
+// interrupt sends Ctrl-Break to the script's process group, the console
+// event Windows can deliver to another group. The group is terminated if
+// the event cannot be sent.
+func (g *processGroup) interrupt() error {
+	if err := windows.GenerateConsoleCtrlEvent(windows.CTRL_BREAK_EVENT, uint32(g.process.Pid)); err != nil {
+		return g.kill()
+	}
+
+	return nil
+}
+
+func (g *processGroup) kill() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.job == 0 {
+		return g.process.Kill()
+	}
+
+	return windows.TerminateJobObject(g.job, 1)
+}
+
+// release closes the job. Processes still in it keep running.
+func (g *processGroup) release() {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.job != 0 {
+		_ = windows.CloseHandle(g.job)
+		g.job = 0
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/datarobot/cli/internal/log"
+	"github.com/spf13/pflag"
+)
+
+// ProgressFormat selects how 'dr start' reports its progress
+type ProgressFormat string
+
+var _ pflag.Value = (*ProgressFormat)(nil)
+
+const (
+	// ProgressFormatTUI shows the interactive terminal UI
+	ProgressFormatTUI ProgressFormat = "tui"
+	// ProgressFormatJSON writes one progressEvent per line to stdout
+	ProgressFormatJSON ProgressFormat = "json"
+)
+
+// ProgressFormats lists the valid progress formats, for help and completion
+var ProgressFormats = []string{
+	string(ProgressFormatTUI),
+	string(ProgressFormatJSON),
+}
+
+func (f *ProgressFormat) String() string {
+	if f == nil {
+		return ""
+	}
+
+	return string(*f)
+}
+
+func (f *ProgressFormat) Set(s string) error {
+	for _, format := range ProgressFormats {
+		if s == format {
+			*f = ProgressFormat(s)
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Invalid progress format %q (must be one of: %s).", s, strings.Join(ProgressFormats, ", "))
+}
+
+// Type is used by the shell completion generator
+func (f *ProgressFormat) Type() string {
+	return "start.ProgressFormat"
+}
+
+// progressEvent reports a finished quickstart step. Its field names are a
+// stable interface for tools wrapping the CLI and are documented in
+// docs/commands/start.md; only add fields, never rename or remove them.
+type progressEvent struct {
+	Step      string    `json:"step"`
+	Index     int       `json:"index"`
+	Status    string    `json:"status"`
+	Message   string    `json:"message,omitempty"`
+	Done      bool      `json:"done"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// progressNow is stubbed in tests to make timestamps predictable
+var progressNow = time.Now
+
+// emitProgress writes the event for a finished step when progress events
+// were requested. done marks the last event of the run.
+func (m *Model) emitProgress(step stepResult, done bool) {
+	if m.progress == nil {
+		return
+	}
+
+	event := progressEvent{
+		Step:      step.Description,
+		Index:     m.current,
+		Status:    step.Status,
+		Message:   strings.TrimSpace(step.Message),
+		Done:      done,
+		Timestamp: progressNow().UTC(),
+	}
+
+	if err := m.progress.Encode(event); err != nil {
+		log.Debug("Failed to write progress event", "error", err)
+	}
+}
+
+// newProgressEncoder returns the encoder progress events are written with
+func newProgressEncoder(w io.Writer) *json.Encoder {
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+
+	return encoder
+}
@@ -0,0 +1,84 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func runWithProgress(t *testing.T, exitCode string) (string, error) {
+	t.Helper()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell script")
+	}
+
+	progressNow = func() time.Time { return time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC) }
+
+	t.Cleanup(func() { progressNow = time.Now })
+
+	script := filepath.Join(t.TempDir(), "quickstart.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\nexit "+exitCode+"\n"), 0o755))
+
+	var out bytes.Buffer
+
+	m := NewStartModel(Options{Action: ActionExecuteScript, QuickstartScript: script, AnswerYes: true})
+	m.progress = newProgressEncoder(&out)
+
+	err := m.runStepsHeadless(&startResult{})
+
+	return out.String(), err
+}
+
+func TestProgressEvents(t *testing.T) {
+	out, err := runWithProgress(t, "0")
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(out), "\n")
+	require.Len(t, lines, 1)
+	assert.True(t, strings.HasPrefix(lines[0], `{"step":"Finding and executing start command...","index":0,"status":"completed","message":"Running quickstart script: `))
+	assert.True(t, strings.HasSuffix(lines[0], `.sh","done":true,"timestamp":"2025-06-01T12:00:00Z"}`))
+}
+
+func TestProgressEventsReportFailure(t *testing.T) {
+	out, err := runWithProgress(t, "42")
+	require.Error(t, err)
+
+	assert.JSONEq(t, `{
+		"step": "Finding and executing start command...",
+		"index": 0,
+		"status": "failed",
+		"message": "Quickstart script exited with code 42.",
+		"done": true,
+		"timestamp": "2025-06-01T12:00:00Z"
+	}`, out)
+}
+
+func TestProgressFormatSet(t *testing.T) {
+	var format ProgressFormat
+
+	require.NoError(t, format.Set("json"))
+	assert.Equal(t, ProgressFormatJSON, format)
+	assert.ErrorContains(t, format.Set("xml"), "must be one of: tui, json")
+}
@@ -0,0 +1,116 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/datarobot/cli/internal/errs"
+	"github.com/datarobot/cli/internal/log"
+)
+
+// interruptGrace is how long a script may take to exit after being
+// interrupted before its process group is killed
+const interruptGrace = 5 * time.Second
+
+// scriptExec runs the quickstart script as a tea.ExecCommand: the TUI
+// releases the terminal to the script, which reads from it in the
+// foreground, and takes it back once the script has exited
+type scriptExec struct {
+	ctx     context.Context
+	cmd     *exec.Cmd
+	timeout time.Duration
+	stdout  io.Writer
+}
+
+var _ tea.ExecCommand = (*scriptExec)(nil)
+
+func (s *scriptExec) SetStdin(r io.Reader) {
+	s.cmd.Stdin = r
+}
+
+func (s *scriptExec) SetStdout(w io.Writer) {
+	s.stdout = w
+}
+
+// SetStderr is ignored: the script's stderr goes to the terminal along
+// with its stdout, so the two stay in order
+func (s *scriptExec) SetStderr(io.Writer) {}
+
+// Run runs the script with its secrets masked on the terminal and its
+// output recorded in the transcript
+func (s *scriptExec) Run() error {
+	terminal := log.RedactLines(s.stdout)
+	defer terminal.Close()
+
+	transcript := log.OutputWriter("script")
+	defer transcript.Close()
+
+	s.cmd.Stdout = io.MultiWriter(terminal, transcript)
+	s.cmd.Stderr = s.cmd.Stdout
+
+	return runScriptWithTimeout(s.ctx, s.cmd, s.timeout, interruptGrace)
+}
+
+// exited completes the step once the script has exited with err, keeping
+// its exit code: -1 if it did not start or was stopped by a signal
+func (s *scriptExec) exited(err error) tea.Msg {
+	exitCode := -1
+	if s.cmd.ProcessState != nil {
+		exitCode = s.cmd.ProcessState.ExitCode()
+	}
+
+	return stepCompleteMsg{scriptExited: true, exitCode: exitCode, scriptErr: err}
+}
+
+// scriptFailure turns the error from running a script into the error
+// reported to the user, keeping the exit code when the script set one
+func scriptFailure(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var timeout *stepTimeoutError
+	if errors.As(err, &timeout) || errors.Is(err, errRunInterrupted) {
+		return err
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() > 0 {
+		code := exitErr.ExitCode()
+
+		return errs.NewScriptError(code, fmt.Sprintf("Quickstart script exited with code %d.", code))
+	}
+
+	return fmt.Errorf("Quickstart script failed: %w", err)
+}
+
+// failureExitCode returns the exit code for a failed run. A script's own
+// exit code is only used with propagate set; without it a failing script
+// exits with 1.
+func failureExitCode(err error, propagate bool) int {
+	var scriptErr *errs.ScriptError
+	if !propagate && errors.As(err, &scriptErr) {
+		return errs.ExitCodeFailure
+	}
+
+	return errs.ExitCode(err)
+}
@@ -0,0 +1,210 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeTestScript writes a POSIX shell script with body and returns its path
+func writeTestScript(t *testing.T, body string) string {
+	t.Helper()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell script")
+	}
+
+	path := filepath.Join(t.TempDir(), "quickstart.sh")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"+body), 0o755))
+
+	return path
+}
+
+// terminal stands in for the terminal tea.Exec hands the script; the
+// script's output arrives from RedactLines while the test reads it
+type terminal struct {
+	mu  sync.Mutex
+	out bytes.Buffer
+}
+
+func (t *terminal) Write(p []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.out.Write(p)
+}
+
+func (t *terminal) String() string {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.out.String()
+}
+
+// runScriptModel runs the model's quickstart script as tea.Exec would,
+// with stdin and out as the terminal, and passes the result to the model
+func runScriptModel(t *testing.T, m Model, stdin io.Reader, out io.Writer) (Model, tea.Cmd) {
+	t.Helper()
+
+	script, err := m.quickstartExec()
+	require.NoError(t, err)
+
+	script.SetStdin(stdin)
+	script.SetStdout(out)
+	script.SetStderr(out)
+
+	next, cmd := m.Update(script.exited(script.Run()))
+
+	return next.(Model), cmd
+}
+
+func TestScriptOutputGoesToTerminal(t *testing.T) {
+	path := writeTestScript(t, "printf '\\033[32mready\\033[0m\\n'\necho oops >&2\n")
+
+	var out terminal
+
+	m, cmd := runScriptModel(t, Model{quickstartScriptPath: path}, nil, &out)
+
+	assert.Equal(t, "\x1b[32mready\x1b[0m\noops\n", out.String(), "colors are kept and stderr is shown too")
+	assert.Equal(t, 0, m.exitCode)
+	assert.True(t, m.done)
+	require.NoError(t, m.err)
+	assert.IsType(t, tea.QuitMsg{}, cmd())
+}
+
+func TestScriptReadsFromTerminal(t *testing.T) {
+	path := writeTestScript(t, "printf 'Name? '\nread name\necho \"hello $name\"\n")
+
+	// An *os.File, like the terminal, which exec passes on without copying
+	stdin, answer, err := os.Pipe()
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		_ = stdin.Close()
+		_ = answer.Close()
+	})
+
+	var out terminal
+
+	done := make(chan Model, 1)
+
+	go func() {
+		m, _ := runScriptModel(t, Model{quickstartScriptPath: path}, stdin, &out)
+		done <- m
+	}()
+
+	// The prompt is shown before the script gets its answer
+	require.Eventually(t, func() bool { return out.String() == "Name? " }, 5*time.Second, 10*time.Millisecond)
+
+	_, err = io.WriteString(answer, "dr\n")
+	require.NoError(t, err)
+
+	select {
+	case m := <-done:
+		assert.Equal(t, "Name? hello dr\n", out.String())
+		assert.True(t, m.done)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the script did not finish after reading its answer")
+	}
+}
+
+func TestScriptExitCodeIsReported(t *testing.T) {
+	path := writeTestScript(t, "echo failing\nexit 42\n")
+
+	m, _ := runScriptModel(t, Model{steps: stepsForAction(ActionExecuteScript), quickstartScriptPath: path}, nil, io.Discard)
+
+	assert.Equal(t, 42, m.exitCode)
+	require.Error(t, m.err)
+	assert.Contains(t, m.err.Error(), "exited with code 42")
+	assert.False(t, m.done)
+	assert.Contains(t, m.View(), "✗")
+	assert.Contains(t, m.View(), "(exit code 42)")
+
+	assert.Equal(t, 42, failureExitCode(m.err, true))
+	assert.Equal(t, 1, failureExitCode(m.err, false))
+}
+
+func TestScriptStoppedByCtrlCInterruptsRun(t *testing.T) {
+	// Ctrl-C on the terminal sends SIGINT to the script's process group
+	path := writeTestScript(t, "echo started\nkill -INT $$\nsleep 30\necho finished\n")
+
+	var out terminal
+
+	m, cmd := runScriptModel(t, Model{quickstartScriptPath: path}, nil, &out)
+
+	assert.Equal(t, "started\n", out.String())
+	assert.True(t, m.interrupted)
+	assert.True(t, m.quitting)
+	require.NoError(t, m.err)
+	assert.IsType(t, tea.QuitMsg{}, cmd())
+}
+
+func TestCancelledRunInterruptsScript(t *testing.T) {
+	path := writeTestScript(t, "echo started\nsleep 30\necho finished\n")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	var out terminal
+
+	go func() {
+		for !strings.Contains(out.String(), "started") {
+			time.Sleep(10 * time.Millisecond)
+		}
+
+		cancel()
+	}()
+
+	start := time.Now()
+
+	m, _ := runScriptModel(t, Model{quickstartScriptPath: path, runCtx: ctx}, nil, &out)
+
+	assert.Less(t, time.Since(start), interruptGrace)
+	assert.NotContains(t, out.String(), "finished")
+	assert.True(t, m.interrupted)
+}
+
+func TestInterruptWithoutScriptQuits(t *testing.T) {
+	next, cmd := Model{}.Interrupt()
+
+	result, ok := next.(Model)
+	require.True(t, ok)
+	assert.True(t, result.interrupted)
+	assert.IsType(t, tea.QuitMsg{}, cmd())
+}
+
+func TestWaitingStepWithAssumeYesRunsScript(t *testing.T) {
+	m := Model{opts: Options{AnswerYes: true}}
+
+	next, cmd := m.handleStepComplete(stepCompleteMsg{waiting: true, quickstartScriptPath: "quickstart.sh"})
+	m = next.(Model)
+
+	assert.False(t, m.waitingToExecute)
+	assert.NoError(t, m.err)
+	assert.NotNil(t, cmd)
+}
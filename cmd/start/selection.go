@@ -0,0 +1,250 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/internal/update"
+	"github.com/datarobot/cli/tui"
+)
+
+// Steps that can be chosen with --select or the --action select menu
+const (
+	selectUpdate     = "update"
+	selectTemplate   = "template"
+	selectQuickstart = "quickstart"
+)
+
+// selectableStep is an entry in the --action select menu
+type selectableStep struct {
+	name        string
+	description string
+	action      Action
+}
+
+// selectableSteps are the steps that can be chosen, in the order they run
+var selectableSteps = []selectableStep{
+	{selectUpdate, "Update the DataRobot CLI", ActionSelfUpdate},
+	{selectTemplate, "Set up a template", ActionTemplateSetup},
+	{selectQuickstart, "Run the quickstart", ActionQuickstart},
+}
+
+// selectNames lists the names --select accepts, for help and completion
+func selectNames() []string {
+	names := make([]string, 0, len(selectableSteps))
+
+	for _, s := range selectableSteps {
+		names = append(names, s.name)
+	}
+
+	return names
+}
+
+// resolveSelection checks the steps chosen with --select or the menu and
+// returns them in the order they run, without duplicates
+func resolveSelection(names []string, opts Options) ([]string, error) {
+	for _, name := range names {
+		if !slices.Contains(selectNames(), name) {
+			return nil, fmt.Errorf("Invalid step %q in --select (must be one of: %s).", name, strings.Join(selectNames(), ", "))
+		}
+	}
+
+	selected := make([]string, 0, len(names))
+
+	for _, s := range selectableSteps {
+		if slices.Contains(names, s.name) {
+			selected = append(selected, s.name)
+		}
+	}
+
+	if len(selected) == 0 {
+		return nil, errors.New("Select at least one step to run.")
+	}
+
+	if slices.Contains(selected, selectUpdate) && update.SelfUpdateDisabled() {
+		return nil, update.ErrSelfUpdateDisabled
+	}
+
+	// The other steps don't run the quickstart script
+	if !slices.Contains(selected, selectQuickstart) {
+		if len(opts.ScriptArgs) > 0 {
+			return nil, errors.New("Arguments after -- are passed to the quickstart script, which runs only when quickstart is selected.")
+		}
+
+		if opts.QuickstartScript != "" {
+			return nil, errors.New("--quickstart-script only applies when quickstart is selected.")
+		}
+	}
+
+	return selected, nil
+}
+
+// stepsForSelection returns the steps of each selected entry, in order.
+// Once the CLI has been updated, the quickstart doesn't check its version.
+func stepsForSelection(selected []string) []step {
+	var steps []step
+
+	for _, s := range selectableSteps {
+		if !slices.Contains(selected, s.name) {
+			continue
+		}
+
+		actionSteps := stepsForAction(s.action)
+		if s.action == ActionQuickstart {
+			actionSteps = quickstartSteps(!slices.Contains(selected, selectUpdate))
+		}
+
+		for _, st := range actionSteps {
+			st.selected = s.name
+			steps = append(steps, st)
+		}
+	}
+
+	return steps
+}
+
+// sessionAction is what a saved session is kept under. A selection is
+// resumed only by a run with the same selection.
+func sessionAction(opts Options) Action {
+	if len(opts.Select) > 0 {
+		return Action(string(ActionSelect) + ":" + strings.Join(opts.Select, ","))
+	}
+
+	return opts.Action
+}
+
+// checkSelectMenu fails when the --action select menu can't be shown
+func checkSelectMenu(opts Options) error {
+	if opts.Action != ActionSelect || len(opts.Select) > 0 {
+		return nil
+	}
+
+	if opts.Resume {
+		return errors.New("--resume needs the steps to resume. Pass them with --select, e.g. --select update,template.")
+	}
+
+	if opts.ListSteps || printer.IsStructured() || opts.ProgressFormat == ProgressFormatJSON || tui.Headless() {
+		return errors.New("--action select asks which steps to run, which needs a terminal. Pass them with --select instead, e.g. --select update,template.")
+	}
+
+	return nil
+}
+
+// selectionContinues reports whether selected steps follow the current
+// one, so finishing it, such as with a self update, doesn't end the run
+func (m Model) selectionContinues() bool {
+	return len(m.opts.Select) > 0 && m.current < len(m.steps)-1
+}
+
+// remainingSelection returns the selected entries still to run once the
+// run has stopped for the interactive template setup: the one containing
+// the current step, unless that was the template setup itself, and those
+// after it
+func (m Model) remainingSelection() []string {
+	if m.current >= len(m.steps) {
+		return nil
+	}
+
+	name := m.steps[m.current].selected
+
+	i := slices.Index(m.opts.Select, name)
+	if i < 0 {
+		return nil
+	}
+
+	if name == selectTemplate {
+		i++
+	}
+
+	return m.opts.Select[i:]
+}
+
+func (m Model) handleStepMenuKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if m.stepMenuCursor > 0 {
+			m.stepMenuCursor--
+		}
+	case "down", "j":
+		if m.stepMenuCursor < len(selectableSteps)-1 {
+			m.stepMenuCursor++
+		}
+	case " ", "x":
+		name := selectableSteps[m.stepMenuCursor].name
+
+		if i := slices.Index(m.stepMenuTicked, name); i >= 0 {
+			m.stepMenuTicked = slices.Delete(m.stepMenuTicked, i, i+1)
+		} else {
+			m.stepMenuTicked = append(m.stepMenuTicked, name)
+		}
+
+		m.stepCompleteMessage = ""
+	case "enter":
+		return m.runSelection()
+	case "q", "esc":
+		m.quitting = true
+
+		return m, tea.Quit
+	}
+
+	return m, nil
+}
+
+// runSelection starts the steps ticked in the menu. An invalid choice is
+// explained and the menu stays open.
+func (m Model) runSelection() (tea.Model, tea.Cmd) {
+	selected, err := resolveSelection(m.stepMenuTicked, m.opts)
+	if err != nil {
+		m.stepCompleteMessage = tui.ErrorStyle.Render(err.Error()) + "\n"
+
+		return m, nil
+	}
+
+	m.opts.Select = selected
+	m.steps = stepsForSelection(selected)
+	m.session = newSession(sessionAction(m.opts))
+	m.choosingSteps = false
+	m.stepCompleteMessage = ""
+
+	return m, m.executeCurrentStep()
+}
+
+// stepMenuView renders the selectable steps with their checkboxes
+func (m Model) stepMenuView() string {
+	var sb strings.Builder
+
+	for i, s := range selectableSteps {
+		box := "[ ]"
+		if slices.Contains(m.stepMenuTicked, s.name) {
+			box = "[" + checkMark.String() + "]"
+		}
+
+		line := fmt.Sprintf("%s %s %s", box, s.description, tui.DimStyle.Render("("+s.name+")"))
+
+		if i == m.stepMenuCursor {
+			sb.WriteString(fmt.Sprintf("  %s %s\n", arrow, line))
+		} else {
+			sb.WriteString(fmt.Sprintf("    %s\n", line))
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,142 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveSelection(t *testing.T) {
+	selected, err := resolveSelection([]string{"quickstart", "update", "quickstart"}, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{selectUpdate, selectQuickstart}, selected, "steps run in their fixed order, once")
+
+	_, err = resolveSelection([]string{"deploy"}, Options{})
+	require.EqualError(t, err, `Invalid step "deploy" in --select (must be one of: update, template, quickstart).`)
+
+	_, err = resolveSelection(nil, Options{})
+	require.EqualError(t, err, "Select at least one step to run.")
+
+	_, err = resolveSelection([]string{"update", "template"}, Options{ScriptArgs: []string{"--env", "staging"}})
+	require.ErrorContains(t, err, "runs only when quickstart is selected")
+
+	_, err = resolveSelection([]string{"template"}, Options{QuickstartScript: "start.sh"})
+	require.ErrorContains(t, err, "--quickstart-script only applies when quickstart is selected")
+
+	_, err = resolveSelection([]string{"template", "quickstart"}, Options{QuickstartScript: "start.sh"})
+	require.NoError(t, err)
+}
+
+func TestStepsForSelection(t *testing.T) {
+	steps := stepsForSelection([]string{selectTemplate, selectQuickstart})
+	require.Len(t, steps, 1+len(quickstartSteps(true)))
+	assert.Equal(t, selectTemplate, steps[0].selected)
+	assert.Equal(t, selectQuickstart, steps[len(steps)-1].selected)
+
+	// A selected update makes the quickstart's version check redundant
+	steps = stepsForSelection([]string{selectUpdate, selectQuickstart})
+	require.Len(t, steps, 1+len(quickstartSteps(false)))
+
+	for _, s := range steps {
+		assert.NotEqual(t, "Checking DataRobot CLI version...", s.description)
+	}
+}
+
+func TestSelectionFinishesUpdateWithoutEndingTheRun(t *testing.T) {
+	testutil.SetTestHomeDir(t, t.TempDir())
+
+	m := NewStartModel(Options{Select: []string{selectUpdate, selectTemplate}})
+	assert.True(t, m.selectionContinues())
+
+	m.current = len(m.steps) - 1
+	assert.False(t, m.selectionContinues())
+
+	assert.False(t, NewStartModel(Options{Action: ActionSelfUpdate}).selectionContinues())
+}
+
+func TestRemainingSelection(t *testing.T) {
+	testutil.SetTestHomeDir(t, t.TempDir())
+
+	m := NewStartModel(Options{Select: []string{selectUpdate, selectTemplate, selectQuickstart}})
+
+	m.current = 1
+	assert.Equal(t, []string{selectQuickstart}, m.remainingSelection(), "the template setup itself is done")
+
+	m.current = len(m.steps) - 1
+	assert.Equal(t, []string{selectQuickstart}, m.remainingSelection(), "the quickstart runs again in the cloned template")
+}
+
+func TestSessionAction(t *testing.T) {
+	assert.Equal(t, ActionQuickstart, sessionAction(Options{Action: ActionQuickstart}))
+	assert.Equal(t, Action("select:update,template"), sessionAction(Options{Select: []string{selectUpdate, selectTemplate}}))
+}
+
+func TestCheckSelectMenu(t *testing.T) {
+	require.NoError(t, checkSelectMenu(Options{Action: ActionQuickstart, ListSteps: true}))
+	require.NoError(t, checkSelectMenu(Options{Action: ActionSelect, Select: []string{selectUpdate}, ListSteps: true}))
+
+	require.ErrorContains(t, checkSelectMenu(Options{Action: ActionSelect, ListSteps: true}), "needs a terminal")
+	require.ErrorContains(t, checkSelectMenu(Options{Action: ActionSelect, ProgressFormat: ProgressFormatJSON}), "needs a terminal")
+	require.ErrorContains(t, checkSelectMenu(Options{Action: ActionSelect, Resume: true}), "--resume needs the steps")
+}
+
+func TestStepMenu(t *testing.T) {
+	testutil.SetTestHomeDir(t, t.TempDir())
+
+	m := NewStartModel(Options{Action: ActionSelect})
+	require.True(t, m.choosingSteps)
+	assert.Empty(t, m.steps)
+	assert.Nil(t, m.Init())
+	assert.Contains(t, m.View(), "[ ] Update the DataRobot CLI")
+
+	enter := func(m Model) (Model, tea.Cmd) {
+		updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+		next, ok := updated.(Model)
+		require.True(t, ok)
+
+		return next, cmd
+	}
+
+	// Nothing ticked yet
+	m, cmd := enter(m)
+	assert.Nil(t, cmd)
+	assert.True(t, m.choosingSteps)
+	assert.Contains(t, m.View(), "Select at least one step to run.")
+
+	// Tick quickstart, then template; they still run in menu order
+	m = pressKey(t, m, "j")
+	m = pressKey(t, m, "j")
+	m = pressKey(t, m, " ")
+	m = pressKey(t, m, "k")
+	m = pressKey(t, m, " ")
+	assert.Contains(t, m.View(), "[✓] Set up a template")
+
+	// Ticking again unticks
+	m = pressKey(t, m, "x")
+	m = pressKey(t, m, "x")
+
+	m, cmd = enter(m)
+	require.NotNil(t, cmd)
+	assert.False(t, m.choosingSteps)
+	assert.Equal(t, []string{selectTemplate, selectQuickstart}, m.opts.Select)
+	assert.Len(t, m.steps, 1+len(quickstartSteps(true)))
+	assert.Equal(t, Action("select:template,quickstart"), m.session.Action)
+}
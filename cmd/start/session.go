@@ -0,0 +1,192 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/datarobot/cli/internal/cache"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/log"
+)
+
+const sessionsDir = cache.SessionsDir
+
+// session records which steps of a run have completed in a directory, so
+// an interrupted run can continue where it stopped with --resume
+type session struct {
+	Dir       string    `json:"dir"`
+	Action    Action    `json:"action"`
+	Completed []string  `json:"completed"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+var sessionNow = time.Now
+
+// newSession returns an empty session for the current working directory
+func newSession(action Action) session {
+	dir, err := os.Getwd()
+	if err != nil {
+		log.Debug("start: cannot determine working directory for session", "error", err)
+	}
+
+	return session{Dir: dir, Action: action}
+}
+
+// has reports whether the step with the given description has completed
+func (s session) has(description string) bool {
+	return slices.Contains(s.Completed, description)
+}
+
+// resumeIndex returns the index of the first step that has not completed.
+// The last step always runs, since finishing it ends the session.
+func (s session) resumeIndex(steps []step) int {
+	for i, st := range steps {
+		if i == len(steps)-1 || !s.has(st.description) {
+			return i
+		}
+	}
+
+	return 0
+}
+
+// sessionPath returns the file holding the session for dir. Directories
+// are hashed so any path maps to a valid file name.
+func sessionPath(dir string) (string, error) {
+	cacheDir, err := config.CacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256([]byte(dir))
+
+	return filepath.Join(cacheDir, sessionsDir, hex.EncodeToString(sum[:8])+".json"), nil
+}
+
+// loadSession returns the saved session for the current working directory,
+// if there is one for the same action
+func loadSession(action Action) (session, bool) {
+	current := newSession(action)
+
+	path, err := sessionPath(current.Dir)
+	if err != nil {
+		return current, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Debug("Failed to read start session", "error", err)
+		}
+
+		return current, false
+	}
+
+	var saved session
+
+	if err := json.Unmarshal(data, &saved); err != nil {
+		log.Debug("Ignoring malformed start session", "path", path, "error", err)
+
+		return current, false
+	}
+
+	if saved.Dir != current.Dir || saved.Action != action || len(saved.Completed) == 0 {
+		return current, false
+	}
+
+	return saved, true
+}
+
+// save persists the session. A failure only means the next --resume starts
+// over, so it is logged rather than returned.
+func (s session) save() {
+	if s.Dir == "" {
+		return
+	}
+
+	path, err := sessionPath(s.Dir)
+	if err != nil {
+		return
+	}
+
+	s.UpdatedAt = sessionNow().UTC()
+
+	data, err := json.Marshal(s)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		log.Debug("Failed to create session directory", "error", err)
+
+		return
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		log.Debug("Failed to write start session", "error", err)
+	}
+}
+
+// remove discards the saved session, once the run has finished or when
+// --restart is given
+func (s session) remove() {
+	if s.Dir == "" {
+		return
+	}
+
+	path, err := sessionPath(s.Dir)
+	if err != nil {
+		return
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Debug("Failed to remove start session", "error", err)
+	}
+}
+
+// recordStep marks the current step completed and saves the session
+func (m *Model) recordStep() {
+	if m.opts.DryRun || m.current >= len(m.steps) {
+		return
+	}
+
+	// A step that failed with --continue-on-error runs again on --resume
+	if _, failed := m.failedSteps[m.current]; failed {
+		return
+	}
+
+	description := m.currentStep().description
+	if !m.session.has(description) {
+		m.session.Completed = append(m.session.Completed, description)
+	}
+
+	m.session.save()
+}
+
+// finishSession discards the session after a successful run
+func (m *Model) finishSession() {
+	if m.opts.DryRun || len(m.failedSteps) > 0 {
+		return
+	}
+
+	m.session.remove()
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"testing"
+
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupSessionTest(t *testing.T) {
+	t.Helper()
+
+	testutil.SetTestHomeDir(t, t.TempDir())
+	t.Chdir(t.TempDir())
+}
+
+func TestResumeSkipsCompletedSteps(t *testing.T) {
+	setupSessionTest(t)
+
+	m := NewStartModel(Options{Action: ActionQuickstart})
+	m.recordStep()
+	m.current++
+	m.recordStep()
+
+	resumed := NewStartModel(Options{Action: ActionQuickstart, Resume: true})
+	assert.Equal(t, 2, resumed.resumed)
+	assert.Equal(t, 2, resumed.current)
+	assert.Contains(t, resumed.View(), "Checking DataRobot CLI version... (done in a previous run)")
+
+	fresh := NewStartModel(Options{Action: ActionQuickstart})
+	assert.Zero(t, fresh.current, "progress is only skipped with --resume")
+
+	other := NewStartModel(Options{Action: ActionExecuteScript, Resume: true})
+	assert.Zero(t, other.current, "sessions of other actions are ignored")
+}
+
+func TestResumeAlwaysRunsLastStep(t *testing.T) {
+	setupSessionTest(t)
+
+	m := NewStartModel(Options{Action: ActionQuickstart})
+	for m.current = 0; m.current < len(m.steps); m.current++ {
+		m.recordStep()
+	}
+
+	resumed := NewStartModel(Options{Action: ActionQuickstart, Resume: true})
+	assert.Equal(t, len(resumed.steps)-1, resumed.current)
+}
+
+func TestRestartAndFinishDiscardSession(t *testing.T) {
+	setupSessionTest(t)
+
+	m := NewStartModel(Options{Action: ActionQuickstart})
+	m.recordStep()
+
+	_, ok := loadSession(ActionQuickstart)
+	require.True(t, ok)
+
+	NewStartModel(Options{Action: ActionQuickstart, Restart: true})
+
+	_, ok = loadSession(ActionQuickstart)
+	assert.False(t, ok)
+
+	m.recordStep()
+	m.finishSession()
+
+	_, ok = loadSession(ActionQuickstart)
+	assert.False(t, ok)
+}
+
+func TestResumeWithoutSession(t *testing.T) {
+	setupSessionTest(t)
+
+	m := NewStartModel(Options{Action: ActionQuickstart, Resume: true})
+	assert.Zero(t, m.current)
+	assert.Contains(t, m.stepCompleteMessage, "No interrupted session to resume")
+}
@@ -0,0 +1,216 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/internal/repo"
+	"github.com/datarobot/cli/internal/tools"
+	"github.com/datarobot/cli/internal/update"
+	"github.com/datarobot/cli/internal/version"
+)
+
+// stepStatus says whether --list-steps expects a step to do its work
+type stepStatus string
+
+const (
+	stepEnabled stepStatus = "enabled"
+	stepSkipped stepStatus = "skipped"
+)
+
+// stepPreview is a step as reported by --list-steps
+type stepPreview struct {
+	Index  int        `json:"index"            yaml:"index"`
+	Step   string     `json:"step"             yaml:"step"`
+	Status stepStatus `json:"status"           yaml:"status"`
+	Reason string     `json:"reason,omitempty" yaml:"reason,omitempty"`
+}
+
+var previewColumns = []printer.Column[stepPreview]{
+	{Field: "index", Header: "#", Value: func(p stepPreview) string { return strconv.Itoa(p.Index) }},
+	{Field: "step", Header: "STEP", Value: func(p stepPreview) string { return p.Step }},
+	{Field: "status", Header: "STATUS", Value: func(p stepPreview) string { return string(p.Status) }},
+	{Field: "reason", Header: "REASON", Value: func(p stepPreview) string { return p.Reason }},
+}
+
+// listSteps prints the steps a run with opts would go through, without
+// running any of them
+func listSteps(w io.Writer, opts Options) error {
+	// Listing must not discard the progress --restart would
+	opts.Restart = false
+
+	m := NewStartModel(opts)
+	previews := m.previewSteps()
+
+	return printer.Print(w, previews, func(w io.Writer) error {
+		return printer.WriteTable(w, previewColumns, previews)
+	})
+}
+
+// previewSteps describes each of the model's steps. Steps without a
+// preview always run and have nothing to explain.
+func (m Model) previewSteps() []stepPreview {
+	previews := make([]stepPreview, len(m.steps))
+
+	for i, s := range m.steps {
+		preview := stepPreview{
+			Index:  i + 1,
+			Step:   strings.TrimSuffix(s.description, "..."),
+			Status: stepEnabled,
+		}
+
+		switch {
+		case i < m.resumed:
+			preview.Status = stepSkipped
+			preview.Reason = "Completed by the interrupted run being resumed."
+		case s.preview != nil:
+			preview.Status, preview.Reason = s.preview(&m)
+		}
+
+		previews[i] = preview
+	}
+
+	return previews
+}
+
+func previewSelfVersion(m *Model) (stepStatus, string) {
+	tool, err := tools.GetSelfRequirement()
+	if err != nil || tools.SufficientSelfVersion(tool.MinimumVersion) {
+		return stepEnabled, "The CLI meets the template's minimum version; no update is needed."
+	}
+
+	if update.SelfUpdateDisabled() {
+		return stepEnabled, fmt.Sprintf("The template requires v%s (installed: %s); self-update is disabled, so this is only reported.",
+			tool.MinimumVersion, version.Version)
+	}
+
+	action := "asks whether to update"
+
+	switch {
+	case m.opts.DryRun:
+		action = "with --dry-run the update is only shown"
+	case m.opts.AnswerYes:
+		action = "updates without asking (--yes)"
+	}
+
+	return stepEnabled, fmt.Sprintf("The template requires v%s (installed: %s); %s.",
+		tool.MinimumVersion, version.Version, action)
+}
+
+func previewPrerequisites(_ *Model) (stepStatus, string) {
+	if tools.MissingPrerequisites() != "" {
+		return stepEnabled, "Some required tools are missing or outdated; the run stops here."
+	}
+
+	return stepEnabled, "All required tools are installed."
+}
+
+func previewRepository(m *Model) (stepStatus, string) {
+	if repo.IsInRepo() {
+		return stepEnabled, "Already in a DataRobot repository; no template is set up."
+	}
+
+	reason := "Not in a DataRobot repository; asks which template to clone."
+	if m.opts.Template != "" {
+		reason = fmt.Sprintf("Not in a DataRobot repository; clones template %q.", m.opts.Template)
+	}
+
+	if m.opts.DryRun {
+		reason += " With --dry-run the template is only shown."
+	}
+
+	return stepEnabled, reason
+}
+
+func previewFindAndExecuteStart(m *Model) (stepStatus, string) {
+	if !repo.IsInRepo() && m.opts.QuickstartScript == "" {
+		return stepEnabled, "Detected in the cloned template once it is set up."
+	}
+
+	return m.previewStartCommand()
+}
+
+func previewExecuteScript(m *Model) (stepStatus, string) {
+	return m.previewStartCommand()
+}
+
+// previewStartCommand finds the start command the way findAndExecuteStart
+// does and describes how it would be run
+func (m *Model) previewStartCommand() (stepStatus, string) {
+	if m.opts.QuickstartScript != "" {
+		return stepEnabled, m.scriptReason(m.opts.QuickstartScript + " (from --quickstart-script)")
+	}
+
+	if hasTask, _ := hasTaskStart(); hasTask {
+		return stepEnabled, m.dryRunNote(fmt.Sprintf("Runs 'task start'%s.", m.argsNote()))
+	}
+
+	script, err := findQuickstartScript()
+	if err != nil {
+		return stepEnabled, err.Error()
+	}
+
+	if script == "" {
+		return stepSkipped, "No start command or quickstart script found."
+	}
+
+	return stepEnabled, m.scriptReason(script)
+}
+
+func (m *Model) scriptReason(script string) string {
+	if m.opts.AnswerYes {
+		return m.dryRunNote(fmt.Sprintf("Runs %s%s without asking (--yes).", script, m.argsNote()))
+	}
+
+	return m.dryRunNote(fmt.Sprintf("Runs %s%s after asking for confirmation.", script, m.argsNote()))
+}
+
+// argsNote lists the arguments given after --, if any
+func (m *Model) argsNote() string {
+	if len(m.opts.ScriptArgs) == 0 {
+		return ""
+	}
+
+	return " with arguments " + strings.Join(m.opts.ScriptArgs, " ")
+}
+
+func (m *Model) dryRunNote(reason string) string {
+	if m.opts.DryRun {
+		return reason + " With --dry-run it is only shown."
+	}
+
+	return reason
+}
+
+func previewSelfUpdate(_ *Model) (stepStatus, string) {
+	if update.SelfUpdateDisabled() {
+		return stepSkipped, "Self-update is disabled; the run fails."
+	}
+
+	return stepEnabled, "Runs 'dr self update'."
+}
+
+func previewTemplateSetup(m *Model) (stepStatus, string) {
+	if m.opts.Template != "" {
+		return stepEnabled, fmt.Sprintf("Clones template %q.", m.opts.Template)
+	}
+
+	return stepEnabled, "Launches the interactive template setup."
+}
@@ -0,0 +1,115 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/datarobot/cli/internal/repo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeRepoScript turns the working directory into a DataRobot repository
+// with an executable quickstart script
+func writeRepoScript(t *testing.T) string {
+	t.Helper()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell script")
+	}
+
+	require.NoError(t, os.MkdirAll(repo.QuickstartScriptPath, 0o755))
+
+	path := filepath.Join(repo.QuickstartScriptPath, "quickstart.sh")
+	require.NoError(t, os.WriteFile(path, []byte("#!/bin/sh\n"), 0o755))
+
+	return path
+}
+
+func TestListStepsOutsideRepo(t *testing.T) {
+	setupSessionTest(t)
+
+	var out bytes.Buffer
+
+	require.NoError(t, listSteps(&out, Options{Action: ActionQuickstart, Template: "Talk to My Data"}))
+
+	assert.Contains(t, out.String(), "4\tChecking repository setup\tenabled\tNot in a DataRobot repository; clones template \"Talk to My Data\".")
+	assert.Contains(t, out.String(), "5\tFinding and executing start command\tenabled\tDetected in the cloned template once it is set up.")
+}
+
+func TestPreviewStepsFindQuickstartScript(t *testing.T) {
+	setupSessionTest(t)
+
+	script := writeRepoScript(t)
+
+	last := func(opts Options) stepPreview {
+		previews := NewStartModel(opts).previewSteps()
+
+		return previews[len(previews)-1]
+	}
+
+	preview := last(Options{Action: ActionQuickstart})
+	assert.Equal(t, stepEnabled, preview.Status)
+	assert.Equal(t, "Runs "+script+" after asking for confirmation.", preview.Reason)
+
+	preview = last(Options{Action: ActionQuickstart, AnswerYes: true, DryRun: true})
+	assert.Equal(t, "Runs "+script+" without asking (--yes). With --dry-run it is only shown.", preview.Reason)
+
+	repository := NewStartModel(Options{Action: ActionQuickstart}).previewSteps()[3]
+	assert.Equal(t, "Already in a DataRobot repository; no template is set up.", repository.Reason)
+}
+
+func TestPreviewStepsWithoutStartCommand(t *testing.T) {
+	setupSessionTest(t)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(".datarobot", "answers"), 0o755))
+
+	previews := NewStartModel(Options{Action: ActionExecuteScript}).previewSteps()
+	require.Len(t, previews, 1)
+	assert.Equal(t, stepSkipped, previews[0].Status)
+	assert.Equal(t, "No start command or quickstart script found.", previews[0].Reason)
+}
+
+func TestPreviewStepsAfterResume(t *testing.T) {
+	setupSessionTest(t)
+
+	m := NewStartModel(Options{Action: ActionQuickstart})
+	m.recordStep()
+	m.current++
+	m.recordStep()
+
+	previews := NewStartModel(Options{Action: ActionQuickstart, Resume: true}).previewSteps()
+	assert.Equal(t, stepSkipped, previews[0].Status)
+	assert.Equal(t, stepSkipped, previews[1].Status)
+	assert.Equal(t, "Completed by the interrupted run being resumed.", previews[1].Reason)
+	assert.Equal(t, stepEnabled, previews[2].Status)
+}
+
+func TestListStepsKeepsSessionOnRestart(t *testing.T) {
+	setupSessionTest(t)
+
+	m := NewStartModel(Options{Action: ActionQuickstart})
+	m.recordStep()
+
+	require.NoError(t, listSteps(&bytes.Buffer{}, Options{Action: ActionQuickstart, Restart: true}))
+
+	_, ok := loadSession(ActionQuickstart)
+	assert.True(t, ok)
+}
@@ -0,0 +1,113 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/tui"
+)
+
+var summaryColumns = []printer.Column[stepResult]{
+	{Field: "description", Header: "STEP", Value: func(s stepResult) string { return strings.TrimSuffix(s.Description, "...") }},
+	{Field: "status", Header: "STATUS", Value: func(s stepResult) string { return s.Status }},
+	{Field: "message", Header: "MESSAGE", Value: func(s stepResult) string { return firstLine(s.Message) }},
+}
+
+// writeSummary writes the status of each step that ran, then how many
+// completed and failed
+func writeSummary(w io.Writer, steps []stepResult) error {
+	if err := printer.WriteTable(w, summaryColumns, steps); err != nil {
+		return err
+	}
+
+	_, err := fmt.Fprintln(w, summaryLine(steps))
+
+	return err
+}
+
+// summaryLine counts the steps by status, such as "2 of 3 steps
+// completed, 1 failed."
+func summaryLine(steps []stepResult) string {
+	counts := map[string]int{}
+
+	for _, s := range steps {
+		counts[s.Status]++
+	}
+
+	line := fmt.Sprintf("%d of %d steps completed", counts[stepStatusCompleted], len(steps))
+
+	if n := counts[stepStatusFailed]; n > 0 {
+		line += fmt.Sprintf(", %d failed", n)
+	}
+
+	if n := counts[stepStatusSkipped]; n > 0 {
+		line += fmt.Sprintf(", %d skipped", n)
+	}
+
+	return line + "."
+}
+
+// showSummary reports whether the TUI ends with a summary of the steps:
+// once a run with --continue-on-error is over and some step failed
+func (m Model) showSummary() bool {
+	if !m.opts.ContinueOnError || (!m.done && m.err == nil) {
+		return false
+	}
+
+	return m.err != nil || len(m.failedSteps) > 0
+}
+
+// summaryView is the summary the TUI ends with
+func (m Model) summaryView() string {
+	steps := m.stepResults()
+
+	return printer.NewTable(summaryColumns, steps).Render(m.outputWidth()) +
+		tui.BaseTextStyle.Render(summaryLine(steps)) + "\n"
+}
+
+// stepResults returns the status of each step the TUI has reached
+func (m Model) stepResults() []stepResult {
+	results := make([]stepResult, 0, m.current+1)
+
+	for i := 0; i <= m.current && i < len(m.steps); i++ {
+		result := stepResult{Description: m.steps[i].description, Status: stepStatusCompleted}
+
+		if err, failed := m.failedSteps[i]; failed {
+			result.Status = stepStatusFailed
+			result.Message = err.Error()
+		} else if i == m.current && m.err != nil {
+			result.Status = stepStatusFailed
+			result.Message = m.err.Error()
+		} else if i < m.resumed {
+			result.Status = stepStatusSkipped
+			result.Message = "Completed in a previous run."
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// firstLine returns the first line of s, to keep table rows to one line
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(s, "\n")
+
+	return line
+}
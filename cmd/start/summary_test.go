@@ -0,0 +1,109 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stepsWithFailingMiddle fails the second of three steps
+func stepsWithFailingMiddle() []step {
+	fail := func(_ *Model) tea.Msg { return stepErrorMsg{err: errors.New("Missing required tools: task.")} }
+
+	return []step{
+		{description: "Starting quickstart...", fn: startQuickstart},
+		{description: "Checking prerequisites...", fn: fail},
+		{description: "Finishing...", fn: startQuickstart},
+	}
+}
+
+func TestMiddleStepFailsFast(t *testing.T) {
+	for _, opts := range []Options{{DryRun: true}, {DryRun: true, FailFast: true}} {
+		final := runSteps(t, Model{steps: stepsWithFailingMiddle(), opts: opts})
+
+		require.EqualError(t, final.err, "Missing required tools: task.")
+		assert.Equal(t, 1, final.current)
+		assert.False(t, final.showSummary())
+		assert.NotContains(t, final.View(), "steps completed")
+	}
+}
+
+func TestMiddleStepContinueOnError(t *testing.T) {
+	final := runSteps(t, Model{steps: stepsWithFailingMiddle(), opts: Options{DryRun: true, ContinueOnError: true}})
+
+	require.NoError(t, final.err)
+	assert.True(t, final.done)
+	assert.Equal(t, 2, final.current)
+	assert.EqualError(t, final.failedStepsError(), "1 step failed: Missing required tools: task.")
+
+	steps := final.stepResults()
+	require.Len(t, steps, 3)
+	assert.Equal(t, []string{stepStatusCompleted, stepStatusFailed, stepStatusCompleted},
+		[]string{steps[0].Status, steps[1].Status, steps[2].Status})
+	assert.Equal(t, "Missing required tools: task.", steps[1].Message)
+
+	view := final.View()
+	assert.Contains(t, view, "2 of 3 steps completed, 1 failed.")
+	assert.Contains(t, view, "Missing required tools: task.")
+}
+
+func TestHeadlessMiddleStepFailsFast(t *testing.T) {
+	m := Model{steps: stepsWithFailingMiddle(), opts: Options{DryRun: true, FailFast: true}, headless: true}
+	result := startResult{}
+
+	err := m.runStepsHeadless(&result)
+	require.EqualError(t, err, "Missing required tools: task.")
+	require.Len(t, result.Steps, 2)
+	assert.Equal(t, stepStatusFailed, result.Steps[1].Status)
+}
+
+func TestHeadlessMiddleStepContinueOnError(t *testing.T) {
+	m := Model{steps: stepsWithFailingMiddle(), opts: Options{DryRun: true, ContinueOnError: true}, headless: true}
+	result := startResult{}
+
+	err := m.runStepsHeadless(&result)
+	require.EqualError(t, err, "1 step failed: Missing required tools: task.")
+	require.Len(t, result.Steps, 3)
+	assert.Equal(t, stepStatusCompleted, result.Steps[2].Status)
+
+	var out bytes.Buffer
+
+	require.NoError(t, writeSummary(&out, result.Steps))
+	assert.Equal(t, "STEP\tSTATUS\tMESSAGE\n"+
+		"Starting quickstart\tcompleted\t\n"+
+		"Checking prerequisites\tfailed\tMissing required tools: task.\n"+
+		"Finishing\tcompleted\t\n"+
+		"2 of 3 steps completed, 1 failed.\n", out.String())
+}
+
+func TestSummaryLine(t *testing.T) {
+	assert.Equal(t, "0 of 0 steps completed.", summaryLine(nil))
+	assert.Equal(t, "1 of 3 steps completed, 1 failed, 1 skipped.", summaryLine([]stepResult{
+		{Status: stepStatusSkipped}, {Status: stepStatusCompleted}, {Status: stepStatusFailed},
+	}))
+}
+
+func TestFailFastConflictsWithContinueOnError(t *testing.T) {
+	cmd := Cmd()
+
+	require.NoError(t, cmd.ParseFlags([]string{"--fail-fast", "--continue-on-error"}))
+	assert.ErrorContains(t, cmd.ValidateFlagGroups(), "[continue-on-error fail-fast] were all set")
+}
@@ -0,0 +1,360 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/datarobot/cli/cmd/templates/clone"
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/tui"
+)
+
+// templateListHeight is the most templates the selection list shows at once
+const templateListHeight = 10
+
+type templatesLoadedMsg struct {
+	templates []drapi.Template
+}
+
+// prefetchedCatalog holds the template catalog fetched by a step before it
+// was needed, or the error fetching it failed with
+type prefetchedCatalog struct {
+	templates []drapi.Template
+	err       error
+}
+
+// loadTemplates fetches the DataRobot quickstart templates, unless a step
+// already has. With --template the named one is cloned right away;
+// otherwise the user picks from a list.
+func (m Model) loadTemplates(catalog *prefetchedCatalog) tea.Cmd {
+	opts := m.opts
+
+	return func() tea.Msg {
+		templates, err := templatesFrom(catalog)
+		if err != nil {
+			return stepErrorMsg{err: err}
+		}
+
+		if opts.Template == "" {
+			return templatesLoadedMsg{templates: templates}
+		}
+
+		template, err := drapi.FindTemplate(templates, opts.Template)
+		if err != nil {
+			return stepErrorMsg{err: err}
+		}
+
+		return cloneTemplateStep(template, opts)
+	}
+}
+
+// templatesFrom returns the prefetched templates, fetching them if no step
+// did
+func templatesFrom(catalog *prefetchedCatalog) ([]drapi.Template, error) {
+	if catalog == nil {
+		return fetchTemplates()
+	}
+
+	return catalog.templates, catalog.err
+}
+
+func fetchTemplates() ([]drapi.Template, error) {
+	list, err := drapi.GetPublicTemplatesSorted()
+	if err != nil {
+		return nil, fmt.Errorf("Failed to fetch templates: %w", err)
+	}
+
+	if len(list.Templates) == 0 {
+		return nil, errors.New("No templates are available.")
+	}
+
+	return list.Templates, nil
+}
+
+// templateOutcome is what setting up a template did
+type templateOutcome int
+
+const (
+	templateCloned templateOutcome = iota
+	templateUpToDate
+	templateUpgraded
+	// templateOutdated means a newer version is available, but the
+	// installed one was kept
+	templateOutdated
+)
+
+// templateSetup reports where a template was set up and how
+type templateSetup struct {
+	dir       string
+	outcome   templateOutcome
+	installed string // Version found in the directory, if any
+}
+
+// message tells the user what happened to template
+func (s templateSetup) message(template drapi.Template) string {
+	latest := versionLabel(templateVersion(template))
+
+	switch s.outcome {
+	case templateUpToDate:
+		return fmt.Sprintf("%s (%s) is already set up in %s\n", template.Name, latest, s.dir)
+	case templateUpgraded:
+		return fmt.Sprintf("Upgraded %s in %s from %s to %s\n", template.Name, s.dir, versionLabel(s.installed), latest)
+	case templateOutdated:
+		return fmt.Sprintf("%s in %s is at %s; %s is available.\n", template.Name, s.dir, versionLabel(s.installed), latest)
+	case templateCloned:
+	}
+
+	return fmt.Sprintf("Cloned %s into %s\n", template.Name, s.dir)
+}
+
+// templateUpgrade is a newer template version the user is asked to install
+type templateUpgrade struct {
+	template drapi.Template
+}
+
+// cloneTemplateStep sets up template and reports where it went. If an
+// older version is already there, the user is asked whether to upgrade.
+func cloneTemplateStep(template drapi.Template, opts Options) tea.Msg {
+	setup, err := cloneTemplate(template, opts)
+	if err != nil {
+		return stepErrorMsg{err: err}
+	}
+
+	if setup.outcome == templateOutdated {
+		return stepCompleteMsg{
+			message: setup.message(template) + "Upgrade it?\n",
+			upgrade: &templateUpgrade{template: template},
+		}
+	}
+
+	return stepCompleteMsg{
+		message:     setup.message(template),
+		templateDir: setup.dir,
+	}
+}
+
+// templateDir returns the absolute directory template is cloned into:
+// dir if given, or a directory named after the template's repository
+func templateDir(template drapi.Template, dir string) (string, error) {
+	if dir == "" {
+		dir = template.DefaultDir()
+	}
+
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return "", fmt.Errorf("Failed to resolve directory %s: %w", dir, err)
+	}
+
+	return absDir, nil
+}
+
+// cloneTemplate clones template into --dir, unless an earlier run already
+// set it up there. The same version is left as it is. A different version
+// is replaced with --upgrade or --assume-yes. A template the user changed
+// since is only replaced with --force.
+func cloneTemplate(template drapi.Template, opts Options) (templateSetup, error) {
+	dir, err := templateDir(template, opts.Dir)
+	if err != nil {
+		return templateSetup{}, err
+	}
+
+	setup := templateSetup{dir: dir, outcome: templateCloned}
+	force := opts.Force || opts.AnswerYes
+
+	if marker, ok := readTemplateMarker(dir); ok && marker.ID == template.ID {
+		setup.installed = marker.Version
+		sameVersion := marker.Version == templateVersion(template)
+
+		switch {
+		case templateModified(dir, marker):
+			if !opts.Force {
+				return templateSetup{}, fmt.Errorf("%s in %s has local changes. Use --force to replace them with a fresh clone, or choose another --dir.", template.Name, dir)
+			}
+
+			log.Warn("start: replacing modified template", "template", template.Name, "dir", dir)
+		case sameVersion:
+			setup.outcome = templateUpToDate
+
+			return setup, nil
+		case !opts.Upgrade && !opts.AnswerYes:
+			setup.outcome = templateOutdated
+
+			return setup, nil
+		}
+
+		if !sameVersion {
+			setup.outcome = templateUpgraded
+		}
+
+		force = true
+	}
+
+	if err := prepareTemplateDir(dir, force); err != nil {
+		return templateSetup{}, err
+	}
+
+	log.Info("start: cloning template", "template", template.Name, "dir", dir)
+
+	if out, err := clone.GitClone(template.Repository.URL, dir, template.Repository.Tag); err != nil {
+		return templateSetup{}, fmt.Errorf("Failed to clone %s: %w\n%s", template.Name, err, strings.TrimSpace(out))
+	}
+
+	if err := writeTemplateMarker(dir, template); err != nil {
+		return templateSetup{}, err
+	}
+
+	return setup, nil
+}
+
+// SetUpTemplate clones template into dir, or a directory named after its
+// repository, the way dr start does, so a later dr start recognizes it. A
+// non-empty directory is only replaced with force. It returns the absolute
+// directory.
+func SetUpTemplate(template drapi.Template, dir string, force bool) (string, error) {
+	setup, err := cloneTemplate(template, Options{Dir: dir, Force: force, Upgrade: true})
+	if err != nil {
+		return "", err
+	}
+
+	return setup.dir, nil
+}
+
+// prepareTemplateDir makes sure a template can be cloned into dir. A
+// non-empty directory is only replaced with force, and never when it
+// contains the working directory.
+func prepareTemplateDir(dir string, force bool) error {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("Cannot clone into %s: %w", dir, err)
+	}
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	if !force {
+		return fmt.Errorf("Directory %s is not empty. Use --force or --assume-yes to replace its contents, or choose another --dir.", dir)
+	}
+
+	if cwd, err := os.Getwd(); err == nil {
+		if rel, err := filepath.Rel(dir, cwd); err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			return fmt.Errorf("Refusing to replace %s because it contains the current directory.", dir)
+		}
+	}
+
+	if err := os.RemoveAll(dir); err != nil {
+		return fmt.Errorf("Failed to remove %s: %w", dir, err)
+	}
+
+	return nil
+}
+
+// handleTemplateUpgradeKey answers whether to upgrade a template set up by
+// an earlier run. Declining carries on with the installed version.
+func (m Model) handleTemplateUpgradeKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	template := m.pendingUpgrade.template
+
+	switch msg.String() {
+	case "y", "Y", "enter":
+		opts := m.opts
+		opts.Upgrade = true
+
+		m.pendingUpgrade = nil
+		m.stepCompleteMessage = fmt.Sprintf("Upgrading %s...\n", template.Name)
+
+		return m, func() tea.Msg {
+			return cloneTemplateStep(template, opts)
+		}
+	case "n", "N", "esc":
+		dir, err := templateDir(template, m.opts.Dir)
+		if err != nil {
+			m.err = err
+
+			return m, tea.Quit
+		}
+
+		m.pendingUpgrade = nil
+
+		return m.handleStepComplete(stepCompleteMsg{
+			message:     fmt.Sprintf("Keeping the installed version of %s in %s\n", template.Name, dir),
+			templateDir: dir,
+		})
+	case "q":
+		m.quitting = true
+
+		return m, tea.Quit
+	}
+
+	return m, nil
+}
+
+func (m Model) handleTemplateKey(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "up", "k":
+		if m.templateCursor > 0 {
+			m.templateCursor--
+		}
+	case "down", "j":
+		if m.templateCursor < len(m.templates)-1 {
+			m.templateCursor++
+		}
+	case "enter":
+		template := m.templates[m.templateCursor]
+		opts := m.opts
+
+		m.selectingTemplate = false
+		m.stepCompleteMessage = fmt.Sprintf("Cloning %s...\n", template.Name)
+
+		return m, func() tea.Msg {
+			return cloneTemplateStep(template, opts)
+		}
+	case "q", "esc":
+		m.quitting = true
+
+		return m, tea.Quit
+	}
+
+	return m, nil
+}
+
+// templateListView renders the part of the template list around the cursor
+func (m Model) templateListView() string {
+	var sb strings.Builder
+
+	rows := m.templateListLines()
+	start := max(0, min(m.templateCursor-rows/2, len(m.templates)-rows))
+	end := min(len(m.templates), start+rows)
+
+	for i := start; i < end; i++ {
+		if i == m.templateCursor {
+			sb.WriteString(fmt.Sprintf("  %s %s\n", arrow, tui.InfoStyle.Render(m.templates[i].Name)))
+		} else {
+			sb.WriteString(fmt.Sprintf("    %s\n", m.templates[i].Name))
+		}
+	}
+
+	return sb.String()
+}
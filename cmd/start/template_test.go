@@ -0,0 +1,334 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTemplateRepo creates a local git repository to clone templates from
+func newTemplateRepo(t *testing.T) drapi.Template {
+	t.Helper()
+
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := filepath.Join(t.TempDir(), "talk-to-my-data")
+	require.NoError(t, os.MkdirAll(dir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# Talk to my data\n"), 0o644))
+
+	for _, args := range [][]string{
+		{"init", "--quiet"},
+		{"add", "README.md"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "-m", "init"},
+	} {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+
+	return drapi.Template{ID: "abc123", Name: "Talk to My Data", Repository: drapi.Repository{URL: "file://" + dir}}
+}
+
+func TestPrepareTemplateDir(t *testing.T) {
+	root := t.TempDir()
+	t.Chdir(root)
+
+	require.NoError(t, prepareTemplateDir(filepath.Join(root, "missing"), false))
+
+	empty := filepath.Join(root, "empty")
+	require.NoError(t, os.Mkdir(empty, 0o755))
+	require.NoError(t, prepareTemplateDir(empty, false))
+
+	full := filepath.Join(root, "full")
+	require.NoError(t, os.Mkdir(full, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(full, "notes.txt"), []byte("keep"), 0o644))
+
+	err := prepareTemplateDir(full, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--force")
+	assert.FileExists(t, filepath.Join(full, "notes.txt"))
+
+	require.NoError(t, prepareTemplateDir(full, true))
+	assert.NoDirExists(t, full)
+
+	err = prepareTemplateDir(root, true)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "current directory")
+}
+
+func TestCloneTemplateDefaultsToRepositoryName(t *testing.T) {
+	template := newTemplateRepo(t)
+	t.Chdir(t.TempDir())
+
+	setup, err := cloneTemplate(template, Options{})
+	require.NoError(t, err)
+
+	assert.Equal(t, templateCloned, setup.outcome)
+	assert.Equal(t, "talk-to-my-data", filepath.Base(setup.dir))
+	assert.FileExists(t, filepath.Join(setup.dir, "README.md"))
+
+	// A directory holding something else is not replaced
+	other := template
+	other.ID = "other"
+
+	_, err = cloneTemplate(other, Options{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not empty")
+}
+
+// gitIn runs git in dir
+func gitIn(t *testing.T, dir string, args ...string) {
+	t.Helper()
+
+	cmd := exec.Command("git", append([]string{"-c", "user.name=test", "-c", "user.email=test@example.com"}, args...)...)
+	cmd.Dir = dir
+
+	out, err := cmd.CombinedOutput()
+	require.NoError(t, err, string(out))
+}
+
+// newTaggedTemplateRepo creates a template repository with tags v1 and
+// v2, returning the template at v1
+func newTaggedTemplateRepo(t *testing.T) drapi.Template {
+	t.Helper()
+
+	template := newTemplateRepo(t)
+	dir := strings.TrimPrefix(template.Repository.URL, "file://")
+
+	gitIn(t, dir, "tag", "v1")
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("# Talk to my data v2\n"), 0o644))
+	gitIn(t, dir, "commit", "--quiet", "-am", "v2")
+	gitIn(t, dir, "tag", "v2")
+
+	template.Repository.Tag = "v1"
+
+	return template
+}
+
+func TestCloneTemplateTwiceSkips(t *testing.T) {
+	template := newTaggedTemplateRepo(t)
+	t.Chdir(t.TempDir())
+
+	first, err := cloneTemplate(template, Options{})
+	require.NoError(t, err)
+
+	marker, ok := readTemplateMarker(first.dir)
+	require.True(t, ok)
+	assert.Equal(t, "abc123", marker.ID)
+	assert.Equal(t, "v1", marker.Version)
+	assert.NotEmpty(t, marker.Commit)
+
+	second, err := cloneTemplate(template, Options{})
+	require.NoError(t, err)
+
+	assert.Equal(t, templateUpToDate, second.outcome)
+	assert.Equal(t, first.dir, second.dir)
+	assert.Contains(t, second.message(template), "Talk to My Data (v1) is already set up in")
+}
+
+func TestCloneTemplateOffersUpgrade(t *testing.T) {
+	template := newTaggedTemplateRepo(t)
+	t.Chdir(t.TempDir())
+
+	_, err := cloneTemplate(template, Options{})
+	require.NoError(t, err)
+
+	template.Repository.Tag = "v2"
+
+	setup, err := cloneTemplate(template, Options{})
+	require.NoError(t, err)
+
+	assert.Equal(t, templateOutdated, setup.outcome)
+	assert.Equal(t, "v1", setup.installed)
+	assert.Contains(t, setup.message(template), "is at v1; v2 is available")
+
+	setup, err = cloneTemplate(template, Options{Upgrade: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, templateUpgraded, setup.outcome)
+	assert.Contains(t, setup.message(template), "from v1 to v2")
+
+	readme, err := os.ReadFile(filepath.Join(setup.dir, "README.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "# Talk to my data v2\n", string(readme))
+
+	marker, _ := readTemplateMarker(setup.dir)
+	assert.Equal(t, "v2", marker.Version)
+}
+
+func TestCloneTemplateModifiedNeedsForce(t *testing.T) {
+	template := newTaggedTemplateRepo(t)
+	t.Chdir(t.TempDir())
+
+	setup, err := cloneTemplate(template, Options{})
+	require.NoError(t, err)
+
+	readme := filepath.Join(setup.dir, "README.md")
+	require.NoError(t, os.WriteFile(readme, []byte("my notes\n"), 0o644))
+
+	// Not even --assume-yes replaces the user's changes
+	_, err = cloneTemplate(template, Options{AnswerYes: true})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "has local changes")
+
+	data, err := os.ReadFile(readme)
+	require.NoError(t, err)
+	assert.Equal(t, "my notes\n", string(data))
+
+	setup, err = cloneTemplate(template, Options{Force: true})
+	require.NoError(t, err)
+
+	assert.Equal(t, templateCloned, setup.outcome)
+
+	data, err = os.ReadFile(readme)
+	require.NoError(t, err)
+	assert.Equal(t, "# Talk to my data\n", string(data))
+}
+
+func TestCloneTemplateIgnoresCLIFiles(t *testing.T) {
+	template := newTemplateRepo(t)
+	t.Chdir(t.TempDir())
+
+	setup, err := cloneTemplate(template, Options{})
+	require.NoError(t, err)
+
+	// State the CLI writes next to the marker does not count as a change
+	require.NoError(t, os.WriteFile(filepath.Join(setup.dir, ".datarobot", "cli", "state.yaml"), []byte("{}\n"), 0o644))
+
+	setup, err = cloneTemplate(template, Options{})
+	require.NoError(t, err)
+	assert.Equal(t, templateUpToDate, setup.outcome)
+}
+
+func TestTemplateUpgradePrompt(t *testing.T) {
+	template := newTaggedTemplateRepo(t)
+	t.Chdir(t.TempDir())
+
+	_, err := cloneTemplate(template, Options{})
+	require.NoError(t, err)
+
+	template.Repository.Tag = "v2"
+	m := Model{steps: stepsForAction(ActionQuickstart), current: 3}
+
+	next, _ := m.Update(cloneTemplateStep(template, m.opts))
+	m = next.(Model)
+
+	require.NotNil(t, m.pendingUpgrade)
+	assert.Contains(t, m.View(), "Upgrade it?")
+	assert.Contains(t, m.View(), "'n' to keep the installed version")
+
+	next, cmd := m.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	m = next.(Model)
+
+	assert.Nil(t, m.pendingUpgrade)
+
+	msg, ok := cmd().(stepCompleteMsg)
+	require.True(t, ok)
+	assert.Contains(t, msg.message, "from v1 to v2")
+	assert.NotEmpty(t, msg.templateDir)
+}
+
+func TestTemplateUpgradePromptDeclined(t *testing.T) {
+	template := newTaggedTemplateRepo(t)
+	t.Chdir(t.TempDir())
+
+	setup, err := cloneTemplate(template, Options{})
+	require.NoError(t, err)
+
+	template.Repository.Tag = "v2"
+	m := Model{steps: stepsForAction(ActionQuickstart), current: 3, pendingUpgrade: &templateUpgrade{template: template}}
+
+	next, _ := m.handleKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	m = next.(Model)
+
+	assert.Nil(t, m.pendingUpgrade)
+	assert.Equal(t, setup.dir, m.repoRoot)
+	assert.Equal(t, 4, m.current)
+
+	marker, _ := readTemplateMarker(setup.dir)
+	assert.Equal(t, "v1", marker.Version)
+}
+
+func TestTemplateSelectionClonesIntoDir(t *testing.T) {
+	template := newTemplateRepo(t)
+	t.Chdir(t.TempDir())
+
+	target := filepath.Join(t.TempDir(), "my-app")
+	m := Model{opts: Options{Dir: target}, steps: stepsForAction(ActionQuickstart), current: 3}
+
+	next, _ := m.Update(templatesLoadedMsg{templates: []drapi.Template{{Name: "Guarded RAG"}, template}})
+	m = next.(Model)
+
+	require.True(t, m.selectingTemplate)
+	assert.Contains(t, m.View(), "Guarded RAG")
+
+	next, _ = m.handleKey(tea.KeyMsg{Type: tea.KeyDown})
+	m = next.(Model)
+
+	next, cmd := m.handleKey(tea.KeyMsg{Type: tea.KeyEnter})
+	m = next.(Model)
+
+	assert.False(t, m.selectingTemplate)
+
+	msg, ok := cmd().(stepCompleteMsg)
+	require.True(t, ok)
+	assert.Equal(t, target, msg.templateDir)
+
+	next, _ = m.Update(msg)
+	m = next.(Model)
+
+	cwd, err := os.Getwd()
+	require.NoError(t, err)
+
+	resolvedTarget, err := filepath.EvalSymlinks(target)
+	require.NoError(t, err)
+
+	assert.Equal(t, resolvedTarget, cwd)
+	assert.Equal(t, 4, m.current)
+	assert.Contains(t, m.stepCompleteMessage, "Cloned Talk to My Data into "+target)
+}
+
+func TestHeadlessKeepsOutdatedTemplate(t *testing.T) {
+	template := newTaggedTemplateRepo(t)
+	t.Chdir(t.TempDir())
+
+	_, err := cloneTemplate(template, Options{})
+	require.NoError(t, err)
+
+	template.Repository.Tag = "v2"
+	m := Model{opts: Options{Template: template.Name}, headless: true}
+	step := stepResult{Status: stepStatusCompleted}
+	result := startResult{}
+
+	done, err := m.setupTemplateHeadless(&prefetchedCatalog{templates: []drapi.Template{template}}, &step, &result)
+	require.NoError(t, err)
+
+	assert.False(t, done)
+	assert.NotEmpty(t, result.TemplateDir)
+	assert.Contains(t, step.Message, "is at v1; v2 is available. Rerun with --upgrade to install it.")
+}
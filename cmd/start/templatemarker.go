@@ -0,0 +1,144 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/datarobot/cli/internal/repo"
+	"github.com/datarobot/cli/internal/version"
+	"gopkg.in/yaml.v3"
+)
+
+// templateMarkerFile records which template was cloned into a directory,
+// so that setting it up again can tell what is there
+const templateMarkerFile = "template.yaml"
+
+// templateMarker is the contents of the marker file
+type templateMarker struct {
+	ID          string    `yaml:"id"`
+	Name        string    `yaml:"name"`
+	Repository  string    `yaml:"repository"`
+	Version     string    `yaml:"version,omitempty"`
+	Commit      string    `yaml:"commit,omitempty"`
+	InstalledAt time.Time `yaml:"installed_at"`
+	CLIVersion  string    `yaml:"cli_version"`
+}
+
+func templateMarkerPath(dir string) string {
+	return filepath.Join(dir, repo.DataRobotTemplateDetectCliPath, templateMarkerFile)
+}
+
+// templateVersion is the version of a template in the catalog: the tag it
+// is cloned at, or empty for the repository's default branch
+func templateVersion(template drapi.Template) string {
+	return template.Repository.Tag
+}
+
+// versionLabel describes a template version for messages
+func versionLabel(v string) string {
+	if v == "" {
+		return "the latest commit"
+	}
+
+	return v
+}
+
+// readTemplateMarker returns the marker in dir, and false if there is none
+// or it cannot be read
+func readTemplateMarker(dir string) (templateMarker, bool) {
+	data, err := os.ReadFile(templateMarkerPath(dir))
+	if err != nil {
+		return templateMarker{}, false
+	}
+
+	var marker templateMarker
+
+	if err := yaml.Unmarshal(data, &marker); err != nil || marker.ID == "" {
+		return templateMarker{}, false
+	}
+
+	return marker, true
+}
+
+// writeTemplateMarker records that template was just cloned into dir
+func writeTemplateMarker(dir string, template drapi.Template) error {
+	commit, _ := gitOutput(dir, "rev-parse", "HEAD")
+
+	marker := templateMarker{
+		ID:          template.ID,
+		Name:        template.Name,
+		Repository:  template.Repository.URL,
+		Version:     templateVersion(template),
+		Commit:      commit,
+		InstalledAt: time.Now().UTC(),
+		CLIVersion:  version.Version,
+	}
+
+	data, err := yaml.Marshal(marker)
+	if err != nil {
+		return err
+	}
+
+	path := templateMarkerPath(dir)
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("Failed to record the template version: %w", err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("Failed to record the template version: %w", err)
+	}
+
+	return nil
+}
+
+// templateModified reports whether the user changed the template since it
+// was cloned: committed on top of it, or has uncommitted changes outside
+// the CLI's own files. A directory git cannot inspect counts as modified.
+func templateModified(dir string, marker templateMarker) bool {
+	head, err := gitOutput(dir, "rev-parse", "HEAD")
+	if err != nil || (marker.Commit != "" && head != marker.Commit) {
+		return true
+	}
+
+	status, err := gitOutput(dir, "status", "--porcelain", "--", ".", ":(exclude)"+repo.DataRobotTemplateDetectCliPath)
+
+	return err != nil || status != ""
+}
+
+func gitOutput(dir string, args ...string) (string, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+
+	out, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return "", fmt.Errorf("git %s: %s", args[0], strings.TrimSpace(string(exitErr.Stderr)))
+		}
+
+		return "", err
+	}
+
+	return strings.TrimSpace(string(out)), nil
+}
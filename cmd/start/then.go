@@ -0,0 +1,91 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"slices"
+
+	"github.com/datarobot/cli/internal/errs"
+	"github.com/datarobot/cli/internal/log"
+)
+
+// thenResult reports the --then command in structured output
+type thenResult struct {
+	Command  string `json:"command"   yaml:"command"`
+	ExitCode int    `json:"exit_code" yaml:"exit_code"`
+}
+
+// thenCommand builds the shell command given with --then. Like the
+// quickstart script, it runs in the current directory and receives the
+// --env-file variables and DataRobot credentials in its environment.
+func thenCommand(opts Options) *exec.Cmd {
+	var cmd *exec.Cmd
+
+	if runtime.GOOS == "windows" {
+		cmd = exec.Command("cmd", "/C", opts.Then)
+	} else {
+		cmd = exec.Command("sh", "-c", opts.Then)
+	}
+
+	cmd.Env = slices.Concat(os.Environ(), opts.fileEnv, credentialsEnv(opts), colorEnv())
+
+	return cmd
+}
+
+// runThen runs the --then command and waits for it. It returns the
+// command's exit code, with an error carrying that code when it failed.
+func runThen(opts Options, stdin io.Reader, stdout, stderr io.Writer) (int, error) {
+	transcript := log.OutputWriter("then")
+	defer transcript.Close()
+
+	cmd := thenCommand(opts)
+	cmd.Stdin = stdin
+	cmd.Stdout = io.MultiWriter(stdout, transcript)
+	cmd.Stderr = io.MultiWriter(stderr, transcript)
+
+	log.Info("start: running follow-up command", "command", opts.Then)
+
+	err := cmd.Run()
+	if err == nil {
+		return 0, nil
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) && exitErr.ExitCode() > 0 {
+		code := exitErr.ExitCode()
+
+		return code, errs.NewScriptError(code, fmt.Sprintf("Follow-up command exited with code %d.", code))
+	}
+
+	return errs.ExitCodeFailure, fmt.Errorf("Failed to run follow-up command: %w", err)
+}
+
+// runThenAfter runs the --then command once the TUI has finished every
+// step. Nothing runs after a failure, a cancelled run, or a --dry-run.
+func runThenAfter(m Model, opts Options) error {
+	if opts.Then == "" || opts.DryRun || !m.done || m.quitting || m.err != nil {
+		return nil
+	}
+
+	_, err := runThen(opts, os.Stdin, os.Stdout, os.Stderr)
+
+	return err
+}
@@ -0,0 +1,73 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"bytes"
+	"errors"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/datarobot/cli/internal/errs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunThen(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses POSIX shell commands")
+	}
+
+	var stdout bytes.Buffer
+
+	code, err := runThen(Options{Then: "echo ready", NoInjectCreds: true}, nil, &stdout, &stdout)
+	require.NoError(t, err)
+	assert.Zero(t, code)
+	assert.Equal(t, "ready\n", stdout.String())
+
+	code, err = runThen(Options{Then: "exit 3", NoInjectCreds: true}, nil, &stdout, &stdout)
+	require.Error(t, err)
+	assert.Equal(t, 3, code)
+	assert.Equal(t, 3, errs.ExitCode(err))
+	assert.Equal(t, "Follow-up command exited with code 3.", err.Error())
+}
+
+func TestRunThenAfterOnlyRunsOnSuccess(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses POSIX shell commands")
+	}
+
+	marker := filepath.Join(t.TempDir(), "ran")
+	opts := Options{Then: "touch " + marker, NoInjectCreds: true}
+
+	for name, m := range map[string]Model{
+		"failed":     {done: true, err: errors.New("Quickstart script exited with code 1.")},
+		"cancelled":  {done: true, quitting: true},
+		"unfinished": {},
+	} {
+		require.NoError(t, runThenAfter(m, opts), name)
+		assert.NoFileExists(t, marker, name)
+	}
+
+	dryRun := opts
+	dryRun.DryRun = true
+
+	require.NoError(t, runThenAfter(Model{done: true}, dryRun))
+	assert.NoFileExists(t, marker)
+
+	require.NoError(t, runThenAfter(Model{done: true}, opts))
+	assert.FileExists(t, marker)
+}
@@ -0,0 +1,211 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"os/exec"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/datarobot/cli/internal/log"
+)
+
+// scriptStepName names the quickstart script in timeout errors
+const scriptStepName = "Quickstart script"
+
+// stepTimeoutError reports a step that ran longer than --timeout-per-step
+type stepTimeoutError struct {
+	step    string
+	timeout time.Duration
+}
+
+func (e *stepTimeoutError) Error() string {
+	return fmt.Sprintf("%s timed out after %s.", strings.TrimSuffix(e.step, "..."), e.timeout)
+}
+
+// runStep runs the step at index on a copy of the model. With
+// --timeout-per-step the step's context expires after the timeout and the
+// step fails once it has run that long. A step that does not watch its
+// context is left to finish in the background and its result is dropped.
+func (m Model) runStep(index int) tea.Msg {
+	s := m.steps[index]
+
+	if m.opts.TimeoutPerStep <= 0 {
+		return s.fn(&m)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), m.opts.TimeoutPerStep)
+	defer cancel()
+
+	m.stepCtx = ctx
+	result := make(chan tea.Msg, 1)
+
+	go func() { result <- s.fn(&m) }()
+
+	select {
+	case msg := <-result:
+		return msg
+	case <-ctx.Done():
+		log.Warn("start: step timed out", "idx", index, "desc", s.description, "timeout", m.opts.TimeoutPerStep)
+
+		return stepErrorMsg{err: &stepTimeoutError{step: s.description, timeout: m.opts.TimeoutPerStep}}
+	}
+}
+
+// stepContext is the context of the running step, which expires with
+// --timeout-per-step
+func (m *Model) stepContext() context.Context {
+	if m.stepCtx == nil {
+		return context.Background()
+	}
+
+	return m.stepCtx
+}
+
+// continueAfterFailure reports whether the run goes on after the current
+// step failed: with --continue-on-error, unless it was the last step
+func (m Model) continueAfterFailure() bool {
+	return m.opts.ContinueOnError && m.current < len(m.steps)-1
+}
+
+// recordFailure remembers a step that failed with --continue-on-error
+func (m *Model) recordFailure(err error) {
+	if m.failedSteps == nil {
+		m.failedSteps = map[int]error{}
+	}
+
+	m.failedSteps[m.current] = err
+}
+
+// failedStepsError summarizes the steps that failed with
+// --continue-on-error, so the run still exits non-zero
+func (m Model) failedStepsError() error {
+	indexes := m.failedStepIndexes()
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	if len(indexes) == 1 {
+		return fmt.Errorf("1 step failed: %s", m.failedSteps[indexes[0]])
+	}
+
+	return fmt.Errorf("%d steps failed; the first: %s", len(indexes), m.failedSteps[indexes[0]])
+}
+
+// failedStepIndexes returns the steps that failed with --continue-on-error,
+// in order
+func (m Model) failedStepIndexes() []int {
+	return slices.Sorted(maps.Keys(m.failedSteps))
+}
+
+// outputWaitDelay is how long output is still copied after the script has
+// exited or was killed
+const outputWaitDelay = time.Second
+
+// runScriptWithTimeout runs cmd to completion in a new process group, and
+// kills the whole group once it has run for timeout, so the processes the
+// script started stop too. Output copying stops shortly after the script
+// exits, even if a process it left running, such as a dev server, still
+// holds the output open. When cmd reads from the terminal,
+// the group becomes its foreground group for as long as the script runs.
+// Cancelling ctx, as SIGINT or SIGTERM do, interrupts the group: it is
+// killed if the script has not exited after grace, and again once it has,
+// to stop what the script left running. The same happens when Ctrl-C
+// stops a script in the foreground.
+func runScriptWithTimeout(ctx context.Context, cmd *exec.Cmd, timeout, grace time.Duration) error {
+	setProcessGroup(cmd)
+
+	restoreTerminal := setForeground(cmd, cmd.Stdin)
+
+	cmd.WaitDelay = outputWaitDelay
+
+	if err := cmd.Start(); err != nil {
+		restoreTerminal()
+
+		return err
+	}
+
+	group, err := newProcessGroup(cmd.Process)
+	if err != nil {
+		log.Warn("start: script processes cannot be stopped as a group", "error", err)
+	}
+
+	defer group.release()
+
+	var expired atomic.Bool
+
+	if timeout > 0 {
+		timer := time.AfterFunc(timeout, func() {
+			expired.Store(true)
+
+			_ = group.kill()
+		})
+		defer timer.Stop()
+	}
+
+	exited := make(chan struct{})
+	watched := make(chan struct{})
+
+	go func() {
+		defer close(watched)
+
+		select {
+		case <-exited:
+		case <-ctx.Done():
+			log.Info("start: interrupting script", "path", cmd.Path)
+
+			_ = group.interrupt()
+
+			select {
+			case <-exited:
+			case <-time.After(grace):
+				_ = group.kill()
+			}
+		}
+	}()
+
+	err = cmd.Wait()
+
+	// The script succeeded; only a process it left running kept its output
+	if errors.Is(err, exec.ErrWaitDelay) {
+		err = nil
+	}
+
+	close(exited)
+	<-watched
+	restoreTerminal()
+
+	if ctx.Err() != nil || exitedOnInterrupt(cmd.ProcessState) {
+		// Stop what the script left running, as an interrupt would
+		_ = group.kill()
+
+		return errRunInterrupted
+	}
+
+	if expired.Load() {
+		log.Warn("start: script timed out", "path", cmd.Path, "timeout", timeout)
+
+		return &stepTimeoutError{step: scriptStepName, timeout: timeout}
+	}
+
+	return err
+}
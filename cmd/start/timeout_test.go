@@ -0,0 +1,186 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package start
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testStepTimeout = 50 * time.Millisecond
+
+// hungStep ignores its context, like a stuck download
+func hungStep(_ *Model) tea.Msg {
+	time.Sleep(time.Second)
+
+	return stepCompleteMsg{}
+}
+
+func stepsWithHung() []step {
+	return []step{
+		{description: "start", fn: startQuickstart},
+		{description: "Downloading...", fn: hungStep},
+		{description: "finish", fn: startQuickstart},
+	}
+}
+
+func TestStepTimeoutFailsRun(t *testing.T) {
+	m := Model{steps: stepsWithHung(), opts: Options{DryRun: true, TimeoutPerStep: testStepTimeout}}
+
+	start := time.Now()
+	final := runSteps(t, m)
+
+	var timeout *stepTimeoutError
+
+	require.ErrorAs(t, final.err, &timeout)
+	assert.EqualError(t, final.err, "Downloading timed out after 50ms.")
+	assert.Equal(t, 1, final.current)
+	assert.Less(t, time.Since(start), time.Second)
+	assert.Contains(t, final.View(), "Downloading... (timed out after 50ms)")
+}
+
+func TestStepTimeoutContinueOnError(t *testing.T) {
+	m := Model{steps: stepsWithHung(), opts: Options{DryRun: true, TimeoutPerStep: testStepTimeout, ContinueOnError: true}}
+
+	final := runSteps(t, m)
+
+	require.NoError(t, final.err)
+	assert.True(t, final.done)
+	assert.Equal(t, 2, final.current)
+	assert.Contains(t, final.View(), "Downloading... (timed out after 50ms)")
+	assert.EqualError(t, final.failedStepsError(), "1 step failed: Downloading timed out after 50ms.")
+}
+
+func TestContinueOnErrorStopsAtLastStep(t *testing.T) {
+	fail := func(_ *Model) tea.Msg { return stepErrorMsg{err: errors.New("Script not found.")} }
+	m := Model{
+		steps: []step{{description: "start", fn: startQuickstart}, {description: "run", fn: fail}},
+		opts:  Options{DryRun: true, ContinueOnError: true},
+	}
+
+	final := runSteps(t, m)
+
+	require.EqualError(t, final.err, "Script not found.")
+	assert.NoError(t, final.failedStepsError())
+}
+
+func TestStepContextExpires(t *testing.T) {
+	expired := make(chan error, 1)
+
+	watching := func(m *Model) tea.Msg {
+		<-m.stepContext().Done()
+		expired <- m.stepContext().Err()
+
+		return stepCompleteMsg{}
+	}
+
+	m := Model{steps: []step{{description: "watch", fn: watching}}, opts: Options{TimeoutPerStep: testStepTimeout}}
+	m.runStep(0)
+
+	select {
+	case err := <-expired:
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	case <-time.After(time.Second):
+		t.Fatal("step context did not expire")
+	}
+}
+
+func TestHeadlessStepTimeout(t *testing.T) {
+	m := Model{steps: stepsWithHung(), opts: Options{DryRun: true, TimeoutPerStep: testStepTimeout}, headless: true}
+	result := startResult{}
+
+	err := m.runStepsHeadless(&result)
+	require.EqualError(t, err, "Downloading timed out after 50ms.")
+	require.Len(t, result.Steps, 2)
+	assert.Equal(t, stepStatusFailed, result.Steps[1].Status)
+	assert.Equal(t, "Downloading timed out after 50ms.", result.Steps[1].Message)
+}
+
+func TestHeadlessContinueOnError(t *testing.T) {
+	m := Model{
+		steps:    stepsWithHung(),
+		opts:     Options{DryRun: true, TimeoutPerStep: testStepTimeout, ContinueOnError: true},
+		headless: true,
+	}
+	result := startResult{}
+
+	err := m.runStepsHeadless(&result)
+	require.EqualError(t, err, "1 step failed: Downloading timed out after 50ms.")
+	require.Len(t, result.Steps, 3)
+	assert.Equal(t, stepStatusFailed, result.Steps[1].Status)
+	assert.Equal(t, stepStatusCompleted, result.Steps[2].Status)
+}
+
+func TestContinuedFailureIsNotRecorded(t *testing.T) {
+	setupSessionTest(t)
+
+	m := NewStartModel(Options{Action: ActionQuickstart})
+	m.recordFailure(errors.New("Failed."))
+	m.recordStep()
+
+	assert.False(t, m.session.has(m.currentStep().description))
+}
+
+func TestScriptTimeoutKillsScript(t *testing.T) {
+	path := writeTestScript(t, "echo started\nsleep 30\n")
+	m := Model{
+		steps:                []step{{description: "Finding and executing start command..."}},
+		opts:                 Options{TimeoutPerStep: testStepTimeout},
+		quickstartScriptPath: path,
+	}
+
+	start := time.Now()
+
+	final, _ := runScriptModel(t, m, nil, io.Discard)
+
+	assert.Less(t, time.Since(start), 5*time.Second)
+	assert.EqualError(t, final.err, "Quickstart script timed out after 50ms.")
+	assert.Contains(t, final.View(), "Finding and executing start command... (timed out after 50ms)")
+}
+
+func TestHeadlessScriptTimeout(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell script")
+	}
+
+	script := filepath.Join(t.TempDir(), "quickstart.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\nsleep 30\n"), 0o755))
+
+	m := NewStartModel(Options{
+		Action:           ActionExecuteScript,
+		QuickstartScript: script,
+		AnswerYes:        true,
+		TimeoutPerStep:   200 * time.Millisecond,
+	})
+	result := startResult{}
+
+	start := time.Now()
+	err := m.runStepsHeadless(&result)
+
+	require.EqualError(t, err, "Quickstart script timed out after 200ms.")
+	assert.Less(t, time.Since(start), 5*time.Second)
+	require.Len(t, result.Steps, 1)
+	assert.Equal(t, stepStatusFailed, result.Steps[0].Status)
+}
@@ -23,7 +23,10 @@ import (
 
 func Cmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "task",
+		Use: "task",
+		Example: `  dr task
+  dr task list --all
+  dr task run dev`,
 		GroupID: "core",
 		Short:   "🛠️ Task management commands",
 		Long: `Task management commands for your DataRobot applications.
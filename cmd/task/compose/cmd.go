@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/printer"
 	"github.com/datarobot/cli/internal/task"
 	"github.com/spf13/cobra"
 )
@@ -83,7 +84,7 @@ func createDiscovery(taskfileName string) *task.Discovery {
 	if templatePath == "" {
 		if _, err := os.Stat(autoTemplatePath); err == nil {
 			templatePath = autoTemplatePath
-			fmt.Printf("Using auto-discovered template: %s\n", autoTemplatePath)
+			fmt.Fprintf(printer.Diagnostics, "Using auto-discovered template: %s\n", autoTemplatePath)
 		}
 	}
 
@@ -154,6 +155,8 @@ If a .Taskfile.template file is found in the root directory, it will be used
 automatically to generate a more comprehensive Taskfile with aggregated tasks.
 
 You can also specify a custom template with the --template flag.`,
+		Example: `  dr task compose
+  dr task compose --template ./Taskfile.tmpl.yaml`,
 		Run: Run,
 	}
 
@@ -21,6 +21,7 @@ import (
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/charmbracelet/lipgloss/table"
+	"github.com/datarobot/cli/internal/printer"
 	"github.com/datarobot/cli/internal/task"
 	"github.com/datarobot/cli/tui"
 	"github.com/spf13/cobra"
@@ -259,8 +260,8 @@ func printCategorizedTasks(categories []*Category, showAll bool) error {
 			Border(lipgloss.RoundedBorder()).
 			BorderForeground(tipBorderColor)
 
-		fmt.Println()
-		fmt.Println(tipStyle.Render("💡 Tip: Run 'dr task list --all' to see all available tasks."))
+		fmt.Fprintln(printer.Diagnostics)
+		fmt.Fprintln(printer.Diagnostics, tipStyle.Render("💡 Tip: Run 'dr task list --all' to see all available tasks."))
 	}
 
 	return nil
@@ -275,6 +276,8 @@ func Cmd() *cobra.Command {
 		Use:     "list",
 		Aliases: []string{"l"},
 		Short:   "List tasks",
+		Example: `  dr task list
+  dr task list --all --dir ./my-app`,
 		Run: func(_ *cobra.Command, _ []string) {
 			binaryName := "task"
 			discovery := task.NewTaskDiscovery("Taskfile.gen.yaml")
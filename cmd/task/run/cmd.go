@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/misc/confirm"
 	"github.com/datarobot/cli/internal/task"
 	"github.com/spf13/cobra"
 )
@@ -47,15 +48,14 @@ Common tasks include:
   🚀 deploy           Deploy to DataRobot
   🔍 lint             Check code quality
 
-Examples:
-  dr run dev                    # Start development server
-  dr run build deploy           # Build and deploy
-  dr run test --parallel        # Run tests in parallel
-  dr run --list                 # Show all available tasks
-
 💡 Tasks are defined in your project's 'Taskfile' and vary by template.`,
+		Example: `  dr run dev
+  dr run build deploy
+  dr run test lint --parallel --concurrency 4
+  dr run dev --dir ./my-app --watch`,
 		Run: func(_ *cobra.Command, args []string) {
 			binaryName := "task"
+			opts.taskOpts.AnswerYes = opts.taskOpts.AnswerYes || confirm.AssumeYes()
 			discovery := task.NewTaskDiscovery("Taskfile.gen.yaml")
 
 			rootTaskfile, err := discovery.Discover(opts.Dir, 2)
@@ -123,7 +123,7 @@ Examples:
 	cmd.Flags().BoolVarP(&opts.taskOpts.Parallel, "parallel", "p", false, "⚡ Run multiple tasks simultaneously for faster execution")
 	cmd.Flags().IntVarP(&opts.taskOpts.Concurrency, "concurrency", "C", 2, "🔢 Number of concurrent tasks to run in parallel")
 	cmd.Flags().BoolVarP(&opts.taskOpts.WatchTask, "watch", "w", false, "👀 Watch files and re-run task on changes")
-	cmd.Flags().BoolVarP(&opts.taskOpts.AnswerYes, "yes", "y", false, "🚀 Skip confirmation prompts (useful for automation)")
+	cmd.Flags().BoolVar(&opts.taskOpts.AnswerYes, "yes", false, "🚀 Skip confirmation prompts (useful for automation, same as the global --assume-yes)")
 	cmd.Flags().BoolVarP(&opts.taskOpts.ExitCode, "exit-code", "x", false, "🔄 Pass through the exact exit code from task")
 	cmd.Flags().BoolVarP(&opts.taskOpts.Silent, "silent", "s", false, "🔇 Suppress task output and progress messages")
 
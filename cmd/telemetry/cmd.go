@@ -0,0 +1,45 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"github.com/datarobot/cli/cmd/telemetry/disable"
+	"github.com/datarobot/cli/cmd/telemetry/enable"
+	"github.com/datarobot/cli/cmd/telemetry/status"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "telemetry",
+		GroupID: "self",
+		Short:   "📊 Anonymous usage metrics settings",
+		Long: `Opt in to, or out of, anonymous usage metrics.
+
+Telemetry is off unless you enable it. When enabled, each run records the
+command name, how long it took, and whether it succeeded, to a local log
+in the config directory. Arguments, flag values, tokens, and paths are
+never recorded. Events are sent to a collector only when
+telemetry-endpoint is configured.`,
+	}
+
+	cmd.AddCommand(
+		enable.Cmd(),
+		disable.Cmd(),
+		status.Cmd(),
+	)
+
+	return cmd
+}
@@ -0,0 +1,47 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package disable
+
+import (
+	"fmt"
+
+	"github.com/datarobot/cli/internal/telemetry"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "disable",
+		Short: "Opt out of anonymous usage metrics",
+		Long: `Turn telemetry off in the config file. It applies to every profile.
+
+Events that were recorded but not yet sent are discarded. The local log is
+kept; delete it yourself if you no longer want it.`,
+		Example: `  dr telemetry disable`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.SilenceUsage = true
+
+			if err := telemetry.SetEnabled(false); err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), tui.SuccessStyle.Render("✅ Telemetry disabled."))
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/internal/telemetry"
+	"github.com/spf13/cobra"
+)
+
+func Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "status",
+		Short:   "Show whether telemetry is enabled and where events go",
+		Example: `  dr telemetry status`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmd.SilenceUsage = true
+
+			status, err := telemetry.GetStatus()
+			if err != nil {
+				return err
+			}
+
+			return printer.Print(cmd.OutOrStdout(), status, func(w io.Writer) error {
+				return printText(w, status)
+			})
+		},
+	}
+}
+
+func printText(w io.Writer, status telemetry.Status) error {
+	if !status.Enabled {
+		_, err := fmt.Fprintln(w, "Telemetry is disabled. Run 'dr telemetry enable' to opt in.")
+
+		return err
+	}
+
+	if _, err := fmt.Fprintln(w, "Telemetry is enabled."); err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintf(w, "Local log: %s\n", status.LogFile); err != nil {
+		return err
+	}
+
+	if status.Endpoint == "" {
+		_, err := fmt.Fprintln(w, "Collector: none configured, so events are only kept locally.")
+
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "Collector: %s (%d events waiting to be sent)\n", status.Endpoint, status.Pending)
+
+	return err
+}
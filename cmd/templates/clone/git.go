@@ -17,9 +17,16 @@ package clone
 import (
 	"os/exec"
 	"strings"
+
+	"github.com/datarobot/cli/internal/apiclient"
 )
 
-func gitClone(repoURL, dir, tag string) (string, error) {
+// GitClone makes a shallow clone of repoURL into dir, at tag if one is given
+func GitClone(repoURL, dir, tag string) (string, error) {
+	if err := apiclient.CheckOnline(repoURL); err != nil {
+		return "", err
+	}
+
 	args := []string{"clone", "--depth", "1", "--single-branch"}
 
 	if tag != "" {
@@ -52,6 +59,16 @@ func gitOrigin(dir string) string {
 }
 
 func gitPull(dir string) (string, error) {
+	// Without an origin there is nothing to contact, and git reports that
+	origin := gitOrigin(dir)
+	if origin == "" {
+		origin = dir
+	}
+
+	if err := apiclient.CheckOnline(origin); err != nil {
+		return "", err
+	}
+
 	cmd := exec.Command("git", "pull")
 
 	cmd.Dir = dir
@@ -15,6 +15,7 @@
 package templates
 
 import (
+	"github.com/datarobot/cli/cmd/templates/describe"
 	"github.com/datarobot/cli/cmd/templates/list"
 	"github.com/datarobot/cli/cmd/templates/setup"
 	"github.com/datarobot/cli/internal/version"
@@ -39,7 +40,8 @@ Manage DataRobot AI application templates:
 
 	cmd.AddCommand(
 		// clone.Cmd,  # CFX-3969 disabled for now
-		list.Cmd,
+		list.Cmd(),
+		describe.Cmd(),
 		setup.Cmd,
 	)
 
@@ -0,0 +1,140 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package describe
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+// notSpecified is shown for metadata the template does not provide
+const notSpecified = "not specified"
+
+func Run(w io.Writer, name string) error {
+	catalog, err := drapi.GetCatalog(false)
+	if err != nil {
+		return err
+	}
+
+	template, err := drapi.FindTemplate(catalog.Templates, name)
+	if err != nil {
+		return err
+	}
+
+	return printer.Print(w, template, func(w io.Writer) error {
+		return printTemplate(w, template)
+	})
+}
+
+func printTemplate(w io.Writer, template drapi.Template) error {
+	fmt.Fprintf(w, "%s (%s)\n", template.Name, template.ID)
+
+	if description := strings.TrimSpace(template.Description); description != "" {
+		fmt.Fprintf(w, "\n%s\n", description)
+	}
+
+	fmt.Fprintln(w)
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "Language:\t%s\n", orNotSpecified(template.LanguageName()))
+
+	updated := notSpecified
+	if lastUpdated := template.LastUpdated(); !lastUpdated.IsZero() {
+		updated = lastUpdated.Local().Format(time.DateOnly)
+	}
+
+	fmt.Fprintf(tw, "Last updated:\t%s\n", updated)
+	fmt.Fprintf(tw, "Repository:\t%s\n", orNotSpecified(template.Repository.URL))
+	fmt.Fprintf(tw, "Entrypoint:\t%s\n", orNotSpecified(template.Entrypoint))
+	fmt.Fprintf(tw, "Setup time:\t%s\n", orNotSpecified(template.SetupTime))
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w, "\nPrerequisites:")
+
+	if len(template.Prerequisites) == 0 {
+		fmt.Fprintln(w, "  None listed")
+	}
+
+	for _, prerequisite := range template.Prerequisites {
+		fmt.Fprintf(w, "  • %s\n", prerequisite)
+	}
+
+	fmt.Fprintln(w, "\nEnvironment variables:")
+
+	if len(template.EnvVars) == 0 {
+		fmt.Fprintln(w, "  None listed")
+
+		return nil
+	}
+
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	for _, envVar := range template.EnvVars {
+		required := "optional"
+		if envVar.Required {
+			required = "required"
+		}
+
+		fmt.Fprintf(tw, "  %s\t%s\t%s\n", envVar.Name, required, envVar.Description)
+	}
+
+	return tw.Flush()
+}
+
+func orNotSpecified(value string) string {
+	if value == "" {
+		return notSpecified
+	}
+
+	return value
+}
+
+func Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "describe NAME",
+		Short: "🔍 Show the details of an AI application template",
+		Long: `Show the details of an AI application template before setting it up.
+
+NAME is the template's name or ID, as shown by 'dr templates list'. The
+details include the environment variables the template needs, the script
+the quickstart runs, the estimated setup time, and any prerequisites.`,
+		Example: `  dr templates describe "Talk to my docs"
+  dr templates describe "Talk to my docs" --output json`,
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			// Local templates or a cached catalog can be described without contacting the server
+			if drapi.UsesLocalTemplates() || drapi.HasCachedCatalog() {
+				return nil
+			}
+
+			return auth.EnsureAuthenticatedE(cmd, args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return Run(cmd.OutOrStdout(), args[0])
+		},
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package describe
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrintTemplate(t *testing.T) {
+	template := drapi.Template{
+		ID:            "t1",
+		Name:          "Talk to my docs",
+		Description:   "Chat with your documents.",
+		Tags:          []string{"Python"},
+		Entrypoint:    ".datarobot/cli/bin/quickstart.py",
+		SetupTime:     "about 10 minutes",
+		Prerequisites: []string{"uv"},
+		EnvVars: []drapi.TemplateEnvVar{
+			{Name: "OPENAI_API_KEY", Description: "Key for the LLM provider", Required: true},
+		},
+	}
+
+	var out bytes.Buffer
+
+	require.NoError(t, printTemplate(&out, template))
+
+	assert.Contains(t, out.String(), "Talk to my docs (t1)")
+	assert.Contains(t, out.String(), "Language:      Python")
+	assert.Contains(t, out.String(), "Entrypoint:    .datarobot/cli/bin/quickstart.py")
+	assert.Contains(t, out.String(), "Setup time:    about 10 minutes")
+	assert.Contains(t, out.String(), "Last updated:  not specified")
+	assert.Contains(t, out.String(), "  • uv")
+	assert.Regexp(t, `OPENAI_API_KEY\s+required\s+Key for the LLM provider`, out.String())
+}
+
+func TestPrintTemplateWithoutMetadata(t *testing.T) {
+	var out bytes.Buffer
+
+	require.NoError(t, printTemplate(&out, drapi.Template{ID: "t2", Name: "Bare"}))
+
+	assert.Contains(t, out.String(), "Entrypoint:    not specified")
+	assert.Contains(t, out.String(), "Prerequisites:\n  None listed")
+	assert.Contains(t, out.String(), "Environment variables:\n  None listed")
+}
@@ -15,46 +15,116 @@
 package list
 
 import (
-	"fmt"
+	"io"
+	"strings"
+	"time"
 
 	"github.com/datarobot/cli/internal/auth"
 	"github.com/datarobot/cli/internal/drapi"
-	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/printer"
 	"github.com/spf13/cobra"
 )
 
-func Run() error {
-	templateList, err := drapi.GetTemplates()
+// maxDescriptionWidth keeps each template on a single table row
+const maxDescriptionWidth = 60
+
+type options struct {
+	refresh bool
+	fields  []string
+}
+
+// columns are the table columns, in their default order
+var columns = []printer.Column[drapi.Template]{
+	{Field: "name", Header: "NAME", Value: func(t drapi.Template) string { return t.Name }},
+	{Field: "language", Header: "LANGUAGE", Value: languageText},
+	{Field: "editedAt", Header: "LAST UPDATED", Value: updatedText},
+	{Field: "description", Header: "DESCRIPTION", Value: func(t drapi.Template) string { return summary(t.Description) }},
+}
+
+func languageText(template drapi.Template) string {
+	if language := template.LanguageName(); language != "" {
+		return language
+	}
+
+	return "-"
+}
+
+func updatedText(template drapi.Template) string {
+	if lastUpdated := template.LastUpdated(); !lastUpdated.IsZero() {
+		return lastUpdated.Local().Format(time.DateOnly)
+	}
+
+	return "-"
+}
+
+func Run(w io.Writer, opts options) error {
+	fields, err := printer.ParseFields(opts.fields, drapi.Template{})
+	if err != nil {
+		return err
+	}
+
+	catalog, err := drapi.GetCatalog(opts.refresh)
 	if err != nil {
 		return err
 	}
 
-	for _, template := range templateList.Templates {
-		fmt.Printf("ID: %s\tName: %s\n", template.ID, template.Name)
+	selected, err := fields.Select(catalog.Templates)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	return printer.Print(w, selected, func(w io.Writer) error {
+		return printer.WriteTable(w, printer.SelectColumns(columns, fields), catalog.Templates)
+	})
 }
 
-var Cmd = &cobra.Command{
-	Use:   "list",
-	Short: "📋 List all available AI application templates",
-	Long: `List all available AI application templates from DataRobot.
+// summary returns the first line of a description, shortened to fit the
+// table
+func summary(description string) string {
+	description, _, _ = strings.Cut(strings.TrimSpace(description), "\n")
+
+	runes := []rune(description)
+	if len(runes) <= maxDescriptionWidth {
+		return description
+	}
+
+	return strings.TrimSpace(string(runes[:maxDescriptionWidth-1])) + "…"
+}
+
+func Cmd() *cobra.Command {
+	var opts options
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "📋 List all available AI application templates",
+		Long: `List all available AI application templates from DataRobot.
 
 This command shows you all the pre-built templates you can use to quickly
-start building AI applications. Each template includes:
-  • Complete application structure
-  • Pre-configured components
-  • Documentation and examples
-  • Ready-to-deploy setup
+start building AI applications, with their language, when they were last
+updated, and a short description.
+
+The catalog is cached locally for 24 hours (see templates-cache-ttl), so it
+stays available offline. Use --refresh to fetch it again.
 
 💡 Use 'dr templates setup' for an interactive selection experience.`,
-	PreRunE: auth.EnsureAuthenticatedE,
-	Run: func(_ *cobra.Command, _ []string) {
-		err := Run()
-		if err != nil {
-			log.Fatal(err)
-			return
-		}
-	},
+		Example: `  dr templates list
+  dr templates list --refresh
+  dr templates list --fields name,language --output json`,
+		PreRunE: func(cmd *cobra.Command, args []string) error {
+			// Local templates or a cached catalog can be listed without contacting the server
+			if drapi.UsesLocalTemplates() || (!opts.refresh && drapi.HasCachedCatalog()) {
+				return nil
+			}
+
+			return auth.EnsureAuthenticatedE(cmd, args)
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return Run(cmd.OutOrStdout(), opts)
+		},
+	}
+
+	cmd.Flags().BoolVar(&opts.refresh, "refresh", false, "Fetch the catalog even if a cached copy is still fresh")
+	cmd.Flags().StringSliceVar(&opts.fields, "fields", nil, "Comma-separated fields to show, such as name,language")
+
+	return cmd
 }
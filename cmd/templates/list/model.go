@@ -20,6 +20,7 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/datarobot/cli/internal/drapi"
@@ -30,12 +31,14 @@ var docStyle = lipgloss.NewStyle().Margin(3, 2)
 
 type Model struct {
 	list       list.Model
+	filter     textinput.Model
+	templates  []drapi.Template
 	Template   drapi.Template
 	SuccessCmd tea.Cmd
 }
 
 func (m Model) Init() tea.Cmd {
-	return tea.WindowSize()
+	return tea.Batch(tea.WindowSize(), textinput.Blink)
 }
 
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
@@ -51,12 +54,33 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			}
 
 			return m, nil
+		case "esc":
+			// Esc clears the filter first, and only then leaves the list
+			if m.filter.Value() != "" {
+				m.filter.SetValue("")
+				m.applyFilter()
+
+				return m, nil
+			}
+		}
+
+		if filterKey(msg) {
+			var cmd tea.Cmd
+
+			previous := m.filter.Value()
+			m.filter, cmd = m.filter.Update(msg)
+
+			if m.filter.Value() != previous {
+				m.applyFilter()
+			}
+
+			return m, cmd
 		}
 
 	case tea.WindowSizeMsg:
-		if len(m.list.Items()) > 0 {
+		if len(m.templates) > 0 {
 			h, v := docStyle.GetFrameSize()
-			m.list.SetSize(msg.Width-h, msg.Height-v)
+			m.list.SetSize(msg.Width-h, msg.Height-v-lipgloss.Height(m.filterView()))
 		}
 
 		return m, nil
@@ -69,8 +93,65 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	return m, cmd
 }
 
+// filterKey tells whether msg edits the filter rather than moving through
+// the list. Typed characters always go to the filter, so that letters such
+// as j, k, and q narrow the list instead of navigating or quitting.
+func filterKey(msg tea.KeyMsg) bool {
+	switch msg.Type {
+	case tea.KeyRunes, tea.KeySpace, tea.KeyBackspace, tea.KeyDelete,
+		tea.KeyLeft, tea.KeyRight, tea.KeyCtrlW, tea.KeyCtrlU, tea.KeyCtrlK:
+		return true
+	default:
+		return false
+	}
+}
+
+// applyFilter shows the templates that fuzzy match the filter on their name
+// or description, best matches first
+func (m *Model) applyFilter() {
+	term := strings.TrimSpace(m.filter.Value())
+
+	items := make([]list.Item, 0, len(m.templates))
+
+	if term == "" {
+		for _, t := range m.templates {
+			items = append(items, t)
+		}
+	} else {
+		targets := make([]string, len(m.templates))
+		for i, t := range m.templates {
+			targets[i] = t.FilterValue()
+		}
+
+		for _, rank := range list.DefaultFilter(term, targets) {
+			items = append(items, m.templates[rank.Index])
+		}
+	}
+
+	m.list.SetItems(items)
+	m.list.ResetSelected()
+}
+
+// NoMatches tells whether the filter hides every template
+func (m Model) NoMatches() bool {
+	return len(m.templates) > 0 && len(m.list.Items()) == 0
+}
+
+func (m Model) filterView() string {
+	return m.filter.View() + "\n"
+}
+
 func (m Model) View() string {
-	return docStyle.Render(m.list.View())
+	if m.NoMatches() {
+		return docStyle.Render(lipgloss.JoinVertical(lipgloss.Left,
+			m.filterView(),
+			m.list.Styles.Title.Render(m.list.Title),
+			"",
+			tui.DimStyle.Render(fmt.Sprintf("No templates match %q. Press Esc to clear the filter.", m.filter.Value())),
+		))
+	}
+
+	return docStyle.Render(lipgloss.JoinVertical(lipgloss.Left, m.filterView(), m.list.View()))
 }
 
 func (m *Model) SetTemplates(templates []drapi.Template) {
@@ -82,8 +163,35 @@ func (m *Model) SetTemplates(templates []drapi.Template) {
 	nl := list.New(items, itemDelegate{}, 0, 0)
 	nl.Title = "📚 Choose Your AI Application Template"
 	nl.Styles.Title = nl.Styles.Title.Background(tui.DrPurple)
+	nl.SetStatusBarItemName("template", "templates")
+	// Filtering is done by the filter input above the list, which takes
+	// every typed character, so the list only keeps its non-letter keys
+	nl.SetFilteringEnabled(false)
+	nl.KeyMap.CursorUp.SetKeys("up")
+	nl.KeyMap.CursorUp.SetHelp("↑", "up")
+	nl.KeyMap.CursorDown.SetKeys("down")
+	nl.KeyMap.CursorDown.SetHelp("↓", "down")
+	nl.KeyMap.PrevPage.SetKeys("pgup")
+	nl.KeyMap.PrevPage.SetHelp("pgup", "prev page")
+	nl.KeyMap.NextPage.SetKeys("pgdown")
+	nl.KeyMap.NextPage.SetHelp("pgdown", "next page")
+	nl.KeyMap.GoToStart.SetKeys("home")
+	nl.KeyMap.GoToStart.SetHelp("home", "go to start")
+	nl.KeyMap.GoToEnd.SetKeys("end")
+	nl.KeyMap.GoToEnd.SetHelp("end", "go to end")
+	nl.KeyMap.Quit.SetKeys("esc")
+	nl.KeyMap.Quit.SetHelp("esc", "quit")
+	nl.KeyMap.ShowFullHelp.Unbind()
+	nl.KeyMap.CloseFullHelp.Unbind()
+
+	filter := textinput.New()
+	filter.Prompt = "🔎 "
+	filter.Placeholder = "Type to filter templates by name or description"
+	filter.Focus()
 
 	m.list = nl
+	m.filter = filter
+	m.templates = templates
 }
 
 var (
@@ -0,0 +1,107 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package list
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/datarobot/cli/internal/drapi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testModel() Model {
+	m := Model{}
+	m.SetTemplates([]drapi.Template{
+		{Name: "Talk to My Docs", Description: "Chat with your documents", Repository: drapi.Repository{URL: "https://example.com/docs"}},
+		{Name: "Forecast Assistant", Description: "Time series predictions", Repository: drapi.Repository{URL: "https://example.com/forecast"}},
+		{Name: "Guarded RAG", Description: "Retrieval with guard models", Repository: drapi.Repository{URL: "https://example.com/rag"}},
+	})
+
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 60})
+
+	return m
+}
+
+func typeText(m Model, text string) Model {
+	for _, r := range text {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+
+	return m
+}
+
+func visibleNames(m Model) []string {
+	var names []string
+
+	for _, item := range m.list.Items() {
+		names = append(names, item.(drapi.Template).Name)
+	}
+
+	return names
+}
+
+func TestFilterMatchesNameAndDescription(t *testing.T) {
+	m := typeText(testModel(), "forecast")
+	assert.Equal(t, []string{"Forecast Assistant"}, visibleNames(m))
+
+	m = typeText(testModel(), "retrieval")
+	assert.Equal(t, []string{"Guarded RAG"}, visibleNames(m))
+}
+
+func TestFilterTakesNavigationLetters(t *testing.T) {
+	// j, k, and q go to the filter instead of moving or quitting
+	m, cmd := testModel().Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("q")})
+	assert.Equal(t, "q", m.filter.Value())
+
+	if cmd != nil {
+		_, quit := cmd().(tea.QuitMsg)
+		assert.False(t, quit)
+	}
+}
+
+func TestFilterSelectsBestMatch(t *testing.T) {
+	var selected bool
+
+	m := testModel()
+	m.SuccessCmd = func() tea.Msg {
+		selected = true
+
+		return nil
+	}
+
+	m = typeText(m, "rag")
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	cmd()
+
+	assert.True(t, selected)
+	assert.Equal(t, "Guarded RAG", m.Template.Name)
+}
+
+func TestFilterNoMatches(t *testing.T) {
+	m := typeText(testModel(), "zzz")
+	assert.True(t, m.NoMatches())
+	assert.Contains(t, m.View(), `No templates match "zzz".`)
+
+	// Enter does nothing without a match, and Esc clears the filter
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	assert.Nil(t, cmd)
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	assert.False(t, m.NoMatches())
+	assert.Len(t, visibleNames(m), 3)
+}
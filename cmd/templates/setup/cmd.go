@@ -38,6 +38,7 @@ var Cmd = &cobra.Command{
 🎉 You'll have a working AI app at the end
 
 💡 Perfect for first-time users or someone starting a new project.`,
+	Example: `  dr templates setup`,
 	PreRunE: auth.EnsureAuthenticatedE,
 	RunE: func(cmd *cobra.Command, _ []string) error {
 		return RunTea(cmd.Context(), false)
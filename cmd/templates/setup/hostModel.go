@@ -23,6 +23,7 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/datarobot/cli/internal/config"
 	"github.com/datarobot/cli/tui"
 )
 
@@ -90,33 +91,24 @@ type HostModel struct {
 }
 
 func NewHostModel() HostModel {
-	items := []list.Item{
-		hostItem{
-			title:       "🇺🇸 US Cloud",
-			description: "https://app.datarobot.com",
-			url:         "https://app.datarobot.com",
-			isCustom:    false,
-		},
-		hostItem{
-			title:       "🇪🇺 EU Cloud",
-			description: "https://app.eu.datarobot.com",
-			url:         "https://app.eu.datarobot.com",
-			isCustom:    false,
-		},
-		hostItem{
-			title:       "🇯🇵 Japan Cloud",
-			description: "https://app.jp.datarobot.com",
-			url:         "https://app.jp.datarobot.com",
+	items := make([]list.Item, 0, len(config.Regions)+1)
+
+	for _, region := range config.Regions {
+		items = append(items, hostItem{
+			title:       region.Title(),
+			description: region.URL,
+			url:         region.URL,
 			isCustom:    false,
-		},
-		hostItem{
-			title:       "🏢 Custom/On-Prem",
-			description: "Enter your custom DataRobot URL",
-			url:         "",
-			isCustom:    true,
-		},
+		})
 	}
 
+	items = append(items, hostItem{
+		title:       "🏢 Custom/On-Prem",
+		description: "Enter your custom DataRobot URL",
+		url:         "",
+		isCustom:    true,
+	})
+
 	delegate := hostItemDelegate{}
 	l := list.New(items, delegate, 0, 0)
 	l.Title = "DataRobot Environment"
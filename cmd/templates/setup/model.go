@@ -32,6 +32,7 @@ import (
 	"github.com/datarobot/cli/cmd/dotenv"
 	"github.com/datarobot/cli/cmd/templates/clone"
 	"github.com/datarobot/cli/cmd/templates/list"
+	"github.com/datarobot/cli/internal/apiclient"
 	"github.com/datarobot/cli/internal/config"
 	"github.com/datarobot/cli/internal/drapi"
 	"github.com/datarobot/cli/internal/log"
@@ -242,7 +243,7 @@ func networkTimeoutMsg() tea.Msg {
 
 	message := tui.BaseTextStyle.Render("❌ Connection to ") +
 		tui.InfoStyle.Render(datarobotHost) +
-		tui.BaseTextStyle.Render(" timed out. Check your network and try again.")
+		tui.BaseTextStyle.Render(fmt.Sprintf(" timed out after %s. Check your network and try again, or raise the limit with --timeout.", apiclient.RequestTimeout()))
 
 	return exitMsg{message}
 }
@@ -336,7 +337,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) { //nolint: cyclop
 	case tea.KeyMsg:
 		switch keypress := msg.String(); keypress {
 		case "q":
-			if m.screen != cloneScreen && m.screen != dotenvScreen {
+			// On the template list, q is typed into the filter
+			if m.screen != cloneScreen && m.screen != dotenvScreen && m.screen != listScreen {
 				return m, tea.Quit
 			}
 		}
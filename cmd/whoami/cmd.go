@@ -0,0 +1,97 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package whoami
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/auth"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/spf13/cobra"
+)
+
+// errNotLoggedIn is returned, for exit code 1, when there are no working
+// credentials
+var errNotLoggedIn = errors.New("Not logged in. Run 'dr auth login' to authenticate.")
+
+// identity is the structured form of the authenticated user
+type identity struct {
+	Username     string `json:"username"               yaml:"username"`
+	Organization string `json:"organization,omitempty" yaml:"organization,omitempty"`
+	Endpoint     string `json:"endpoint"               yaml:"endpoint"`
+}
+
+func (i identity) printText(w io.Writer) error {
+	var err error
+
+	if i.Organization == "" {
+		_, err = fmt.Fprintf(w, "%s on %s\n", i.Username, i.Endpoint)
+	} else {
+		_, err = fmt.Fprintf(w, "%s (%s) on %s\n", i.Username, i.Organization, i.Endpoint)
+	}
+
+	return err
+}
+
+func Run(w io.Writer) error {
+	creds, err := auth.ResolveCredentials()
+	if err != nil {
+		return err
+	}
+
+	endpoint, err := apiclient.NormalizeEndpoint(creds.Endpoint)
+	if err != nil || creds.Token == "" {
+		return errNotLoggedIn
+	}
+
+	creds.Endpoint = endpoint
+
+	info, err := auth.CachedAccountInfo(creds)
+	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			return fmt.Errorf("Connection to %s timed out. Check your network and try again.", endpoint)
+		}
+
+		return fmt.Errorf("%w (%w)", errNotLoggedIn, err)
+	}
+
+	result := identity{Username: info.Username, Organization: info.OrgName, Endpoint: endpoint}
+
+	return printer.Print(w, result, result.printText)
+}
+
+func Cmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "whoami",
+		GroupID: "core",
+		Short:   "👤 Show who you are logged in as",
+		Long: `Print the authenticated username, organization, and endpoint on one line.
+
+This is a quicker form of 'dr auth status'. The answer is cached for a few
+minutes, so repeated runs don't each contact DataRobot. Exits with status 1
+if you are not logged in.`,
+		Example: `  dr whoami
+  dr whoami -o json`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return Run(cmd.OutOrStdout())
+		},
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package apiclient provides the HTTP client shared by every outbound call
+// the CLI makes, so transport behavior such as retries is configured in
+// one place.
+package apiclient
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/datarobot/cli/internal/log"
+	"github.com/spf13/viper"
+)
+
+// DefaultTimeout bounds a request end to end, including retries
+const DefaultTimeout = 30 * time.Second
+
+// New returns an HTTP client with the shared transport. A zero timeout
+// means the configured request timeout. When the timeout expires, the
+// error names the URL and the duration, and matches
+// context.DeadlineExceeded.
+func New(timeout time.Duration) *http.Client {
+	if timeout == 0 {
+		timeout = RequestTimeout()
+	}
+
+	return &http.Client{
+		Transport: timeoutTransport{base: Transport(), timeout: timeout},
+	}
+}
+
+// Transport returns the shared round tripper: requests go through the
+// configured proxy and TLS settings, are retried per the retry settings,
+// every attempt waits its turn under --rate-limit, and every attempt is
+// logged at trace level and to the trace file. If the TLS or rate limit
+// settings are invalid, every request fails with that error. In offline
+// mode every request fails at once with an OfflineError.
+func Transport() http.RoundTripper {
+	if Offline() {
+		return offlineTransport{}
+	}
+
+	tlsCfg, err := tlsConfig()
+	if err != nil {
+		return errTransport{err: err}
+	}
+
+	rate, err := RateLimit()
+	if err != nil {
+		return errTransport{err: err}
+	}
+
+	base := http.DefaultTransport.(*http.Transport).Clone()
+	base.Proxy = proxyFunc()
+	base.TLSClientConfig = tlsCfg
+
+	var attempt http.RoundTripper = traceTransport{base: log.HTTPTransport(base)}
+
+	if rate > 0 {
+		attempt = rateLimitTransport{base: attempt, rate: rate}
+	}
+
+	return retryTransport{base: attempt}
+}
+
+// CheckConfig reports whether the proxy, TLS, and rate limit settings are
+// usable without making a request
+func CheckConfig() error {
+	if _, err := tlsConfig(); err != nil {
+		return err
+	}
+
+	if _, err := RateLimit(); err != nil {
+		return err
+	}
+
+	if proxy := viper.GetString(ProxyKey); proxy != "" {
+		return validateProxy(proxy)
+	}
+
+	return nil
+}
+
+// errTransport fails every request with a configuration error
+type errTransport struct {
+	err error
+}
+
+func (t errTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	return nil, t.err
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclient
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// APIPath is the path prefix of the DataRobot public API
+const APIPath = "/api/v2"
+
+// EndpointError reports an endpoint that cannot be turned into a
+// DataRobot URL
+type EndpointError struct {
+	Endpoint string
+	Reason   string
+}
+
+func (e *EndpointError) Error() string {
+	return fmt.Sprintf("Invalid endpoint %q: %s.", e.Endpoint, e.Reason)
+}
+
+// NormalizeEndpoint turns the forms users paste, such as
+// "app.datarobot.com", "https://app.datarobot.com/" or
+// "https://app.datarobot.com/api/v2", into the base URL of the instance:
+// https scheme when none is given, and no trailing slash or /api/v2 suffix.
+// A path prefix in front of /api/v2 is kept for installs behind a proxy.
+func NormalizeEndpoint(endpoint string) (string, error) {
+	raw := strings.TrimSpace(endpoint)
+	if raw == "" {
+		return "", &EndpointError{Endpoint: endpoint, Reason: "a URL is required"}
+	}
+
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return "", &EndpointError{Endpoint: endpoint, Reason: "it is not a valid URL"}
+	}
+
+	if parsed.Scheme != "https" && parsed.Scheme != "http" {
+		return "", &EndpointError{Endpoint: endpoint, Reason: "the scheme must be https or http"}
+	}
+
+	if parsed.Hostname() == "" {
+		return "", &EndpointError{Endpoint: endpoint, Reason: "a host is required"}
+	}
+
+	if parsed.User != nil {
+		return "", &EndpointError{Endpoint: endpoint, Reason: "credentials must not be part of the URL"}
+	}
+
+	path := strings.TrimRight(parsed.Path, "/")
+	path = strings.TrimSuffix(path, APIPath)
+
+	base := url.URL{Scheme: parsed.Scheme, Host: parsed.Host, Path: strings.TrimRight(path, "/")}
+
+	return base.String(), nil
+}
+
+// APIURL returns the URL of an API route, such as "/account/info/", on the
+// given endpoint. /api/v2 is appended once whether or not the endpoint
+// already ends with it.
+func APIURL(endpoint, route string) (string, error) {
+	base, err := NormalizeEndpoint(endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	if route != "" && !strings.HasPrefix(route, "/") {
+		route = "/" + route
+	}
+
+	return base + APIPath + route, nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/datarobot/cli/internal/errs"
+	"github.com/spf13/viper"
+)
+
+// OfflineKey is the viper key that turns on offline mode, settable via
+// --offline or DATAROBOT_CLI_OFFLINE. Offline, no request leaves the
+// machine and cached data is used where there is some.
+const OfflineKey = "offline"
+
+// ErrOffline matches every error caused by offline mode
+var ErrOffline = errors.New("offline mode")
+
+// Offline reports whether offline mode is on
+func Offline() bool {
+	return viper.GetBool(OfflineKey)
+}
+
+// OfflineError reports a connection that offline mode did not allow. It
+// matches ErrOffline with errors.Is.
+type OfflineError struct {
+	Target string
+}
+
+func (e *OfflineError) Error() string {
+	return fmt.Sprintf("offline mode: not connecting to %s; run without --offline to allow it", e.Target)
+}
+
+func (e *OfflineError) Unwrap() error { return ErrOffline }
+
+func (*OfflineError) ExitCode() int { return errs.ExitCodeNetwork }
+
+// CheckOnline returns an OfflineError for target in offline mode. It
+// guards network access that does not go through Transport, such as git.
+// Local paths and file URLs are always allowed.
+func CheckOnline(target string) error {
+	if !Offline() || isLocal(target) {
+		return nil
+	}
+
+	return &OfflineError{Target: target}
+}
+
+func isLocal(target string) bool {
+	if strings.HasPrefix(target, "file://") {
+		return true
+	}
+
+	_, err := os.Stat(target)
+
+	return err == nil
+}
+
+// offlineTransport fails every request without sending it
+type offlineTransport struct{}
+
+func (offlineTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+
+	return nil, &OfflineError{Target: hostOf(req.URL)}
+}
+
+func hostOf(u *url.URL) string {
+	if u.Host == "" {
+		return u.String()
+	}
+
+	return u.Host
+}
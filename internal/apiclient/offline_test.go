@@ -0,0 +1,78 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclient
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/datarobot/cli/internal/errs"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOfflineBlocksRequests(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.Set(OfflineKey, true)
+
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		requests++
+	}))
+	t.Cleanup(server.Close)
+
+	resp, err := New(0).Get(server.URL)
+	if resp != nil {
+		resp.Body.Close()
+	}
+
+	require.Error(t, err)
+	require.ErrorIs(t, err, ErrOffline)
+	assert.Contains(t, err.Error(), "offline mode")
+	assert.Equal(t, errs.ExitCodeNetwork, errs.ExitCode(err))
+	assert.Zero(t, requests, "no request should be sent in offline mode")
+}
+
+func TestOnlineAllowsRequests(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	t.Cleanup(server.Close)
+
+	resp, err := New(0).Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+}
+
+func TestCheckOnline(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	require.NoError(t, CheckOnline("https://github.com/datarobot/example.git"))
+
+	viper.Set(OfflineKey, true)
+
+	err := CheckOnline("https://github.com/datarobot/example.git")
+	require.ErrorIs(t, err, ErrOffline)
+	assert.Contains(t, err.Error(), "github.com/datarobot/example.git")
+
+	assert.NoError(t, CheckOnline(t.TempDir()), "local paths need no network")
+	assert.NoError(t, CheckOnline("file:///srv/templates/example.git"))
+}
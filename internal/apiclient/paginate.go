@@ -0,0 +1,167 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclient
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strconv"
+)
+
+// ErrStopPaging can be returned by a page callback to stop Paginate
+// without an error, e.g. once enough filtered items are collected
+var ErrStopPaging = errors.New("Stop paging.")
+
+// ErrMaxPages is returned by Paginate when it stops at MaxPages while the
+// server still has more pages. The items already delivered are valid.
+var ErrMaxPages = errors.New("Stopped at the page limit; results are truncated.")
+
+// DefaultMaxPages is the page cap list commands use unless told otherwise
+const DefaultMaxPages = 50
+
+// Page is one page of a DataRobot list response
+type Page[T any] struct {
+	Data       []T    `json:"data"`
+	Count      int    `json:"count"`
+	TotalCount int    `json:"totalCount"`
+	Next       string `json:"next"`
+	Previous   string `json:"previous"`
+}
+
+// FetchFunc requests url and decodes its JSON body into v
+type FetchFunc func(ctx context.Context, url string, v any) error
+
+// PageOptions controls Paginate
+type PageOptions struct {
+	// Limit caps the items passed to the callback across all pages; 0
+	// means no cap
+	Limit int
+	// PageSize is sent as the limit query parameter when the start URL
+	// has none; 0 leaves the server default
+	PageSize int
+	// MaxPages caps the pages fetched; 0 means no cap
+	MaxPages int
+}
+
+// Paginate fetches pages starting at start and calls fn with each page's
+// items. It follows the next link, or, for endpoints that return a
+// totalCount without one, advances the offset query parameter. It stops
+// when there are no more pages, Limit items have been delivered, fn
+// returns ErrStopPaging, or ctx is done. If it stops at MaxPages with
+// pages left, it returns ErrMaxPages.
+func Paginate[T any](ctx context.Context, fetch FetchFunc, start string, opts PageOptions, fn func(items []T) error) error {
+	next, err := withPageSize(start, opts.PageSize)
+	if err != nil {
+		return err
+	}
+
+	delivered, pages := 0, 0
+
+	for next != "" {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		var page Page[T]
+
+		if err := fetch(ctx, next, &page); err != nil {
+			return err
+		}
+
+		items := page.Data
+		if opts.Limit > 0 && delivered+len(items) > opts.Limit {
+			items = items[:opts.Limit-delivered]
+		}
+
+		if err := fn(items); err != nil {
+			if errors.Is(err, ErrStopPaging) {
+				return nil
+			}
+
+			return err
+		}
+
+		delivered += len(items)
+		pages++
+
+		if opts.Limit > 0 && delivered >= opts.Limit {
+			return nil
+		}
+
+		next, err = nextPage(next, page.Next, len(page.Data), page.TotalCount)
+		if err != nil {
+			return err
+		}
+
+		if next != "" && opts.MaxPages > 0 && pages >= opts.MaxPages {
+			return ErrMaxPages
+		}
+	}
+
+	return nil
+}
+
+func withPageSize(start string, pageSize int) (string, error) {
+	if pageSize <= 0 {
+		return start, nil
+	}
+
+	parsed, err := url.Parse(start)
+	if err != nil {
+		return "", err
+	}
+
+	query := parsed.Query()
+	if query.Has("limit") {
+		return start, nil
+	}
+
+	query.Set("limit", strconv.Itoa(pageSize))
+	parsed.RawQuery = query.Encode()
+
+	return parsed.String(), nil
+}
+
+// nextPage returns the URL after current: the server's next link if it
+// sent one, otherwise current with its offset advanced past the received
+// items while totalCount says more remain, otherwise ""
+func nextPage(current, next string, received, totalCount int) (string, error) {
+	if next != "" {
+		return next, nil
+	}
+
+	if received == 0 || totalCount == 0 {
+		return "", nil
+	}
+
+	parsed, err := url.Parse(current)
+	if err != nil {
+		return "", err
+	}
+
+	query := parsed.Query()
+	offset, _ := strconv.Atoi(query.Get("offset"))
+	offset += received
+
+	if offset >= totalCount {
+		return "", nil
+	}
+
+	query.Set("offset", strconv.Itoa(offset))
+	parsed.RawQuery = query.Encode()
+
+	return parsed.String(), nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// pagedServer serves items 1 to 7 three at a time, so there are three
+// pages. With links it sends next links; without, only totalCount.
+func pagedServer(t *testing.T, links bool) (*httptest.Server, *int) {
+	t.Helper()
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.Set(MaxRetriesKey, 0)
+
+	const total = 7
+
+	requests := 0
+
+	var server *httptest.Server
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		assert.Equal(t, "3", r.URL.Query().Get("limit"))
+
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		page := Page[int]{TotalCount: total}
+
+		for i := offset; i < min(offset+3, total); i++ {
+			page.Data = append(page.Data, i+1)
+		}
+
+		if links && offset+3 < total {
+			page.Next = fmt.Sprintf("%s/items/?limit=3&offset=%d", server.URL, offset+3)
+		}
+
+		_ = json.NewEncoder(w).Encode(page)
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func fetchJSON(ctx context.Context, url string, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := New(0).Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func collect(t *testing.T, ctx context.Context, start string, opts PageOptions) ([][]int, error) {
+	t.Helper()
+
+	var pages [][]int
+
+	err := Paginate(ctx, fetchJSON, start, opts, func(items []int) error {
+		pages = append(pages, items)
+
+		return nil
+	})
+
+	return pages, err
+}
+
+func TestPaginateFollowsNextLinks(t *testing.T) {
+	server, requests := pagedServer(t, true)
+
+	pages, err := collect(t, context.Background(), server.URL+"/items/", PageOptions{PageSize: 3})
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]int{{1, 2, 3}, {4, 5, 6}, {7}}, pages)
+	assert.Equal(t, 3, *requests)
+}
+
+func TestPaginateAdvancesOffsetWithoutLinks(t *testing.T) {
+	server, requests := pagedServer(t, false)
+
+	pages, err := collect(t, context.Background(), server.URL+"/items/?limit=3", PageOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]int{{1, 2, 3}, {4, 5, 6}, {7}}, pages)
+	assert.Equal(t, 3, *requests)
+}
+
+func TestPaginateStopsAtLimit(t *testing.T) {
+	server, requests := pagedServer(t, true)
+
+	pages, err := collect(t, context.Background(), server.URL+"/items/", PageOptions{PageSize: 3, Limit: 4})
+	require.NoError(t, err)
+
+	assert.Equal(t, [][]int{{1, 2, 3}, {4}}, pages)
+	assert.Equal(t, 2, *requests)
+}
+
+func TestPaginateStopsWhenCallbackSaysSo(t *testing.T) {
+	server, requests := pagedServer(t, true)
+
+	err := Paginate(context.Background(), fetchJSON, server.URL+"/items/", PageOptions{PageSize: 3}, func([]int) error {
+		return ErrStopPaging
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 1, *requests)
+}
+
+func TestPaginateHonorsCancellation(t *testing.T) {
+	server, requests := pagedServer(t, true)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	err := Paginate(ctx, fetchJSON, server.URL+"/items/", PageOptions{PageSize: 3}, func([]int) error {
+		cancel()
+
+		return nil
+	})
+	require.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, *requests)
+}
+
+func TestPaginateStopsAtMaxPages(t *testing.T) {
+	server, requests := pagedServer(t, true)
+
+	pages, err := collect(t, context.Background(), server.URL+"/items/", PageOptions{PageSize: 3, MaxPages: 2})
+	require.ErrorIs(t, err, ErrMaxPages)
+
+	assert.Equal(t, [][]int{{1, 2, 3}, {4, 5, 6}}, pages)
+	assert.Equal(t, 2, *requests)
+
+	pages, err = collect(t, context.Background(), server.URL+"/items/", PageOptions{PageSize: 3, MaxPages: 3})
+	require.NoError(t, err, "reaching the cap on the last page is not a truncation")
+	assert.Len(t, pages, 3)
+}
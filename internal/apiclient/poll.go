@@ -0,0 +1,194 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// PollIntervalKey is the viper key for the first wait between polls
+	// of a long-running operation, settable via --poll-interval or
+	// DATAROBOT_CLI_POLL_INTERVAL. Each further wait doubles it, up to
+	// maxPollInterval.
+	PollIntervalKey = "poll-interval"
+	// PollTimeoutKey is the viper key bounding how long to wait for a
+	// long-running operation, settable via --poll-timeout or
+	// DATAROBOT_CLI_POLL_TIMEOUT. Zero waits as long as it takes.
+	PollTimeoutKey = "poll-timeout"
+
+	DefaultPollInterval = time.Second
+	DefaultPollTimeout  = 30 * time.Minute
+
+	maxPollInterval = 30 * time.Second
+)
+
+// Statuses of a long-running operation, as its status endpoint reports
+// them
+const (
+	StatusInitialized = "INITIALIZED"
+	StatusRunning     = "RUNNING"
+	StatusCompleted   = "COMPLETED"
+	StatusError       = "ERROR"
+	StatusAborted     = "ABORTED"
+	StatusExpired     = "EXPIRED"
+)
+
+// PollOptions controls PollUntil
+type PollOptions struct {
+	// Interval is the first wait between polls; each further wait
+	// doubles it, up to MaxInterval. 0 uses DefaultPollInterval.
+	Interval time.Duration
+	// MaxInterval caps the wait between polls; 0 uses maxPollInterval
+	MaxInterval time.Duration
+	// Timeout bounds the whole wait; 0 relies on ctx alone
+	Timeout time.Duration
+}
+
+// PollOptionsFromConfig returns the PollOptions set by --poll-interval
+// and --poll-timeout
+func PollOptionsFromConfig() PollOptions {
+	opts := PollOptions{Interval: DefaultPollInterval, Timeout: DefaultPollTimeout}
+
+	if viper.IsSet(PollIntervalKey) {
+		opts.Interval = viper.GetDuration(PollIntervalKey)
+	}
+
+	if viper.IsSet(PollTimeoutKey) {
+		opts.Timeout = viper.GetDuration(PollTimeoutKey)
+	}
+
+	return opts
+}
+
+// operationStatus is the part of a status response PollUntil interprets
+type operationStatus struct {
+	Status  string `json:"status"`
+	Message string `json:"message"`
+}
+
+// OperationError reports a long-running operation that ended with
+// StatusError, StatusAborted, or StatusExpired
+type OperationError struct {
+	Status  string
+	Message string
+}
+
+func (e *OperationError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("The operation ended with status %s.", e.Status)
+	}
+
+	return fmt.Sprintf("The operation ended with status %s: %s", e.Status, e.Message)
+}
+
+// PollTimeoutError reports an operation that was still running when the
+// poll timeout ran out. It matches context.DeadlineExceeded with
+// errors.Is.
+type PollTimeoutError struct {
+	Timeout time.Duration
+	Status  string // The last status reported
+}
+
+func (e *PollTimeoutError) Error() string {
+	return fmt.Sprintf("Gave up after %s waiting for the operation (last status: %s). Raise --poll-timeout to wait longer.", e.Timeout, e.Status)
+}
+
+func (e *PollTimeoutError) Unwrap() error { return context.DeadlineExceeded }
+
+// PollUntil fetches statusURL while the operation it describes is
+// INITIALIZED or RUNNING, and returns the final response decoded as T:
+// usually the finished resource, since DataRobot status endpoints
+// redirect to it once the operation completes. Any other status, such as
+// COMPLETED or a status field of the resource itself, ends the wait, except
+// that ERROR, ABORTED, or EXPIRED returns an *OperationError. Between polls the wait doubles from opts.Interval to
+// opts.MaxInterval. Once opts.Timeout has passed, a *PollTimeoutError is
+// returned; ctx can cut the wait short as well.
+func PollUntil[T any](ctx context.Context, fetch FetchFunc, statusURL string, opts PollOptions) (T, error) {
+	var result T
+
+	interval := opts.Interval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+
+	maxInterval := opts.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = maxPollInterval
+	}
+
+	parent := ctx
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	status := operationStatus{Status: "unknown"}
+
+	// timedOut turns running out of opts.Timeout into a PollTimeoutError
+	timedOut := func(err error) error {
+		if opts.Timeout > 0 && parent.Err() == nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return &PollTimeoutError{Timeout: opts.Timeout, Status: status.Status}
+		}
+
+		return err
+	}
+
+	for {
+		var raw json.RawMessage
+
+		if err := fetch(ctx, statusURL, &raw); err != nil {
+			return result, timedOut(err)
+		}
+
+		var current operationStatus
+
+		// A resource that isn't a JSON object has no status, so it is done
+		_ = json.Unmarshal(raw, &current)
+
+		switch strings.ToUpper(current.Status) {
+		case StatusInitialized, StatusRunning:
+		case StatusError, StatusAborted, StatusExpired:
+			return result, &OperationError{Status: strings.ToUpper(current.Status), Message: current.Message}
+		default:
+			err := json.Unmarshal(raw, &result)
+
+			return result, err
+		}
+
+		status = current
+		wait := time.NewTimer(interval)
+
+		select {
+		case <-ctx.Done():
+			wait.Stop()
+
+			return result, timedOut(ctx.Err())
+		case <-wait.C:
+		}
+
+		interval = min(interval*2, maxInterval)
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type pollResource struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// statusServer reports each of statuses in turn, then redirects to the
+// finished resource, as DataRobot status endpoints do
+func statusServer(t *testing.T, statuses ...string) (*httptest.Server, *[]time.Time) {
+	t.Helper()
+
+	var polls []time.Time
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/status/1/", func(w http.ResponseWriter, r *http.Request) {
+		polls = append(polls, time.Now())
+
+		if len(polls) <= len(statuses) {
+			fmt.Fprintf(w, `{"status": %q, "message": "step %d"}`, statuses[len(polls)-1], len(polls))
+
+			return
+		}
+
+		http.Redirect(w, r, "/models/m1/", http.StatusSeeOther)
+	})
+	mux.HandleFunc("/models/m1/", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, `{"id": "m1", "name": "Churn model"}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return server, &polls
+}
+
+func TestPollUntilFollowsStatusToResource(t *testing.T) {
+	server, polls := statusServer(t, "INITIALIZED", "RUNNING", "RUNNING")
+
+	got, err := PollUntil[pollResource](context.Background(), fetchJSON, server.URL+"/status/1/",
+		PollOptions{Interval: 10 * time.Millisecond, MaxInterval: 25 * time.Millisecond})
+	require.NoError(t, err)
+	assert.Equal(t, pollResource{ID: "m1", Name: "Churn model"}, got)
+	require.Len(t, *polls, 4)
+
+	// The waits grow 10ms, 20ms, then stop at the 25ms cap
+	waits := []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 25 * time.Millisecond}
+	for i, want := range waits {
+		assert.GreaterOrEqual(t, (*polls)[i+1].Sub((*polls)[i]), want, "wait %d", i)
+	}
+}
+
+func TestPollUntilCompletedStatus(t *testing.T) {
+	server, polls := statusServer(t, "RUNNING", "COMPLETED")
+
+	got, err := PollUntil[operationStatus](context.Background(), fetchJSON, server.URL+"/status/1/",
+		PollOptions{Interval: time.Millisecond})
+	require.NoError(t, err)
+	assert.Equal(t, "COMPLETED", got.Status)
+	assert.Len(t, *polls, 2)
+}
+
+func TestPollUntilError(t *testing.T) {
+	server, _ := statusServer(t, "RUNNING", "ERROR")
+
+	_, err := PollUntil[pollResource](context.Background(), fetchJSON, server.URL+"/status/1/",
+		PollOptions{Interval: time.Millisecond})
+
+	var opErr *OperationError
+
+	require.ErrorAs(t, err, &opErr)
+	assert.Equal(t, "ERROR", opErr.Status)
+	assert.EqualError(t, err, "The operation ended with status ERROR: step 2")
+}
+
+func TestPollUntilTimeout(t *testing.T) {
+	server, _ := statusServer(t, "RUNNING", "RUNNING", "RUNNING", "RUNNING", "RUNNING", "RUNNING")
+
+	_, err := PollUntil[pollResource](context.Background(), fetchJSON, server.URL+"/status/1/",
+		PollOptions{Interval: 20 * time.Millisecond, Timeout: 50 * time.Millisecond})
+
+	var timeout *PollTimeoutError
+
+	require.ErrorAs(t, err, &timeout)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.EqualError(t, err, "Gave up after 50ms waiting for the operation (last status: RUNNING). Raise --poll-timeout to wait longer.")
+}
+
+func TestPollUntilCancelled(t *testing.T) {
+	server, _ := statusServer(t, "RUNNING", "RUNNING", "RUNNING")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	_, err := PollUntil[pollResource](ctx, fetchJSON, server.URL+"/status/1/",
+		PollOptions{Interval: time.Second, Timeout: time.Minute})
+	require.ErrorIs(t, err, context.Canceled)
+
+	var timeout *PollTimeoutError
+
+	assert.False(t, errors.As(err, &timeout))
+}
+
+func TestPollOptionsFromConfig(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	assert.Equal(t, PollOptions{Interval: DefaultPollInterval, Timeout: DefaultPollTimeout}, PollOptionsFromConfig())
+
+	viper.Set(PollIntervalKey, "5s")
+	viper.Set(PollTimeoutKey, "0s")
+
+	assert.Equal(t, PollOptions{Interval: 5 * time.Second}, PollOptionsFromConfig())
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclient
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/datarobot/cli/internal/log"
+	"github.com/spf13/viper"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// ProxyKey is the viper key for a proxy URL that overrides HTTP_PROXY and
+// HTTPS_PROXY, settable via --proxy or DATAROBOT_CLI_PROXY. NO_PROXY is
+// still honored.
+const ProxyKey = "proxy"
+
+// proxyFunc resolves the proxy for each request from the standard
+// environment variables and the proxy setting. The environment is read
+// when the transport is created rather than once per process, unlike
+// http.ProxyFromEnvironment. An invalid proxy setting fails every request.
+func proxyFunc() func(*http.Request) (*url.URL, error) {
+	cfg := httpproxy.FromEnvironment()
+
+	if proxy := viper.GetString(ProxyKey); proxy != "" {
+		if err := validateProxy(proxy); err != nil {
+			return func(*http.Request) (*url.URL, error) { return nil, err }
+		}
+
+		cfg.HTTPProxy = proxy
+		cfg.HTTPSProxy = proxy
+	}
+
+	resolve := cfg.ProxyFunc()
+
+	return func(req *http.Request) (*url.URL, error) {
+		proxy, err := resolve(req.URL)
+		if proxy != nil {
+			log.Debug("Using proxy", "proxy", proxy.Redacted(), "host", req.URL.Host)
+		}
+
+		return proxy, err
+	}
+}
+
+func validateProxy(proxy string) error {
+	parsed, err := url.Parse(proxy)
+	if err != nil || parsed.Host == "" {
+		return fmt.Errorf("Invalid proxy URL %q.", proxy)
+	}
+
+	return nil
+}
+
+// ProxyFor returns the proxy requests to target go through, with any
+// credentials redacted, or an empty string for a direct connection
+func ProxyFor(target string) (string, error) {
+	req, err := http.NewRequest(http.MethodGet, target, nil)
+	if err != nil {
+		return "", err
+	}
+
+	proxy, err := proxyFunc()(req)
+	if err != nil || proxy == nil {
+		return "", err
+	}
+
+	return proxy.Redacted(), nil
+}
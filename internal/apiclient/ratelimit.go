@@ -0,0 +1,125 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/datarobot/cli/internal/log"
+	"github.com/spf13/viper"
+)
+
+// RateLimitKey is the viper key for the most requests per second sent by
+// the CLI, settable via --rate-limit or DATAROBOT_CLI_RATE_LIMIT. Zero, the
+// default, sends requests as soon as they are made.
+const RateLimitKey = "rate-limit"
+
+// RateLimit returns the configured requests per second, or an error if it
+// is negative
+func RateLimit() (float64, error) {
+	rate := viper.GetFloat64(RateLimitKey)
+	if rate < 0 {
+		return 0, fmt.Errorf("--rate-limit must be zero or more requests per second, got %v.", rate)
+	}
+
+	return rate, nil
+}
+
+// limiter is a token bucket holding a single token, so requests are spaced
+// evenly at the configured rate. It is shared by every client in the
+// process, since they all count against the same server-side limit.
+type limiter struct {
+	mu   sync.Mutex
+	rate float64
+	// next is when the token is available again
+	next time.Time
+}
+
+var requestLimiter limiter
+
+// reserve takes the token at rate requests per second and returns how long
+// to wait before it may be used
+func (l *limiter) reserve(rate float64) (time.Duration, time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if rate != l.rate {
+		l.rate = rate
+		l.next = time.Time{}
+	}
+
+	now := time.Now()
+
+	start := now
+	if l.next.After(now) {
+		start = l.next
+	}
+
+	l.next = start.Add(time.Duration(float64(time.Second) / rate))
+
+	return start.Sub(now), l.next
+}
+
+// cancel gives back a token taken by reserve and never used, unless later
+// requests have queued behind it
+func (l *limiter) cancel(next time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.next.Equal(next) {
+		l.next = next.Add(-time.Duration(float64(time.Second) / l.rate))
+	}
+}
+
+// rateLimitTransport waits for the request limiter before every attempt,
+// retries included
+type rateLimitTransport struct {
+	base http.RoundTripper
+	rate float64
+}
+
+func (t rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if delay, next := requestLimiter.reserve(t.rate); delay > 0 {
+		log.Debug("Throttling request to stay under --rate-limit", "url", req.URL.Redacted(), "delay", delay, "rate-limit", t.rate)
+
+		if err := sleep(req.Context(), delay); err != nil {
+			requestLimiter.cancel(next)
+
+			if req.Body != nil {
+				req.Body.Close()
+			}
+
+			return nil, err
+		}
+	}
+
+	return t.base.RoundTrip(req)
+}
+
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func rateLimited(t *testing.T, rate float64) {
+	t.Helper()
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.Set(RateLimitKey, rate)
+
+	requestLimiter = limiter{}
+	t.Cleanup(func() { requestLimiter = limiter{} })
+}
+
+func TestRateLimitSpacesRequests(t *testing.T) {
+	rateLimited(t, 20)
+
+	var (
+		mu    sync.Mutex
+		times []time.Time
+	)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		mu.Lock()
+		times = append(times, time.Now())
+		mu.Unlock()
+	}))
+	t.Cleanup(server.Close)
+
+	// Separate clients share the limiter, and concurrent requests queue
+	var wg sync.WaitGroup
+
+	for range 4 {
+		wg.Go(func() {
+			resp, err := New(0).Get(server.URL)
+			if assert.NoError(t, err) {
+				resp.Body.Close()
+			}
+		})
+	}
+
+	wg.Wait()
+
+	require.Len(t, times, 4)
+	slices.SortFunc(times, time.Time.Compare)
+
+	// 20 requests per second spaces them 50ms apart
+	for i := 1; i < len(times); i++ {
+		assert.GreaterOrEqual(t, times[i].Sub(times[i-1]), 45*time.Millisecond, "request %d", i)
+	}
+}
+
+func TestRateLimitAppliesToRetries(t *testing.T) {
+	fastRetries(t)
+	viper.Set(RateLimitKey, 10)
+
+	requestLimiter = limiter{}
+	t.Cleanup(func() { requestLimiter = limiter{} })
+
+	server, calls := stubServer(t, http.StatusServiceUnavailable)
+
+	start := time.Now()
+
+	resp, err := New(0).Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, 2, *calls)
+	assert.GreaterOrEqual(t, time.Since(start), 95*time.Millisecond)
+}
+
+func TestRateLimitHonorsContext(t *testing.T) {
+	rateLimited(t, 0.1)
+
+	server, calls := stubServer(t)
+
+	resp, err := New(0).Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	// The next request would wait ten seconds
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	_, err = New(0).Do(req)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Equal(t, 1, *calls)
+
+	// The cancelled request gives its turn back
+	assert.WithinDuration(t, time.Now().Add(10*time.Second), requestLimiter.next, time.Second)
+}
+
+func TestRateLimitRejectsNegative(t *testing.T) {
+	rateLimited(t, -1)
+
+	require.ErrorContains(t, CheckConfig(), "--rate-limit must be zero or more")
+
+	_, err := New(0).Get("http://127.0.0.1:1")
+	require.ErrorContains(t, err, "--rate-limit must be zero or more")
+}
@@ -0,0 +1,181 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclient
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/datarobot/cli/internal/log"
+	"github.com/spf13/viper"
+)
+
+const (
+	// MaxRetriesKey is the viper key for how many times a failed request is
+	// retried, settable via --max-retries or DATAROBOT_CLI_MAX_RETRIES
+	MaxRetriesKey = "max-retries"
+	// RetryBaseDelayKey is the viper key for the delay before the first
+	// retry; each further retry doubles it
+	RetryBaseDelayKey = "retry-base-delay"
+
+	DefaultMaxRetries     = 3
+	DefaultRetryBaseDelay = 500 * time.Millisecond
+
+	// maxRetryDelay caps both the backoff and a server's Retry-After
+	maxRetryDelay = 30 * time.Second
+)
+
+type retrySafeKey struct{}
+
+// WithRetrySafe marks requests made with ctx as safe to retry even though
+// their method isn't idempotent, e.g. a POST that only reads data
+func WithRetrySafe(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retrySafeKey{}, true)
+}
+
+// retryTransport retries idempotent requests that fail with a network
+// error, 429, or 5xx, backing off exponentially with jitter
+type retryTransport struct {
+	base http.RoundTripper
+}
+
+func (t retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	maxRetries := MaxRetries()
+	if maxRetries <= 0 || !retryable(req) {
+		return t.base.RoundTrip(req)
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+
+		// RoundTrip must not modify req, so resend the body on a copy
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		resp, err := t.base.RoundTrip(attemptReq)
+		if attempt >= maxRetries || !shouldRetry(resp, err) || req.Context().Err() != nil {
+			return resp, err
+		}
+
+		delay := retryDelay(attempt, resp)
+
+		if err != nil {
+			log.Debug("Retrying request", "url", req.URL.Redacted(), "attempt", attempt+1, "delay", delay, "error", err)
+		} else {
+			log.Debug("Retrying request", "url", req.URL.Redacted(), "attempt", attempt+1, "delay", delay, "status", resp.StatusCode)
+
+			// Drain so the connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(delay)
+
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// MaxRetries returns the configured number of retries
+func MaxRetries() int {
+	if !viper.IsSet(MaxRetriesKey) {
+		return DefaultMaxRetries
+	}
+
+	return viper.GetInt(MaxRetriesKey)
+}
+
+func baseDelay() time.Duration {
+	if delay := viper.GetDuration(RetryBaseDelayKey); delay > 0 {
+		return delay
+	}
+
+	return DefaultRetryBaseDelay
+}
+
+// retryable reports whether req may be sent more than once
+func retryable(req *http.Request) bool {
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete, http.MethodTrace:
+		return true
+	}
+
+	safe, _ := req.Context().Value(retrySafeKey{}).(bool)
+
+	return safe
+}
+
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+// retryDelay honors the server's Retry-After header when present and
+// otherwise backs off exponentially, with jitter spreading retries from
+// concurrent clients
+func retryDelay(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			return min(delay, maxRetryDelay)
+		}
+	}
+
+	backoff := min(baseDelay()<<attempt, maxRetryDelay)
+
+	// Wait between half and the full backoff
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+// parseRetryAfter parses a Retry-After value given in seconds or as an HTTP
+// date
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,167 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubServer answers with the given status codes in order, then 200
+func stubServer(t *testing.T, statuses ...int) (*httptest.Server, *int) {
+	t.Helper()
+
+	calls := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		body, _ := io.ReadAll(r.Body)
+
+		if calls <= len(statuses) {
+			w.WriteHeader(statuses[calls-1])
+
+			return
+		}
+
+		_, _ = w.Write(append([]byte("ok:"), body...))
+	}))
+
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func fastRetries(t *testing.T) {
+	t.Helper()
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	viper.Set(RetryBaseDelayKey, time.Millisecond)
+}
+
+func TestRetriesOn429Then200(t *testing.T) {
+	fastRetries(t)
+
+	server, calls := stubServer(t, http.StatusTooManyRequests)
+
+	resp, err := New(0).Get(server.URL)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, 2, *calls)
+}
+
+func TestRetriesOn5xxUntilLimit(t *testing.T) {
+	fastRetries(t)
+	viper.Set(MaxRetriesKey, 2)
+
+	server, calls := stubServer(t, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway)
+
+	resp, err := New(0).Get(server.URL)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+	assert.Equal(t, 3, *calls, "one attempt plus two retries")
+}
+
+func TestDoesNotRetryClientErrors(t *testing.T) {
+	fastRetries(t)
+
+	server, calls := stubServer(t, http.StatusUnauthorized)
+
+	resp, err := New(0).Get(server.URL)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, 1, *calls)
+}
+
+func TestDoesNotRetryPost(t *testing.T) {
+	fastRetries(t)
+
+	server, calls := stubServer(t, http.StatusServiceUnavailable)
+
+	resp, err := New(0).Post(server.URL, "text/plain", strings.NewReader("payload"))
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+	assert.Equal(t, 1, *calls)
+}
+
+func TestRetriesPostMarkedSafe(t *testing.T) {
+	fastRetries(t)
+
+	server, calls := stubServer(t, http.StatusServiceUnavailable)
+
+	req, err := http.NewRequestWithContext(WithRetrySafe(context.Background()),
+		http.MethodPost, server.URL, strings.NewReader("payload"))
+	require.NoError(t, err)
+
+	resp, err := New(0).Do(req)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, *calls)
+	assert.Equal(t, "ok:payload", string(body), "body should be resent on retry")
+}
+
+func TestRetryDelayHonorsRetryAfter(t *testing.T) {
+	fastRetries(t)
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}
+	assert.Equal(t, 2*time.Second, retryDelay(0, resp))
+
+	resp.Header.Set("Retry-After", "3600")
+	assert.Equal(t, maxRetryDelay, retryDelay(0, resp), "Retry-After should be capped")
+
+	resp.Header.Set("Retry-After", time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat))
+	assert.Equal(t, time.Duration(0), retryDelay(0, resp))
+}
+
+func TestRetryDelayBacksOffExponentially(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	for attempt := range 4 {
+		backoff := DefaultRetryBaseDelay << attempt
+		delay := retryDelay(attempt, nil)
+
+		assert.GreaterOrEqual(t, delay, backoff/2)
+		assert.LessOrEqual(t, delay, backoff)
+	}
+}
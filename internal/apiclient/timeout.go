@@ -0,0 +1,119 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/spf13/viper"
+)
+
+// RequestTimeoutKey is the viper key bounding each request, including
+// retries and reading the response body, settable via --timeout or
+// DATAROBOT_CLI_REQUEST_TIMEOUT. Zero disables the limit.
+const RequestTimeoutKey = "request-timeout"
+
+// RequestTimeout returns the configured request timeout
+func RequestTimeout() time.Duration {
+	if !viper.IsSet(RequestTimeoutKey) {
+		return DefaultTimeout
+	}
+
+	return viper.GetDuration(RequestTimeoutKey)
+}
+
+// TimeoutError reports a request that did not complete in time. It
+// matches context.DeadlineExceeded with errors.Is.
+type TimeoutError struct {
+	Duration time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	return fmt.Sprintf("timed out after %s", e.Duration)
+}
+
+func (e *TimeoutError) Timeout() bool { return true }
+
+func (e *TimeoutError) Unwrap() error { return context.DeadlineExceeded }
+
+// timeoutTransport puts a deadline on the context of each request. The
+// deadline stays in place until the response body is closed, so a stalled
+// download is cut off too.
+type timeoutTransport struct {
+	base    http.RoundTripper
+	timeout time.Duration
+}
+
+func (t timeoutTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.timeout <= 0 {
+		return t.base.RoundTrip(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), t.timeout)
+
+	resp, err := t.base.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+
+		return nil, t.wrap(ctx, req, err)
+	}
+
+	resp.Body = &timeoutBody{ReadCloser: resp.Body, transport: t, req: req, ctx: ctx, cancel: cancel}
+
+	return resp, nil
+}
+
+// wrap replaces a deadline error caused by the timeout, rather than by the
+// caller's own context, with a TimeoutError
+func (t timeoutTransport) wrap(ctx context.Context, req *http.Request, err error) error {
+	if !errors.Is(ctx.Err(), context.DeadlineExceeded) || req.Context().Err() != nil {
+		return err
+	}
+
+	return &TimeoutError{Duration: t.timeout}
+}
+
+type timeoutBody struct {
+	io.ReadCloser
+
+	transport timeoutTransport
+	req       *http.Request
+	ctx       context.Context //nolint:containedctx
+	cancel    context.CancelFunc
+}
+
+func (b *timeoutBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err != nil && !errors.Is(err, io.EOF) {
+		if wrapped := b.transport.wrap(b.ctx, b.req, err); wrapped != err { //nolint:errorlint
+			// Body reads aren't wrapped by http.Client, so name the URL here
+			err = &url.Error{Op: b.req.Method, URL: b.req.URL.Redacted(), Err: wrapped}
+		}
+	}
+
+	return n, err
+}
+
+func (b *timeoutBody) Close() error {
+	defer b.cancel()
+
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,151 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// slowServer waits until the client gives up or release is closed. With
+// flushHeaders, the status line is sent first so only the body stalls.
+func slowServer(t *testing.T, flushHeaders bool) *httptest.Server {
+	t.Helper()
+
+	release := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if flushHeaders {
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+		}
+
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+
+	t.Cleanup(func() {
+		close(release)
+		server.Close()
+	})
+
+	return server
+}
+
+func shortTimeout(t *testing.T, timeout time.Duration) {
+	t.Helper()
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.Set(MaxRetriesKey, 0)
+	viper.Set(RequestTimeoutKey, timeout)
+}
+
+func TestRequestTimeoutDefault(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	assert.Equal(t, DefaultTimeout, RequestTimeout())
+
+	viper.Set(RequestTimeoutKey, "2m")
+	assert.Equal(t, 2*time.Minute, RequestTimeout())
+}
+
+func TestRequestTimeoutNamesURLAndDuration(t *testing.T) {
+	shortTimeout(t, 50*time.Millisecond)
+
+	server := slowServer(t, false)
+
+	_, err := New(0).Get(server.URL + "/api/v2/version/")
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+
+	var timeoutErr *TimeoutError
+
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, 50*time.Millisecond, timeoutErr.Duration)
+	assert.Contains(t, err.Error(), server.URL+"/api/v2/version/")
+	assert.Contains(t, err.Error(), "timed out after 50ms")
+}
+
+func TestRequestTimeoutCoversBody(t *testing.T) {
+	shortTimeout(t, 50*time.Millisecond)
+
+	server := slowServer(t, true)
+
+	resp, err := New(0).Get(server.URL)
+	require.NoError(t, err)
+
+	defer resp.Body.Close()
+
+	_, err = io.ReadAll(resp.Body)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.Contains(t, err.Error(), server.URL)
+	assert.Contains(t, err.Error(), "timed out after 50ms")
+}
+
+func TestExplicitTimeoutOverridesConfigured(t *testing.T) {
+	shortTimeout(t, time.Hour)
+
+	server := slowServer(t, false)
+
+	_, err := New(20 * time.Millisecond).Get(server.URL)
+
+	var timeoutErr *TimeoutError
+
+	require.ErrorAs(t, err, &timeoutErr)
+	assert.Equal(t, 20*time.Millisecond, timeoutErr.Duration)
+}
+
+func TestCallerCancellationIsNotATimeout(t *testing.T) {
+	shortTimeout(t, time.Hour)
+
+	server := slowServer(t, false)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	require.NoError(t, err)
+
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	_, err = New(0).Do(req)
+	require.ErrorIs(t, err, context.Canceled)
+
+	var timeoutErr *TimeoutError
+
+	assert.NotErrorAs(t, err, &timeoutErr)
+}
+
+func TestZeroRequestTimeoutDisablesLimit(t *testing.T) {
+	shortTimeout(t, 0)
+
+	server, _ := stubServer(t)
+
+	resp, err := New(0).Get(server.URL)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
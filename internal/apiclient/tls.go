@@ -0,0 +1,73 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// CACertKey is the viper key for a PEM bundle of extra CA certificates
+	// to trust, settable via --ca-cert or DATAROBOT_CLI_CA_CERT. This is
+	// needed for self-managed installs signed by an internal CA.
+	CACertKey = "ca-cert"
+	// InsecureSkipTLSVerifyKey disables certificate verification entirely.
+	// It is meant for development environments only.
+	InsecureSkipTLSVerifyKey = "insecure-skip-tls-verify"
+)
+
+var insecureWarning sync.Once
+
+// tlsConfig builds the TLS settings for the shared transport. The CA bundle
+// is added to the system roots rather than replacing them, so public hosts
+// such as GitHub keep working.
+func tlsConfig() (*tls.Config, error) {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+
+	if path := viper.GetString(CACertKey); path != "" {
+		pem, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to read CA certificate bundle: %w", err)
+		}
+
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("No PEM certificates found in %s.", path)
+		}
+
+		cfg.RootCAs = pool
+	}
+
+	if viper.GetBool(InsecureSkipTLSVerifyKey) {
+		insecureWarning.Do(func() {
+			fmt.Fprintln(os.Stderr, "WARNING: TLS certificate verification is disabled (--insecure-skip-tls-verify). "+
+				"Connections can be intercepted; never use this outside of development.")
+		})
+
+		cfg.InsecureSkipVerify = true //nolint:gosec
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclient
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// writeServerCA saves the self-signed certificate of server as a PEM bundle
+func writeServerCA(t *testing.T, server *httptest.Server) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+
+	return path
+}
+
+func newTLSServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	viper.Set(MaxRetriesKey, 0)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func TestSelfSignedServerRejectedByDefault(t *testing.T) {
+	server := newTLSServer(t)
+
+	_, err := New(0).Get(server.URL)
+	require.Error(t, err)
+}
+
+func TestCACertTrustsSelfSignedServer(t *testing.T) {
+	server := newTLSServer(t)
+	viper.Set(CACertKey, writeServerCA(t, server))
+
+	resp, err := New(0).Get(server.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestInsecureSkipTLSVerify(t *testing.T) {
+	server := newTLSServer(t)
+	viper.Set(InsecureSkipTLSVerifyKey, true)
+
+	resp, err := New(0).Get(server.URL)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+}
+
+func TestInvalidCACertFailsRequests(t *testing.T) {
+	server := newTLSServer(t)
+
+	path := filepath.Join(t.TempDir(), "ca.pem")
+	require.NoError(t, os.WriteFile(path, []byte("not a certificate"), 0o600))
+	viper.Set(CACertKey, path)
+
+	_, err := New(0).Get(server.URL)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "No PEM certificates found")
+}
@@ -0,0 +1,125 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclient
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// TraceFileKey is the viper key for a file receiving a JSON record of
+	// every outbound HTTP request, settable via --trace-file
+	TraceFileKey = "trace-file"
+	// TraceAppendKey keeps the existing contents of the trace file instead
+	// of truncating it
+	TraceAppendKey = "trace-append"
+
+	redacted = "***"
+)
+
+// sensitiveHeaders are replaced with *** in trace records
+var sensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+	"X-Api-Key":           true,
+}
+
+// traceLogger writes trace records when a trace file is open. It is
+// separate from the CLI's log so traces are complete regardless of the
+// log level and never mixed into terminal output.
+var traceLogger atomic.Pointer[slog.Logger]
+
+// OpenTraceFile starts writing trace records to path, truncating the file
+// unless appending. The returned function closes the file.
+func OpenTraceFile(path string, appending bool) (func() error, error) {
+	flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if appending {
+		flags = os.O_CREATE | os.O_WRONLY | os.O_APPEND
+	}
+
+	f, err := os.OpenFile(path, flags, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to open trace file: %w", err)
+	}
+
+	traceLogger.Store(slog.New(slog.NewJSONHandler(f, nil)))
+
+	return func() error {
+		traceLogger.Store(nil)
+
+		return f.Close()
+	}, nil
+}
+
+// traceTransport records each request attempt in the trace file
+type traceTransport struct {
+	base http.RoundTripper
+}
+
+func (t traceTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	logger := traceLogger.Load()
+	if logger == nil {
+		return t.base.RoundTrip(req)
+	}
+
+	start := time.Now()
+
+	resp, err := t.base.RoundTrip(req)
+
+	attrs := []slog.Attr{
+		slog.String("method", req.Method),
+		slog.String("url", req.URL.Redacted()),
+		slog.Int64("duration_ms", time.Since(start).Milliseconds()),
+		slog.Any("request_headers", redactHeaders(req.Header)),
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		logger.LogAttrs(context.Background(), slog.LevelError, "http request", attrs...)
+
+		return nil, err
+	}
+
+	attrs = append(attrs,
+		slog.Int("status", resp.StatusCode),
+		slog.Any("response_headers", redactHeaders(resp.Header)))
+	logger.LogAttrs(context.Background(), slog.LevelInfo, "http request", attrs...)
+
+	return resp, nil
+}
+
+// redactHeaders flattens headers for the trace, masking credentials
+func redactHeaders(header http.Header) map[string]string {
+	out := make(map[string]string, len(header))
+
+	for name, values := range header {
+		if sensitiveHeaders[http.CanonicalHeaderKey(name)] {
+			out[name] = redacted
+		} else {
+			out[name] = strings.Join(values, ", ")
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,123 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclient
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type traceRecord struct {
+	Msg             string            `json:"msg"`
+	Method          string            `json:"method"`
+	URL             string            `json:"url"`
+	Status          int               `json:"status"`
+	RequestHeaders  map[string]string `json:"request_headers"`
+	ResponseHeaders map[string]string `json:"response_headers"`
+}
+
+func readTrace(t *testing.T, path string) []traceRecord {
+	t.Helper()
+
+	f, err := os.Open(path)
+	require.NoError(t, err)
+
+	defer f.Close()
+
+	var records []traceRecord
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var record traceRecord
+
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &record))
+
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func tracedRequest(t *testing.T, path string, appending bool) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.Header().Set("Content-Type", "application/json")
+	}))
+	t.Cleanup(server.Close)
+
+	closeTrace, err := OpenTraceFile(path, appending)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/api/v2/version/", nil)
+	require.NoError(t, err)
+
+	req.Header.Set("Authorization", "Bearer secret-token")
+	req.Header.Set("User-Agent", "dr-test")
+
+	resp, err := New(0).Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.NoError(t, closeTrace())
+}
+
+func TestTraceFileRecordsRedactedRequests(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+
+	tracedRequest(t, path, false)
+
+	records := readTrace(t, path)
+	require.Len(t, records, 1)
+
+	record := records[0]
+	assert.Equal(t, http.MethodGet, record.Method)
+	assert.Contains(t, record.URL, "/api/v2/version/")
+	assert.Equal(t, http.StatusOK, record.Status)
+	assert.Equal(t, "***", record.RequestHeaders["Authorization"])
+	assert.Equal(t, "dr-test", record.RequestHeaders["User-Agent"])
+	assert.Equal(t, "***", record.ResponseHeaders["Set-Cookie"])
+	assert.Equal(t, "application/json", record.ResponseHeaders["Content-Type"])
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "secret")
+}
+
+func TestTraceFileTruncatesUnlessAppending(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	path := filepath.Join(t.TempDir(), "trace.jsonl")
+
+	tracedRequest(t, path, false)
+	tracedRequest(t, path, false)
+	assert.Len(t, readTrace(t, path), 1)
+
+	tracedRequest(t, path, true)
+	assert.Len(t, readTrace(t, path), 2)
+}
@@ -0,0 +1,144 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/log"
+)
+
+const (
+	// accountCacheTTL is how long an account lookup is reused, so running
+	// dr whoami repeatedly, for example from a shell prompt, stays fast
+	accountCacheTTL = 5 * time.Minute
+
+	accountCacheFile = "account.json"
+)
+
+// now is stubbed in tests to control cache expiry
+var now = time.Now
+
+// accountCache is the last account lookup. The token is stored only as a
+// hash, to tell whether the entry belongs to the current credentials.
+type accountCache struct {
+	Endpoint  string             `json:"endpoint"`
+	TokenHash string             `json:"token_hash"`
+	FetchedAt time.Time          `json:"fetched_at"`
+	Info      config.AccountInfo `json:"info"`
+}
+
+// CachedAccountInfo returns the account that creds belong to. A lookup
+// for the same endpoint and token in the last few minutes is reused;
+// otherwise one request is made and its answer cached.
+func CachedAccountInfo(creds Credentials) (*config.AccountInfo, error) {
+	hash := tokenHash(creds.Token)
+
+	if cache, ok := loadAccountCache(); ok && cache.Endpoint == creds.Endpoint && cache.TokenHash == hash &&
+		now().Sub(cache.FetchedAt) < accountCacheTTL {
+		return &cache.Info, nil
+	}
+
+	info, err := config.FetchAccountInfo(creds.Endpoint, creds.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	saveAccountCache(accountCache{Endpoint: creds.Endpoint, TokenHash: hash, FetchedAt: now().UTC(), Info: *info})
+
+	return info, nil
+}
+
+func tokenHash(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+func accountCachePath() (string, error) {
+	dir, err := config.CacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, accountCacheFile), nil
+}
+
+func loadAccountCache() (accountCache, bool) {
+	var cache accountCache
+
+	path, err := accountCachePath()
+	if err != nil {
+		return cache, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Debug("Failed to read account cache", "error", err)
+		}
+
+		return cache, false
+	}
+
+	if err := json.Unmarshal(data, &cache); err != nil {
+		return cache, false
+	}
+
+	return cache, true
+}
+
+// saveAccountCache persists the lookup. Failures only cost a request next
+// time, so they are logged rather than returned.
+func saveAccountCache(cache accountCache) {
+	path, err := accountCachePath()
+	if err != nil {
+		return
+	}
+
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		log.Debug("Failed to create cache directory", "error", err)
+
+		return
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		log.Debug("Failed to write account cache", "error", err)
+	}
+}
+
+// clearAccountCache forgets the last account lookup
+func clearAccountCache() {
+	path, err := accountCachePath()
+	if err != nil {
+		return
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		log.Debug("Failed to remove account cache", "error", err)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupAccountServer(t *testing.T) (string, *int) {
+	t.Helper()
+
+	testutil.SetTestHomeDir(t, t.TempDir())
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.Set(apiclient.MaxRetriesKey, 0)
+
+	requests := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if r.Header.Get("Authorization") == "Bearer bad-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"username":"jane@example.com","orgName":"Acme"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	return server.URL, &requests
+}
+
+func stubNow(t *testing.T, at time.Time) *time.Time {
+	t.Helper()
+
+	current := at
+	now = func() time.Time { return current }
+
+	t.Cleanup(func() { now = time.Now })
+
+	return &current
+}
+
+func TestCachedAccountInfoReusesRecentLookup(t *testing.T) {
+	endpoint, requests := setupAccountServer(t)
+	current := stubNow(t, time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	creds := Credentials{Endpoint: endpoint, Token: "valid-token"}
+
+	info, err := CachedAccountInfo(creds)
+	require.NoError(t, err)
+	assert.Equal(t, "jane@example.com", info.Username)
+
+	info, err = CachedAccountInfo(creds)
+	require.NoError(t, err)
+	assert.Equal(t, "Acme", info.OrgName)
+	assert.Equal(t, 1, *requests)
+
+	*current = current.Add(accountCacheTTL)
+
+	_, err = CachedAccountInfo(creds)
+	require.NoError(t, err)
+	assert.Equal(t, 2, *requests, "an expired lookup is fetched again")
+}
+
+func TestCachedAccountInfoIsPerToken(t *testing.T) {
+	endpoint, requests := setupAccountServer(t)
+	stubNow(t, time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC))
+
+	_, err := CachedAccountInfo(Credentials{Endpoint: endpoint, Token: "valid-token"})
+	require.NoError(t, err)
+
+	_, err = CachedAccountInfo(Credentials{Endpoint: endpoint, Token: "bad-token"})
+	require.Error(t, err)
+	assert.Equal(t, 2, *requests)
+
+	clearAccountCache()
+
+	_, err = CachedAccountInfo(Credentials{Endpoint: endpoint, Token: "valid-token"})
+	require.NoError(t, err)
+	assert.Equal(t, 3, *requests, "a cleared cache is fetched again")
+}
@@ -18,16 +18,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net"
 	"net/http"
-	"os"
 	"strings"
 
+	"github.com/charmbracelet/lipgloss"
+	"github.com/datarobot/cli/internal/apiclient"
 	"github.com/datarobot/cli/internal/assets"
 	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/credentials"
+	"github.com/datarobot/cli/internal/errs"
 	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/misc/confirm"
 	"github.com/datarobot/cli/internal/misc/open"
 	"github.com/datarobot/cli/internal/misc/reader"
+	"github.com/datarobot/cli/internal/printer"
 	"github.com/datarobot/cli/tui"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -41,11 +47,11 @@ var APIKeyCallbackFunc = WaitForAPIKeyCallback
 var ErrEnvCredentialsNotSet = errors.New("environment credentials not set")
 
 // PrintUnsetTokenInstructions prints platform-specific instructions for unsetting DATAROBOT_API_TOKEN.
-func PrintUnsetTokenInstructions() {
-	fmt.Print(tui.InfoStyle.Render("  unset DATAROBOT_API_TOKEN"))
-	fmt.Print(tui.BaseTextStyle.Render(" (or "))
-	fmt.Print(tui.InfoStyle.Render("Remove-Item Env:\\DATAROBOT_API_TOKEN"))
-	fmt.Println(tui.BaseTextStyle.Render(" on Windows)"))
+func PrintUnsetTokenInstructions(w io.Writer) {
+	fmt.Fprint(w, tui.InfoStyle.Render("  unset DATAROBOT_API_TOKEN"))
+	fmt.Fprint(w, tui.BaseTextStyle.Render(" (or "))
+	fmt.Fprint(w, tui.InfoStyle.Render("Remove-Item Env:\\DATAROBOT_API_TOKEN"))
+	fmt.Fprintln(w, tui.BaseTextStyle.Render(" on Windows)"))
 }
 
 // EnvCredentials holds environment variable authentication credentials.
@@ -57,15 +63,9 @@ type EnvCredentials struct {
 // GetEnvCredentials reads DATAROBOT_ENDPOINT and DATAROBOT_API_TOKEN from environment.
 // Falls back to DATAROBOT_API_ENDPOINT if DATAROBOT_ENDPOINT is not set.
 func GetEnvCredentials() EnvCredentials {
-	endpoint := os.Getenv("DATAROBOT_ENDPOINT")
-	if endpoint == "" {
-		endpoint = os.Getenv("DATAROBOT_API_ENDPOINT")
-	}
+	endpoint, token := credentials.SDKEnvironment()
 
-	return EnvCredentials{
-		Endpoint: endpoint,
-		Token:    os.Getenv("DATAROBOT_API_TOKEN"),
-	}
+	return EnvCredentials{Endpoint: endpoint, Token: token}
 }
 
 // VerifyEnvCredentials checks if environment variable credentials are valid.
@@ -86,7 +86,7 @@ func VerifyEnvCredentials() (*EnvCredentials, error) {
 // fails, suitable for use in Cobra PreRunE hooks.
 func EnsureAuthenticatedE(cmd *cobra.Command, _ []string) error {
 	if !EnsureAuthenticated(cmd.Context()) {
-		return errors.New("Authentication failed.")
+		return errs.NewAuthError("Authentication failed.", nil)
 	}
 
 	return nil
@@ -102,6 +102,14 @@ func EnsureAuthenticated(ctx context.Context) bool { //nolint: cyclop
 		return true
 	}
 
+	// The token cannot be checked without the server; API calls fail
+	// with an offline error regardless
+	if apiclient.Offline() {
+		log.Debug("Skipping authentication checks in offline mode")
+
+		return true
+	}
+
 	// bindValidAuthEnv binds DATAROBOT ENDPOINT/API_TOKEN to viper config only if these credentials are valid
 	creds, envErr := VerifyEnvCredentials()
 	if envErr == nil {
@@ -132,25 +140,25 @@ func EnsureAuthenticated(ctx context.Context) bool { //nolint: cyclop
 	if errors.Is(envErr, context.DeadlineExceeded) {
 		envDatarobotHost, _ := config.SchemeHostOnly(creds.Endpoint)
 
-		fmt.Print(tui.BaseTextStyle.Render("❌ Connection to "))
-		fmt.Print(tui.InfoStyle.Render(envDatarobotHost))
-		fmt.Println(tui.BaseTextStyle.Render(" from DATAROBOT_ENDPOINT environment variable timed out."))
-		fmt.Println(tui.BaseTextStyle.Render("Check your network and try again."))
+		fmt.Fprint(printer.Diagnostics, tui.BaseTextStyle.Render("❌ Connection to "))
+		fmt.Fprint(printer.Diagnostics, tui.InfoStyle.Render(envDatarobotHost))
+		fmt.Fprintln(printer.Diagnostics, tui.BaseTextStyle.Render(" from DATAROBOT_ENDPOINT environment variable timed out."))
+		fmt.Fprintln(printer.Diagnostics, tui.BaseTextStyle.Render("Check your network and try again."))
 
 		skipAuthFlow = true
 	} else if creds.Token != "" {
-		fmt.Println(tui.BaseTextStyle.Render("Your DATAROBOT_API_TOKEN environment variable"))
-		fmt.Println(tui.BaseTextStyle.Render("contains an expired or invalid token. Unset it:"))
-		PrintUnsetTokenInstructions()
+		fmt.Fprintln(printer.Diagnostics, tui.BaseTextStyle.Render("Your DATAROBOT_API_TOKEN environment variable"))
+		fmt.Fprintln(printer.Diagnostics, tui.BaseTextStyle.Render("contains an expired or invalid token. Unset it:"))
+		PrintUnsetTokenInstructions(printer.Diagnostics)
 
 		skipAuthFlow = true
 	}
 
 	if errors.Is(viperErr, context.DeadlineExceeded) {
-		fmt.Print(tui.BaseTextStyle.Render("❌ Connection to "))
-		fmt.Print(tui.InfoStyle.Render(datarobotHost))
-		fmt.Println(tui.BaseTextStyle.Render(" from dr cli config timed out."))
-		fmt.Println(tui.BaseTextStyle.Render("Check your network and try again."))
+		fmt.Fprint(printer.Diagnostics, tui.BaseTextStyle.Render("❌ Connection to "))
+		fmt.Fprint(printer.Diagnostics, tui.InfoStyle.Render(datarobotHost))
+		fmt.Fprintln(printer.Diagnostics, tui.BaseTextStyle.Render(" from dr cli config timed out."))
+		fmt.Fprintln(printer.Diagnostics, tui.BaseTextStyle.Render("Check your network and try again."))
 
 		skipAuthFlow = true
 	}
@@ -221,9 +229,9 @@ func WaitForAPIKeyCallback(ctx context.Context, datarobotHost string) (string, e
 	go func() {
 		authURL := datarobotHost + "/account/developer-tools?cliRedirect=true"
 
-		fmt.Println("\n\nPlease visit this link to connect your DataRobot credentials to the CLI")
-		fmt.Println("(If you're prompted to log in, you may need to re-enter this URL):")
-		fmt.Printf("%s\n\n", authURL)
+		fmt.Fprintln(printer.Diagnostics, "\n\nPlease visit this link to connect your DataRobot credentials to the CLI")
+		fmt.Fprintln(printer.Diagnostics, "(If you're prompted to log in, you may need to re-enter this URL):")
+		fmt.Fprintf(printer.Diagnostics, "%s\n\n", authURL)
 
 		open.Open(authURL)
 
@@ -246,17 +254,28 @@ func WaitForAPIKeyCallback(ctx context.Context, datarobotHost string) (string, e
 			return "", errors.New("Interrupt request received.")
 		}
 
-		fmt.Println("Successfully consumed API key from API request")
+		fmt.Fprintln(printer.Diagnostics, "Successfully consumed API key from API request")
 
 		return apiKey, nil
 	case <-ctx.Done():
-		fmt.Println("\nCtrl-C received, exiting...")
+		fmt.Fprintln(printer.Diagnostics, "\nCtrl-C received, exiting...")
 		return "", errors.New("Interrupt request received.")
 	}
 }
 
+// WriteConfigFileSilent saves the API token viper holds to the config
+// file. Only the token is touched, so comments, profiles, and values that
+// came from flags or the environment stay as they are. A token stored in
+// the keyring or a token file takes precedence over the config file, so
+// the stale one is dropped first.
 func WriteConfigFileSilent() error {
-	err := viper.WriteConfig()
+	if _, err := config.DeleteStoredToken(); err != nil {
+		log.Error(err)
+		return err
+	}
+
+	err := config.SetConfigFileValue(config.SettingKeyPath(config.DataRobotAPIKey),
+		viper.GetString(config.DataRobotAPIKey))
 	if err != nil {
 		log.Error(err)
 		return err
@@ -271,26 +290,45 @@ func WriteConfigFile() error {
 		return err
 	}
 
-	fmt.Println("Config file written successfully.")
+	fmt.Fprintln(printer.Diagnostics, "Config file written successfully.")
 
 	return nil
 }
 
 func printSetURLPrompt() {
-	fmt.Println("🌐 DataRobot URL Configuration")
-	fmt.Println("")
-	fmt.Println("Choose your DataRobot environment:")
-	fmt.Println("")
-	fmt.Println("┌────────────────────────────────────────────────────────┐")
-	fmt.Println("│  [1] 🇺🇸 US Cloud        https://app.datarobot.com      │")
-	fmt.Println("│  [2] 🇪🇺 EU Cloud        https://app.eu.datarobot.com   │")
-	fmt.Println("│  [3] 🇯🇵 Japan Cloud     https://app.jp.datarobot.com   │")
-	fmt.Println("│      🏢 Custom          Enter your custom URL          │")
-	fmt.Println("└────────────────────────────────────────────────────────┘")
-	fmt.Println("")
-	fmt.Println("🔗 Don't know which one? Check your DataRobot login page URL in your browser.")
-	fmt.Println("")
-	fmt.Print("Enter your choice: ")
+	fmt.Fprintln(printer.Diagnostics, "🌐 DataRobot URL Configuration")
+	fmt.Fprintln(printer.Diagnostics)
+	fmt.Fprintln(printer.Diagnostics, "Choose your DataRobot environment:")
+	fmt.Fprintln(printer.Diagnostics)
+	fmt.Fprintln(printer.Diagnostics, "┌"+strings.Repeat("─", setURLPromptWidth)+"┐")
+
+	for i, region := range config.Regions {
+		printSetURLPromptRow(fmt.Sprintf("[%d]", i+1), region.Title(), region.URL)
+	}
+
+	printSetURLPromptRow("", "🏢 Custom", "Enter your custom URL")
+	fmt.Fprintln(printer.Diagnostics, "└"+strings.Repeat("─", setURLPromptWidth)+"┘")
+	fmt.Fprintln(printer.Diagnostics)
+	fmt.Fprintln(printer.Diagnostics, "🔗 Don't know which one? Check your DataRobot login page URL in your browser.")
+	fmt.Fprintln(printer.Diagnostics)
+	fmt.Fprint(printer.Diagnostics, "Enter a number, a region such as eu, or a URL: ")
+}
+
+// setURLPromptWidth is the inner width of the box listing the regions
+const setURLPromptWidth = 56
+
+// printSetURLPromptRow prints one choice of the set-url prompt, padded to
+// the width of the box. Widths are measured in terminal cells, since flags
+// and other emoji take two.
+func printSetURLPromptRow(key, title, detail string) {
+	row := fmt.Sprintf("  %-4s%s%s  %s", key, title, padding(title, 17), detail)
+
+	fmt.Fprintln(printer.Diagnostics, "│"+row+padding(row, setURLPromptWidth)+"│")
+}
+
+// padding returns the spaces that extend s to width cells
+func padding(s string, width int) string {
+	return strings.Repeat(" ", max(width-lipgloss.Width(s), 0))
 }
 
 func askForNewHost() bool {
@@ -300,14 +338,14 @@ func askForNewHost() bool {
 		return true
 	}
 
-	fmt.Printf("A DataRobot URL of %s is already present; do you want to overwrite it? (y/N): ", datarobotHost)
-
-	selectedOption, err := reader.ReadString()
+	overwrite, err := confirm.Ask(fmt.Sprintf("A DataRobot URL of %s is already present; do you want to overwrite it?", datarobotHost))
 	if err != nil {
+		log.Error(err)
+
 		return false
 	}
 
-	return strings.ToLower(strings.TrimSpace(selectedOption)) == "y"
+	return overwrite
 }
 
 func SetURLAction() bool {
@@ -322,8 +360,9 @@ func SetURLAction() bool {
 
 			err = config.SaveURLToConfig(url)
 			if err != nil {
-				if errors.Is(err, config.ErrInvalidURL) {
-					fmt.Print("\nInvalid URL provided. Verify your URL and try again.\n\n")
+				var endpointErr *apiclient.EndpointError
+				if errors.As(err, &endpointErr) {
+					fmt.Fprintf(printer.Diagnostics, "\n%s Verify your URL and try again.\n\n", endpointErr.Error())
 					continue
 				}
 
@@ -332,13 +371,13 @@ func SetURLAction() bool {
 				break
 			}
 
-			fmt.Println("Environment URL configured successfully!")
+			fmt.Fprintln(printer.Diagnostics, "Environment URL configured successfully!")
 
 			return true
 		}
 	}
 
-	fmt.Println("Exiting without changing the DataRobot URL.")
+	fmt.Fprintln(printer.Diagnostics, "Exiting without changing the DataRobot URL.")
 
 	return false
 }
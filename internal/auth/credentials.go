@@ -0,0 +1,40 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import "github.com/datarobot/cli/internal/credentials"
+
+// TokenSource describes where the active API token was resolved from
+type TokenSource = credentials.Source
+
+const (
+	TokenSourceNone      = credentials.SourceNone
+	TokenSourceFlag      = credentials.SourceFlag
+	TokenSourceTokenFile = credentials.SourceTokenFile
+	TokenSourceEnv       = credentials.SourceEnv
+	TokenSourceConfig    = credentials.SourceConfig
+	TokenSourceKeyring   = credentials.SourceKeyring
+	TokenSourceFile      = credentials.SourceFile
+)
+
+// Credentials is the endpoint and token pair the CLI will use, along with
+// where the token came from
+type Credentials = credentials.Credentials
+
+// ResolveCredentials determines the credentials without contacting the
+// server; see credentials.Resolve for the precedence
+func ResolveCredentials() (Credentials, error) {
+	return credentials.Resolve()
+}
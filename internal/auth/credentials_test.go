@@ -0,0 +1,87 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"testing"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+func setupCredentials(t *testing.T) {
+	t.Helper()
+
+	keyring.MockInit()
+	t.Cleanup(keyring.MockInit)
+	testutil.SetTestHomeDir(t, t.TempDir())
+	t.Setenv("DATAROBOT_ENDPOINT", "")
+	t.Setenv("DATAROBOT_API_TOKEN", "")
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.Set(config.DataRobotURL, "https://app.datarobot.com/api/v2")
+}
+
+func TestResolveCredentialsNone(t *testing.T) {
+	setupCredentials(t)
+
+	creds, err := ResolveCredentials()
+	require.NoError(t, err)
+
+	assert.Empty(t, creds.Token)
+	assert.Equal(t, TokenSourceNone, creds.Source)
+}
+
+func TestResolveCredentialsConfig(t *testing.T) {
+	setupCredentials(t)
+	viper.Set(config.DataRobotAPIKey, "config-token")
+
+	creds, err := ResolveCredentials()
+	require.NoError(t, err)
+
+	assert.Equal(t, "config-token", creds.Token)
+	assert.Equal(t, TokenSourceConfig, creds.Source)
+}
+
+func TestResolveCredentialsKeyring(t *testing.T) {
+	setupCredentials(t)
+
+	_, err := config.StoreToken("stored-token")
+	require.NoError(t, err)
+
+	creds, err := ResolveCredentials()
+	require.NoError(t, err)
+
+	assert.Equal(t, "stored-token", creds.Token)
+	assert.Equal(t, TokenSourceKeyring, creds.Source)
+}
+
+func TestResolveCredentialsEnvWins(t *testing.T) {
+	setupCredentials(t)
+	viper.Set(config.DataRobotAPIKey, "config-token")
+	t.Setenv("DATAROBOT_ENDPOINT", "https://env.datarobot.com")
+	t.Setenv("DATAROBOT_API_TOKEN", "env-token")
+
+	creds, err := ResolveCredentials()
+	require.NoError(t, err)
+
+	assert.Equal(t, "env-token", creds.Token)
+	assert.Equal(t, "https://env.datarobot.com", creds.Endpoint)
+	assert.Equal(t, TokenSourceEnv, creds.Source)
+}
@@ -0,0 +1,195 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/misc/open"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/viper"
+)
+
+const (
+	defaultDevicePollInterval = 5 * time.Second
+	// RFC 8628 section 3.5: increase the interval by 5 seconds on slow_down
+	slowDownIncrement   = 5 * time.Second
+	deviceCodeGrantType = "urn:ietf:params:oauth:grant-type:device_code"
+)
+
+// DeviceAuthorization is the device authorization response defined in
+// RFC 8628 section 3.2
+type DeviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int    `json:"expires_in"`
+	Interval                int    `json:"interval"`
+}
+
+// devicePollWait waits between token requests; replaced in tests
+var devicePollWait = func(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RequestDeviceAuthorization starts the device flow by requesting a device
+// and user code
+func RequestDeviceAuthorization(ctx context.Context, endpoints config.OAuthEndpoints) (*DeviceAuthorization, error) {
+	form := url.Values{"client_id": {endpoints.ClientID}}
+	if endpoints.Scope != "" {
+		form.Set("scope", endpoints.Scope)
+	}
+
+	var authorization DeviceAuthorization
+
+	if err := config.PostOAuthForm(ctx, endpoints.DeviceAuthorizationURL, form, &authorization); err != nil {
+		return nil, fmt.Errorf("Failed to start device authorization: %w", err)
+	}
+
+	if authorization.DeviceCode == "" || authorization.VerificationURI == "" {
+		return nil, errors.New("Device authorization response is missing the device code or verification URL.")
+	}
+
+	return &authorization, nil
+}
+
+// PollDeviceToken polls the token endpoint until the user approves the
+// request, the device code expires, or ctx is done. Per RFC 8628 section
+// 3.5, authorization_pending keeps polling and slow_down increases the
+// polling interval.
+func PollDeviceToken(ctx context.Context, endpoints config.OAuthEndpoints, authorization *DeviceAuthorization) (*config.OAuthToken, error) {
+	interval := defaultDevicePollInterval
+	if authorization.Interval > 0 {
+		interval = time.Duration(authorization.Interval) * time.Second
+	}
+
+	if authorization.ExpiresIn > 0 {
+		var cancel context.CancelFunc
+
+		ctx, cancel = context.WithTimeout(ctx, time.Duration(authorization.ExpiresIn)*time.Second)
+		defer cancel()
+	}
+
+	form := url.Values{
+		"grant_type":  {deviceCodeGrantType},
+		"device_code": {authorization.DeviceCode},
+		"client_id":   {endpoints.ClientID},
+	}
+
+	for {
+		if err := devicePollWait(ctx, interval); err != nil {
+			if errors.Is(err, context.DeadlineExceeded) {
+				return nil, errors.New("Device code expired before the login was approved.")
+			}
+
+			return nil, err
+		}
+
+		var resp config.OAuthTokenResponse
+
+		err := config.PostOAuthForm(ctx, endpoints.TokenURL, form, &resp)
+		if err == nil {
+			return config.ParseOAuthTokenResponse(resp, endpoints.TokenURL, endpoints.ClientID)
+		}
+
+		var oauthErr *config.OAuthError
+		if !errors.As(err, &oauthErr) {
+			return nil, fmt.Errorf("Failed to request OAuth token: %w", err)
+		}
+
+		switch oauthErr.Code {
+		case "authorization_pending":
+			continue
+		case "slow_down":
+			interval += slowDownIncrement
+		case "access_denied":
+			return nil, errors.New("Login request was denied.")
+		case "expired_token":
+			return nil, errors.New("Device code expired before the login was approved.")
+		default:
+			return nil, fmt.Errorf("Failed to request OAuth token: %w", oauthErr)
+		}
+	}
+}
+
+// LoginWithDeviceFlow runs the OAuth device authorization grant against the
+// configured DataRobot URL, prompting the user on out to approve the login
+// in a browser, and stores the resulting tokens.
+func LoginWithDeviceFlow(ctx context.Context, out io.Writer) (*config.AccountInfo, config.TokenLocation, error) {
+	endpoints, err := config.GetOAuthEndpoints()
+	if err != nil {
+		return nil, "", err
+	}
+
+	authorization, err := RequestDeviceAuthorization(ctx, endpoints)
+	if err != nil {
+		return nil, "", err
+	}
+
+	fmt.Fprintln(out, tui.BaseTextStyle.Render("To log in, visit:"))
+	fmt.Fprintln(out, "  "+tui.InfoStyle.Render(authorization.VerificationURI))
+	fmt.Fprintln(out, tui.BaseTextStyle.Render("and enter the code:"))
+	fmt.Fprintln(out, "  "+tui.SuccessStyle.Render(authorization.UserCode))
+	fmt.Fprintln(out)
+
+	if authorization.VerificationURIComplete != "" {
+		open.Open(authorization.VerificationURIComplete)
+	}
+
+	fmt.Fprintln(out, tui.DimStyle.Render("Waiting for approval..."))
+
+	token, err := PollDeviceToken(ctx, endpoints, authorization)
+	if err != nil {
+		return nil, "", err
+	}
+
+	apiEndpoint, err := config.GetAPIURL("")
+	if err != nil {
+		return nil, "", err
+	}
+
+	info, err := config.FetchAccountInfo(apiEndpoint, token.AccessToken)
+	if err != nil {
+		return nil, "", fmt.Errorf("Token validation failed: %w", err)
+	}
+
+	location, err := config.StoreOAuthToken(token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if _, err := config.UnsetConfigFileValue(configTokenKeyPath(config.ActiveProfile())); err != nil {
+		return nil, "", err
+	}
+
+	viper.Set(config.DataRobotAPIKey, token.AccessToken)
+
+	return info, location, nil
+}
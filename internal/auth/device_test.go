@@ -0,0 +1,121 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubPollWait records the requested intervals instead of sleeping
+func stubPollWait(t *testing.T) *[]time.Duration {
+	t.Helper()
+
+	var waits []time.Duration
+
+	original := devicePollWait
+	devicePollWait = func(_ context.Context, d time.Duration) error {
+		waits = append(waits, d)
+
+		return nil
+	}
+
+	t.Cleanup(func() { devicePollWait = original })
+
+	return &waits
+}
+
+func deviceTokenServer(t *testing.T, responses []string) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, deviceCodeGrantType, r.PostForm.Get("grant_type"))
+		assert.Equal(t, "device-123", r.PostForm.Get("device_code"))
+
+		i := int(atomic.AddInt32(&calls, 1)) - 1
+		body := responses[min(i, len(responses)-1)]
+
+		w.Header().Set("Content-Type", "application/json")
+
+		if body[2:7] == "error" {
+			w.WriteHeader(http.StatusBadRequest)
+		}
+
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &calls
+}
+
+func TestPollDeviceTokenHandlesPendingAndSlowDown(t *testing.T) {
+	waits := stubPollWait(t)
+	server, calls := deviceTokenServer(t, []string{
+		`{"error":"authorization_pending"}`,
+		`{"error":"slow_down"}`,
+		`{"error":"authorization_pending"}`,
+		`{"access_token":"access-1","refresh_token":"refresh-1","expires_in":3600}`,
+	})
+
+	endpoints := config.OAuthEndpoints{TokenURL: server.URL, ClientID: "datarobot-cli"}
+	authorization := &DeviceAuthorization{DeviceCode: "device-123", Interval: 1, ExpiresIn: 600}
+
+	token, err := PollDeviceToken(context.Background(), endpoints, authorization)
+	require.NoError(t, err)
+
+	assert.Equal(t, "access-1", token.AccessToken)
+	assert.Equal(t, "refresh-1", token.RefreshToken)
+	assert.Equal(t, server.URL, token.TokenURL)
+	assert.False(t, token.Expired())
+	assert.Equal(t, int32(4), atomic.LoadInt32(calls))
+	assert.Equal(t, []time.Duration{time.Second, time.Second, 6 * time.Second, 6 * time.Second}, *waits)
+}
+
+func TestPollDeviceTokenAccessDenied(t *testing.T) {
+	stubPollWait(t)
+
+	server, _ := deviceTokenServer(t, []string{`{"error":"access_denied"}`})
+
+	endpoints := config.OAuthEndpoints{TokenURL: server.URL, ClientID: "datarobot-cli"}
+	authorization := &DeviceAuthorization{DeviceCode: "device-123"}
+
+	_, err := PollDeviceToken(context.Background(), endpoints, authorization)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "denied")
+}
+
+func TestPollDeviceTokenExpired(t *testing.T) {
+	stubPollWait(t)
+
+	server, _ := deviceTokenServer(t, []string{`{"error":"expired_token"}`})
+
+	endpoints := config.OAuthEndpoints{TokenURL: server.URL, ClientID: "datarobot-cli"}
+	authorization := &DeviceAuthorization{DeviceCode: "device-123"}
+
+	_, err := PollDeviceToken(context.Background(), endpoints, authorization)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "expired")
+}
@@ -0,0 +1,132 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+const logoutConfigYAML = `endpoint: https://app.datarobot.com/api/v2
+token: base-token
+profiles:
+  staging:
+    endpoint: https://staging.datarobot.com/api/v2
+    token: staging-token
+`
+
+// setupLogout points HOME at a temp dir, writes a config file with tokens
+// for the base config and a staging profile, and disables the keyring so
+// tokens are stored in fallback files.
+func setupLogout(t *testing.T) string {
+	t.Helper()
+
+	keyring.MockInitWithError(errors.New("no keyring"))
+	t.Cleanup(keyring.MockInit)
+
+	homeDir := t.TempDir()
+	testutil.SetTestHomeDir(t, homeDir)
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	configPath := filepath.Join(homeDir, "drconfig.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(logoutConfigYAML), 0o600))
+	require.NoError(t, config.ReadConfigFile(configPath))
+
+	return configPath
+}
+
+func storeProfileToken(t *testing.T, profile, token string) string {
+	t.Helper()
+
+	viper.Set(config.ProfileKey, profile)
+	t.Cleanup(func() { viper.Set(config.ProfileKey, "") })
+
+	_, err := config.StoreToken(token)
+	require.NoError(t, err)
+
+	dir, err := config.TokensDir()
+	require.NoError(t, err)
+
+	name := profile
+	if name == "" {
+		name = "default"
+	}
+
+	path := filepath.Join(dir, name)
+	require.FileExists(t, path)
+
+	return path
+}
+
+func TestLogoutClearsActiveProfile(t *testing.T) {
+	configPath := setupLogout(t)
+	defaultPath := storeProfileToken(t, "", "default-token")
+	stagingPath := storeProfileToken(t, "staging", "staging-token")
+
+	viper.Set(config.ProfileKey, "")
+
+	cleared, err := Logout(false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"config file", "token file"}, cleared)
+
+	assert.NoFileExists(t, defaultPath)
+	assert.FileExists(t, stagingPath)
+
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "base-token")
+	assert.Contains(t, string(data), "staging-token")
+}
+
+func TestLogoutAll(t *testing.T) {
+	configPath := setupLogout(t)
+	defaultPath := storeProfileToken(t, "", "default-token")
+	stagingPath := storeProfileToken(t, "staging", "staging-token")
+
+	cleared, err := Logout(true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"config file", "token file"}, cleared)
+
+	assert.NoFileExists(t, defaultPath)
+	assert.NoFileExists(t, stagingPath)
+
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "token:")
+	assert.Contains(t, string(data), "staging.datarobot.com")
+}
+
+func TestLogoutNothingStored(t *testing.T) {
+	keyring.MockInit()
+	t.Cleanup(keyring.MockInit)
+	testutil.SetTestHomeDir(t, t.TempDir())
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	cleared, err := Logout(true)
+	require.NoError(t, err)
+	assert.Empty(t, cleared)
+}
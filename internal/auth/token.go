@@ -0,0 +1,277 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package auth
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+)
+
+const (
+	// minTokenLength is shorter than any token DataRobot issues, so a
+	// shorter one was cut off while copying or pasting
+	minTokenLength = 32
+
+	// Terminals wrap pasted text in these markers while bracketed paste
+	// mode is on, and some leave them in text piped from a paste
+	pasteStart = "\x1b[200~"
+	pasteEnd   = "\x1b[201~"
+
+	tokenPromptText = "Paste your DataRobot API token: "
+)
+
+// ReadToken reads an API token from in. When in is a terminal the token is
+// entered in a tui.MaskedInput, so a pasted newline doesn't end the input
+// early; otherwise a single line is read so tokens can be piped in. Paste markers and surrounding
+// whitespace are removed, and tokens that look truncated or contain
+// characters no token has are rejected before they are sent anywhere.
+func ReadToken(in *os.File, out io.Writer) (string, error) {
+	var (
+		token string
+		err   error
+	)
+
+	interactive := term.IsTerminal(int(in.Fd()))
+
+	if interactive {
+		token, err = promptToken(in, out)
+	} else {
+		token, err = bufio.NewReader(in).ReadString('\n')
+		if errors.Is(err, io.EOF) {
+			err = nil
+		}
+	}
+
+	if err != nil {
+		return "", fmt.Errorf("Failed to read token: %w", err)
+	}
+
+	token = cleanToken(token)
+	if token == "" {
+		return "", errors.New("No token provided.")
+	}
+
+	if err := checkToken(token); err != nil {
+		return "", err
+	}
+
+	if interactive {
+		fmt.Fprintf(out, "Token received: %s (%d characters).\n", maskToken(token), len(token))
+	}
+
+	return token, nil
+}
+
+// tokenPrompt asks for a token in a masked input until Enter or Esc
+type tokenPrompt struct {
+	input     tui.MaskedInput
+	submitted bool
+	done      bool
+}
+
+func newTokenPrompt() tokenPrompt {
+	input := tui.NewMaskedInput(tokenPromptText)
+	input.Focus()
+
+	return tokenPrompt{input: input}
+}
+
+func (p tokenPrompt) Init() tea.Cmd {
+	return nil
+}
+
+func (p tokenPrompt) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok {
+		switch key.Type { //nolint: exhaustive
+		case tea.KeyEnter:
+			p.submitted = true
+			p.done = true
+
+			return p, tea.Quit
+		case tea.KeyEsc:
+			p.done = true
+
+			return p, tea.Quit
+		}
+	}
+
+	var cmd tea.Cmd
+
+	p.input, cmd = p.input.Update(msg)
+
+	return p, cmd
+}
+
+func (p tokenPrompt) View() string {
+	if p.done {
+		return ""
+	}
+
+	return p.input.View()
+}
+
+// promptToken reads a token from the terminal in. Esc and Ctrl+C cancel.
+func promptToken(in *os.File, out io.Writer) (string, error) {
+	final, err := tui.Run(newTokenPrompt(), tea.WithInput(in), tea.WithOutput(out))
+	if err != nil {
+		return "", err
+	}
+
+	prompt, _ := final.(tui.InterruptibleModel).Model.(tokenPrompt)
+	if !prompt.submitted {
+		return "", errors.New("Token entry cancelled.")
+	}
+
+	return prompt.input.Value(), nil
+}
+
+// cleanToken removes bracketed paste markers and the whitespace and line
+// breaks that often come along when a token is copied
+func cleanToken(raw string) string {
+	raw = strings.ReplaceAll(raw, pasteStart, "")
+	raw = strings.ReplaceAll(raw, pasteEnd, "")
+
+	return strings.TrimSpace(raw)
+}
+
+// checkToken rejects tokens that are too short or contain characters that
+// don't appear in DataRobot API keys or OAuth access tokens
+func checkToken(token string) error {
+	if len(token) < minTokenLength {
+		return fmt.Errorf("Token looks truncated: it has %d characters, but DataRobot tokens have at least %d. Copy the whole token and try again.",
+			len(token), minTokenLength)
+	}
+
+	for i, r := range token {
+		if !isTokenChar(r) {
+			return fmt.Errorf("Token contains an unexpected character %q at position %d. Copy only the token and try again.", r, i+1)
+		}
+	}
+
+	return nil
+}
+
+func isTokenChar(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return true
+	default:
+		return strings.ContainsRune("-_.~+/=", r)
+	}
+}
+
+// maskToken hides all but the last four characters of token
+func maskToken(token string) string {
+	return strings.Repeat("*", 8) + token[len(token)-4:]
+}
+
+// LoginWithToken validates the token against the configured endpoint and
+// stores it in the keyring or fallback token file
+func LoginWithToken(token string) (*config.AccountInfo, config.TokenLocation, error) {
+	endpoint, err := config.GetAPIURL("")
+	if err != nil {
+		return nil, "", err
+	}
+
+	info, err := config.FetchAccountInfo(endpoint, token)
+	if err != nil {
+		return nil, "", fmt.Errorf("Token validation failed: %w", err)
+	}
+
+	location, err := config.StoreToken(token)
+	if err != nil {
+		return nil, "", err
+	}
+
+	// A token in the config file would shadow the stored one, so drop it
+	if _, err := config.UnsetConfigFileValue(configTokenKeyPath(config.ActiveProfile())); err != nil {
+		return nil, "", err
+	}
+
+	viper.Set(config.DataRobotAPIKey, token)
+
+	return info, location, nil
+}
+
+// configTokenKeyPath returns where the token for profile lives in the
+// config file; an empty profile refers to the top-level token
+func configTokenKeyPath(profile string) []string {
+	if profile == "" {
+		return []string{config.DataRobotAPIKey}
+	}
+
+	return []string{config.ProfilesKey, profile, config.DataRobotAPIKey}
+}
+
+// Logout clears the API token for the active profile from the config file,
+// the keyring, and the fallback token file. With all set, the tokens of
+// every profile are cleared. It returns the locations a token was removed
+// from, which is empty if nothing was stored.
+func Logout(all bool) ([]string, error) {
+	profiles := []string{config.ActiveProfile()}
+	if all {
+		profiles = append([]string{""}, config.ProfileNames()...)
+	}
+
+	var cleared []string
+
+	inConfig := false
+
+	for _, profile := range profiles {
+		removed, err := config.UnsetConfigFileValue(configTokenKeyPath(profile))
+		if err != nil {
+			return nil, err
+		}
+
+		inConfig = inConfig || removed
+	}
+
+	if inConfig {
+		cleared = append(cleared, "config file")
+	}
+
+	deleteFn := config.DeleteStoredToken
+	if all {
+		deleteFn = config.DeleteAllStoredTokens
+	}
+
+	locations, err := deleteFn()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, location := range locations {
+		if location == config.TokenLocationKeyring {
+			cleared = append(cleared, "OS keyring")
+		} else {
+			cleared = append(cleared, "token file")
+		}
+	}
+
+	viper.Set(config.DataRobotAPIKey, "")
+	clearAccountCache()
+
+	return cleared, nil
+}
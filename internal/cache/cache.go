@@ -0,0 +1,192 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cache lists and removes the files the CLI keeps in its cache
+// directory, such as the template catalog and saved quickstart sessions.
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/datarobot/cli/internal/config"
+)
+
+// Kinds of cached data, as accepted by Clear
+const (
+	Templates   = "templates"
+	UpdateCheck = "update-check"
+	Sessions    = "sessions"
+	Account     = "account"
+	ServerInfo  = "server-info"
+)
+
+// Names of the cached files and directories, relative to config.CacheDir.
+// They match the names used by the packages that write them.
+const (
+	TemplatesFile   = "templates.json"
+	UpdateCheckFile = "update-check.json"
+	AccountFile     = "account.json"
+	SessionsDir     = "sessions"
+	ServerInfoFile  = "server-info.json"
+)
+
+type artifact struct {
+	kind        string
+	name        string
+	description string
+}
+
+// artifacts are the known cached items, in the order they are shown
+var artifacts = []artifact{
+	{Templates, TemplatesFile, "Template catalog"},
+	{UpdateCheck, UpdateCheckFile, "Last update check"},
+	{Sessions, SessionsDir, "Quickstart sessions saved for --resume"},
+	{Account, AccountFile, "Account details shown by dr whoami"},
+	{ServerInfo, ServerInfoFile, "DataRobot server versions, per endpoint"},
+}
+
+// Entry describes one kind of cached data. Size and Files are totals for
+// a directory; ModifiedAt is the time its newest file was written.
+type Entry struct {
+	Kind        string    `json:"kind"        yaml:"kind"`
+	Description string    `json:"description" yaml:"description"`
+	Path        string    `json:"path"        yaml:"path"`
+	Exists      bool      `json:"exists"      yaml:"exists"`
+	Files       int       `json:"files"       yaml:"files"`
+	Size        int64     `json:"size"        yaml:"size"`
+	ModifiedAt  time.Time `json:"modified_at" yaml:"modified_at"`
+}
+
+// Kinds returns every kind of cached data, in display order
+func Kinds() []string {
+	kinds := make([]string, 0, len(artifacts))
+
+	for _, a := range artifacts {
+		kinds = append(kinds, a.kind)
+	}
+
+	return kinds
+}
+
+// List describes every kind of cached data, whether or not any is cached
+func List() ([]Entry, error) {
+	dir, err := config.CacheDir()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]Entry, 0, len(artifacts))
+
+	for _, a := range artifacts {
+		entry, err := inspect(dir, a)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// Clear removes the cached data of the given kinds, or of every kind when
+// none are given, and returns what was removed
+func Clear(kinds []string) ([]Entry, error) {
+	for _, kind := range kinds {
+		if !slices.Contains(Kinds(), kind) {
+			return nil, fmt.Errorf("Unknown cache kind %q; valid kinds are %s.", kind, strings.Join(Kinds(), ", "))
+		}
+	}
+
+	entries, err := List()
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []Entry
+
+	for _, entry := range entries {
+		if !entry.Exists || (len(kinds) > 0 && !slices.Contains(kinds, entry.Kind)) {
+			continue
+		}
+
+		if err := os.RemoveAll(entry.Path); err != nil {
+			return removed, fmt.Errorf("Failed to remove %s: %w", entry.Path, err)
+		}
+
+		removed = append(removed, entry)
+	}
+
+	return removed, nil
+}
+
+// inspect totals the files at the artifact's path
+func inspect(dir string, a artifact) (Entry, error) {
+	entry := Entry{Kind: a.kind, Description: a.description, Path: filepath.Join(dir, a.name)}
+
+	err := filepath.WalkDir(entry.Path, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+
+		entry.Exists = true
+		entry.Files++
+		entry.Size += info.Size()
+
+		if info.ModTime().After(entry.ModifiedAt) {
+			entry.ModifiedAt = info.ModTime()
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return entry, fmt.Errorf("Failed to read %s: %w", entry.Path, err)
+	}
+
+	return entry, nil
+}
+
+// FormatSize returns n bytes in the largest unit that keeps it above one,
+// such as 12 KB
+func FormatSize(n int64) string {
+	const unit = 1024
+
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+
+	value, suffix := float64(n)/unit, "KB"
+
+	for _, next := range []string{"MB", "GB"} {
+		if value < unit {
+			break
+		}
+
+		value, suffix = value/unit, next
+	}
+
+	return fmt.Sprintf("%.1f %s", value, suffix)
+}
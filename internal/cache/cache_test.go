@@ -0,0 +1,134 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupCache points the config directory at a temporary one and returns
+// its cache directory
+func setupCache(t *testing.T) string {
+	t.Helper()
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	dir := t.TempDir()
+	viper.Set(config.ConfigDirKey, dir)
+
+	cacheDir, err := config.CacheDir()
+	require.NoError(t, err)
+	require.NoError(t, os.MkdirAll(filepath.Join(cacheDir, SessionsDir), 0o700))
+
+	return cacheDir
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func entryOf(t *testing.T, entries []Entry, kind string) Entry {
+	t.Helper()
+
+	for _, entry := range entries {
+		if entry.Kind == kind {
+			return entry
+		}
+	}
+
+	t.Fatalf("no entry for %s", kind)
+
+	return Entry{}
+}
+
+func TestList(t *testing.T) {
+	dir := setupCache(t)
+
+	writeFile(t, filepath.Join(dir, TemplatesFile), `{"templates": []}`)
+	writeFile(t, filepath.Join(dir, SessionsDir, "a.json"), "{}")
+	writeFile(t, filepath.Join(dir, SessionsDir, "b.json"), "{}")
+
+	entries, err := List()
+	require.NoError(t, err)
+	assert.Len(t, entries, len(Kinds()))
+
+	templates := entryOf(t, entries, Templates)
+	assert.True(t, templates.Exists)
+	assert.Equal(t, 1, templates.Files)
+	assert.Equal(t, int64(17), templates.Size)
+	assert.False(t, templates.ModifiedAt.IsZero())
+
+	sessions := entryOf(t, entries, Sessions)
+	assert.True(t, sessions.Exists)
+	assert.Equal(t, 2, sessions.Files)
+	assert.Equal(t, int64(4), sessions.Size)
+
+	assert.False(t, entryOf(t, entries, UpdateCheck).Exists)
+}
+
+func TestClearKinds(t *testing.T) {
+	dir := setupCache(t)
+
+	writeFile(t, filepath.Join(dir, TemplatesFile), "{}")
+	writeFile(t, filepath.Join(dir, UpdateCheckFile), "{}")
+
+	removed, err := Clear([]string{Templates, Sessions})
+	require.NoError(t, err)
+	require.Len(t, removed, 1, "an empty kind has nothing to remove")
+	assert.Equal(t, Templates, removed[0].Kind)
+
+	assert.NoFileExists(t, filepath.Join(dir, TemplatesFile))
+	assert.FileExists(t, filepath.Join(dir, UpdateCheckFile))
+}
+
+func TestClearAll(t *testing.T) {
+	dir := setupCache(t)
+
+	writeFile(t, filepath.Join(dir, UpdateCheckFile), "{}")
+	writeFile(t, filepath.Join(dir, SessionsDir, "a.json"), "{}")
+
+	removed, err := Clear(nil)
+	require.NoError(t, err)
+	assert.Len(t, removed, 2)
+
+	assert.NoFileExists(t, filepath.Join(dir, UpdateCheckFile))
+	assert.NoDirExists(t, filepath.Join(dir, SessionsDir))
+}
+
+func TestClearUnknownKind(t *testing.T) {
+	setupCache(t)
+
+	_, err := Clear([]string{"cookies"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "templates, update-check, sessions, account, server-info")
+}
+
+func TestFormatSize(t *testing.T) {
+	assert.Equal(t, "0 B", FormatSize(0))
+	assert.Equal(t, "1023 B", FormatSize(1023))
+	assert.Equal(t, "1.5 KB", FormatSize(1536))
+	assert.Equal(t, "2.0 MB", FormatSize(2<<20))
+	assert.Equal(t, "3.0 GB", FormatSize(3<<30))
+}
@@ -21,6 +21,7 @@ import (
 	"net/url"
 	"strings"
 
+	"github.com/datarobot/cli/internal/apiclient"
 	"github.com/datarobot/cli/internal/version"
 	"github.com/spf13/viper"
 )
@@ -44,9 +45,11 @@ func SchemeHostOnly(longURL string) (string, error) {
 	return parsedURL.String(), nil
 }
 
+// GetBaseURL returns the normalized base URL of the configured endpoint,
+// or an empty string if none is set or it is invalid
 func GetBaseURL() string {
 	if endpoint := viper.GetString(DataRobotURL); endpoint != "" {
-		if newURL, err := SchemeHostOnly(endpoint); err == nil {
+		if newURL, err := apiclient.NormalizeEndpoint(endpoint); err == nil {
 			return newURL
 		}
 	}
@@ -54,13 +57,15 @@ func GetBaseURL() string {
 	return ""
 }
 
-func GetEndpointURL(endpoint string) (string, error) {
-	baseURL := GetBaseURL()
-	if baseURL == "" {
+// GetAPIURL returns the URL of an API route, such as "/account/info/", on
+// the configured endpoint
+func GetAPIURL(route string) (string, error) {
+	endpoint := viper.GetString(DataRobotURL)
+	if endpoint == "" {
 		return "", errors.New("Empty URL.")
 	}
 
-	return baseURL + endpoint, nil
+	return apiclient.APIURL(endpoint, route)
 }
 
 func GetUserAgentHeader() string {
@@ -74,6 +79,16 @@ func RedactedReqInfo(req *http.Request) string {
 		dumpReq.Header.Set("Authorization", "[REDACTED]")
 	}
 
+	// The clone shares the body; dump a fresh copy so req can still be sent
+	if req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return ""
+		}
+
+		dumpReq.Body = body
+	}
+
 	requestDump, err := httputil.DumpRequestOut(dumpReq, true)
 	if err != nil {
 		return ""
@@ -83,12 +98,9 @@ func RedactedReqInfo(req *http.Request) string {
 }
 
 func SaveURLToConfig(newURL string) error {
-	newURL, err := SchemeHostOnly(urlFromShortcut(newURL))
-	if err != nil {
-		return err
-	}
+	newURL = urlFromShortcut(newURL)
 
-	if err = CreateConfigFileDirIfNotExists(); err != nil {
+	if err := CreateConfigFileDirIfNotExists(); err != nil {
 		return err
 	}
 
@@ -98,27 +110,29 @@ func SaveURLToConfig(newURL string) error {
 		viper.Set(DataRobotURL, "")
 		viper.Set(DataRobotAPIKey, "")
 
-		return viper.WriteConfig()
+		if err := SetConfigFileValue(SettingKeyPath(DataRobotURL), ""); err != nil {
+			return err
+		}
+
+		return SetConfigFileValue(SettingKeyPath(DataRobotAPIKey), "")
 	}
 
-	viper.Set(DataRobotURL, newURL+"/api/v2")
+	apiURL, err := apiclient.APIURL(newURL, "")
+	if err != nil {
+		return err
+	}
 
-	return viper.WriteConfig()
+	viper.Set(DataRobotURL, apiURL)
+
+	return SetConfigFileValue(SettingKeyPath(DataRobotURL), apiURL)
 }
 
 func urlFromShortcut(selectedOption string) string {
 	selected := strings.TrimSpace(selectedOption)
 
-	switch selected {
-	case "":
-		return ""
-	case "1":
-		return "https://app.datarobot.com"
-	case "2":
-		return "https://app.eu.datarobot.com"
-	case "3":
-		return "https://app.jp.datarobot.com"
-	default:
-		return selected
+	if region, ok := FindRegion(selected); ok {
+		return region.URL
 	}
+
+	return selected
 }
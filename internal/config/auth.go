@@ -15,18 +15,19 @@
 package config
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
-	"net/url"
-	"time"
 
+	"github.com/datarobot/cli/internal/apiclient"
 	"github.com/datarobot/cli/internal/log"
 	"github.com/spf13/viper"
 )
 
 // VerifyToken verifies if the datarobot endpoint + api key pair correspond to a valid pair.
 func VerifyToken(datarobotEndpoint, token string) error {
-	_, err := url.Parse(datarobotEndpoint)
+	versionURL, err := apiclient.APIURL(datarobotEndpoint, "/version/")
 	if err != nil {
 		return err
 	}
@@ -35,7 +36,7 @@ func VerifyToken(datarobotEndpoint, token string) error {
 		return errors.New("empty token")
 	}
 
-	req, err := http.NewRequest(http.MethodGet, datarobotEndpoint+"/version/", nil)
+	req, err := http.NewRequest(http.MethodGet, versionURL, nil)
 	if err != nil {
 		return err
 	}
@@ -46,9 +47,7 @@ func VerifyToken(datarobotEndpoint, token string) error {
 
 	log.Debug("Request Info: \n" + RedactedReqInfo(req))
 
-	client := &http.Client{
-		Timeout: 30 * time.Second,
-	}
+	client := apiclient.New(0)
 
 	resp, err := client.Do(req)
 	if err != nil {
@@ -64,15 +63,82 @@ func VerifyToken(datarobotEndpoint, token string) error {
 	return nil
 }
 
+// AccountInfo is the subset of /api/v2/account/info/ the CLI uses
+type AccountInfo struct {
+	UID       string `json:"uid"`
+	Username  string `json:"username"`
+	FirstName string `json:"firstName"`
+	LastName  string `json:"lastName"`
+	Email     string `json:"email"`
+	OrgID     string `json:"orgId"`
+	OrgName   string `json:"orgName"`
+}
+
+// FetchAccountInfo validates the endpoint + token pair against the account
+// info endpoint. On failure the error carries the server's message.
+func FetchAccountInfo(datarobotEndpoint, token string) (*AccountInfo, error) {
+	if token == "" {
+		return nil, errors.New("empty token")
+	}
+
+	infoURL, err := apiclient.APIURL(datarobotEndpoint, "/account/info/")
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodGet, infoURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Authorization", "Bearer "+token)
+	req.Header.Add("User-Agent", GetUserAgentHeader())
+
+	log.Debug("Request Info: \n" + RedactedReqInfo(req))
+
+	client := apiclient.New(0)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var apiErr struct {
+			Message string `json:"message"`
+		}
+
+		if err := json.NewDecoder(resp.Body).Decode(&apiErr); err == nil && apiErr.Message != "" {
+			return nil, fmt.Errorf("%s (%s)", apiErr.Message, resp.Status)
+		}
+
+		return nil, errors.New("Response status code is " + resp.Status + ".")
+	}
+
+	var info AccountInfo
+
+	if err := json.NewDecoder(resp.Body).Decode(&info); err != nil {
+		return nil, fmt.Errorf("Failed to decode account info: %w", err)
+	}
+
+	return &info, nil
+}
+
 func GetAPIKey() (string, error) {
 	viperEndpoint := viper.GetString(DataRobotURL)
-	viperToken := viper.GetString(DataRobotAPIKey)
+
+	token, _, err := ResolveToken()
+	if err != nil {
+		return "", err
+	}
 
 	// Returns valid API key if there is one, otherwise returns an empty string
-	err := VerifyToken(viperEndpoint, viperToken)
+	err = VerifyToken(viperEndpoint, token)
 	if err != nil {
 		return "", err
 	}
 
-	return viperToken, nil
+	return token, nil
 }
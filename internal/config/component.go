@@ -98,14 +98,12 @@ func findComponentDefaultsPath() string {
 		}
 	}
 
-	homeDir, err := os.UserHomeDir()
+	// 2. Check the CLI's config directory
+	homeConfigDir, err := Dir()
 	if err != nil {
 		return ""
 	}
 
-	// 2. Check home directory .config/datarobot
-	homeConfigDir := filepath.Join(homeDir, configFileDir)
-
 	homePath := filepath.Join(homeConfigDir, ComponentDefaultsFileName)
 	if _, err := os.Stat(homePath); err == nil {
 		return homePath
@@ -22,22 +22,18 @@ import (
 	"sort"
 	"strings"
 
+	"github.com/datarobot/cli/internal/printer"
 	"github.com/spf13/viper"
 )
 
-var (
-	configFileDir  = filepath.Join(".config", "datarobot") // Can we also support XDG_CONFIG_HOME?
-	configFileName = "drconfig.yaml"
-)
+var configFileName = "drconfig.yaml"
 
 func CreateConfigFileDirIfNotExists() error {
-	homeDir, err := os.UserHomeDir()
+	defaultConfigFileDir, err := Dir()
 	if err != nil {
-		return fmt.Errorf("failed to get home directory: %w", err)
+		return err
 	}
 
-	// Set the default config file directory here to aid in testing
-	defaultConfigFileDir := filepath.Join(homeDir, configFileDir)
 	defaultConfigFilePath := filepath.Join(defaultConfigFileDir, configFileName)
 
 	_, err = os.Stat(defaultConfigFilePath)
@@ -66,13 +62,20 @@ func CreateConfigFileDirIfNotExists() error {
 }
 
 func ReadConfigFile(filePath string) error {
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return fmt.Errorf("failed to get home directory: %w", err)
+	stdinConfig = nil
+
+	if filePath == StdinConfig {
+		if err := readConfigStdin(); err != nil {
+			return err
+		}
+
+		return printDebugConfig()
 	}
 
-	// Set the default config file directory here to aid in testing
-	defaultConfigFileDir := filepath.Join(homeDir, configFileDir)
+	defaultConfigFileDir, err := Dir()
+	if err != nil {
+		return err
+	}
 
 	viper.SetConfigType("yaml")
 
@@ -81,10 +84,22 @@ func ReadConfigFile(filePath string) error {
 			return fmt.Errorf("Config file must have .yaml or .yml extension: %s.", filePath)
 		}
 
-		dir := filepath.Dir(filePath)
-		filename := filepath.Base(filePath)
-		viper.SetConfigName(filename)
-		viper.AddConfigPath(dir)
+		// An explicit config file must exist; bypass the search path entirely
+		// so we never silently fall back to the defaults
+		absPath, err := filepath.Abs(filePath)
+		if err != nil {
+			return fmt.Errorf("Failed to resolve config file path %s: %w", filePath, err)
+		}
+
+		viper.SetConfigFile(absPath)
+
+		if _, err := os.Stat(absPath); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return fmt.Errorf("Config file not found: %s.", absPath)
+			}
+
+			return fmt.Errorf("Failed to access config file %s: %w", absPath, err)
+		}
 	} else {
 		viper.SetConfigName(configFileName)
 		viper.AddConfigPath(defaultConfigFileDir)
@@ -102,13 +117,26 @@ func ReadConfigFile(filePath string) error {
 		}
 	}
 
+	if err := autoMigrateConfigFile(); err != nil {
+		return err
+	}
+
+	if err := expandConfigFile(); err != nil {
+		return err
+	}
+
+	return printDebugConfig()
+}
+
+// printDebugConfig prints the resolved config to stderr with --debug
+func printDebugConfig() error {
 	if viper.GetBool("debug") {
 		output, err := DebugViperConfig()
 		if err != nil {
 			return fmt.Errorf("Failed to generate debug config output: %w", err)
 		}
 
-		fmt.Print(output)
+		fmt.Fprint(printer.Diagnostics, output)
 	}
 
 	return nil
@@ -118,7 +146,11 @@ func DebugViperConfig() (string, error) {
 	var sb strings.Builder
 
 	configFile := viper.ConfigFileUsed()
-	if configFile == "" {
+
+	switch {
+	case FromStdin():
+		configFile = "stdin"
+	case configFile == "":
 		configFile = "none (using defaults and environment variables)"
 	}
 
@@ -141,8 +173,8 @@ func DebugViperConfig() (string, error) {
 		value := viper.Get(key)
 
 		// Skip token because its sensitive
-		if key == "token" {
-			sb.WriteString(fmt.Sprintf("  %s: %s\n", key, "****"))
+		if IsSecretKey(key) {
+			sb.WriteString(fmt.Sprintf("  %s: %s\n", key, redactedValue))
 		} else {
 			sb.WriteString(fmt.Sprintf("  %s: %v\n", key, value))
 		}
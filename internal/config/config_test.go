@@ -81,3 +81,51 @@ func (suite *ConfigTestSuite) TestReadConfigFileWithPreviousFile() {
 	token := viper.GetString("token")
 	suite.Equal(token, readYamlData["token"], "Expected config file to have the same token")
 }
+
+func (suite *ConfigTestSuite) TestReadConfigFileExplicitAbsolutePath() {
+	viper.Reset()
+
+	configPath := filepath.Join(suite.tempDir, "custom.yaml")
+	err := os.WriteFile(configPath, []byte("endpoint: https://custom.datarobot.com/api/v2\n"), 0o644)
+	suite.Require().NoError(err)
+
+	err = ReadConfigFile(configPath)
+	suite.Require().NoError(err)
+
+	suite.Equal(configPath, viper.ConfigFileUsed())
+	suite.Equal("https://custom.datarobot.com/api/v2", viper.GetString(DataRobotURL))
+}
+
+func (suite *ConfigTestSuite) TestReadConfigFileExplicitRelativePath() {
+	viper.Reset()
+
+	wd, err := os.Getwd()
+	suite.Require().NoError(err)
+
+	suite.T().Cleanup(func() { _ = os.Chdir(wd) })
+
+	err = os.MkdirAll(filepath.Join(suite.tempDir, "configs"), 0o755)
+	suite.Require().NoError(err)
+
+	err = os.WriteFile(filepath.Join(suite.tempDir, "configs", "relative.yml"), []byte("endpoint: https://relative.datarobot.com/api/v2\n"), 0o644)
+	suite.Require().NoError(err)
+
+	err = os.Chdir(suite.tempDir)
+	suite.Require().NoError(err)
+
+	err = ReadConfigFile(filepath.Join("configs", "relative.yml"))
+	suite.Require().NoError(err)
+
+	suite.True(filepath.IsAbs(viper.ConfigFileUsed()))
+	suite.Equal("https://relative.datarobot.com/api/v2", viper.GetString(DataRobotURL))
+}
+
+func (suite *ConfigTestSuite) TestReadConfigFileExplicitMissingFile() {
+	viper.Reset()
+
+	configPath := filepath.Join(suite.tempDir, "missing.yaml")
+
+	err := ReadConfigFile(configPath)
+	suite.Require().Error(err)
+	suite.Contains(err.Error(), configPath)
+}
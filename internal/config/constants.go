@@ -14,6 +14,12 @@
 
 package config
 
+// EnvPrefix is prepended to config keys to form the environment variables
+// viper reads automatically, e.g. DATAROBOT_CLI_SKIP_AUTH for skip-auth.
+// It is a variable so forks can change it at build time with
+// -ldflags "-X github.com/datarobot/cli/internal/config.EnvPrefix=ACME_CLI".
+var EnvPrefix = "DATAROBOT_CLI"
+
 const (
 	DataRobotURL    = "endpoint"
 	DataRobotAPIKey = "token"
@@ -0,0 +1,145 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"maps"
+	"sort"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// ContextNameKey selects the active context. It is settable via
+	// --context-name, DATAROBOT_CLI_CONTEXT_NAME, or a top-level
+	// "context-name" key in the config file.
+	ContextNameKey = "context-name"
+	// ContextsKey holds the named contexts in the config file
+	ContextsKey = "contexts"
+	// ContextTemplateKey is the template a context uses by default
+	ContextTemplateKey = "template"
+)
+
+// Context is a named bundle of an endpoint, credentials, and a default
+// template. Its token is stored apart from other tokens, under a name
+// scoped to the context.
+type Context struct {
+	Name     string `json:"name"               yaml:"name"`
+	Endpoint string `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	Template string `json:"template,omitempty" yaml:"template,omitempty"`
+	Active   bool   `json:"active"             yaml:"active"`
+}
+
+// ActiveContext returns the name of the context currently selected, if any
+func ActiveContext() string {
+	return viper.GetString(ContextNameKey)
+}
+
+// ContextNames returns the names of all contexts defined in the config file
+func ContextNames() []string {
+	contexts := viper.GetStringMap(ContextsKey)
+
+	names := make([]string, 0, len(contexts))
+	for name := range contexts {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// HasContext reports whether a context with the given name is defined
+func HasContext(name string) bool {
+	_, ok := viper.GetStringMap(ContextsKey)[name]
+
+	return ok
+}
+
+// Contexts returns the contexts defined in the config file, sorted by name
+func Contexts() []Context {
+	active := ActiveContext()
+	names := ContextNames()
+	contexts := make([]Context, 0, len(names))
+
+	for _, name := range names {
+		prefix := ContextsKey + "." + name + "."
+
+		contexts = append(contexts, Context{
+			Name:     name,
+			Endpoint: viper.GetString(prefix + DataRobotURL),
+			Template: viper.GetString(prefix + ContextTemplateKey),
+			Active:   name == active,
+		})
+	}
+
+	return contexts
+}
+
+// ApplyContext overlays the settings of the named context on top of the
+// config file values and the active profile, so a context wins over a
+// profile. Flags and environment variables still take precedence since
+// the context is merged into the config layer. As with profiles, that
+// layer is only read, so the context's endpoint and credentials never end
+// up at the top level of the file.
+func ApplyContext(name string) error {
+	if name == "" {
+		return nil
+	}
+
+	if !HasContext(name) {
+		return fmt.Errorf("Context %q is not defined in the config file.", name)
+	}
+
+	settings := maps.Clone(viper.GetStringMap(ContextsKey + "." + name))
+
+	// The template is a default for 'dr start', not a setting of its own
+	delete(settings, ContextTemplateKey)
+
+	return viper.MergeConfigMap(settings)
+}
+
+// ContextTemplate returns the default template of the active context
+func ContextTemplate() string {
+	name := ActiveContext()
+	if name == "" {
+		return ""
+	}
+
+	return viper.GetString(ContextsKey + "." + name + "." + ContextTemplateKey)
+}
+
+// SetCurrentContext persists the given context as the current one in the
+// config file. An empty name clears it.
+func SetCurrentContext(name string) error {
+	if name == "" {
+		_, err := UnsetConfigFileValue([]string{ContextNameKey})
+
+		return err
+	}
+
+	if !HasContext(name) {
+		return fmt.Errorf("Context %q is not defined in the config file.", name)
+	}
+
+	return SetConfigFileValue([]string{ContextNameKey}, name)
+}
+
+// contextTokenName is the name the token of a context is stored under, in
+// the keyring and the tokens directory
+func contextTokenName(name string) string {
+	return "context:" + name
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+const contextsYAML = `endpoint: https://app.datarobot.com/api/v2
+profiles:
+  eu:
+    endpoint: https://app.eu.datarobot.com/api/v2
+    max-retries: 1
+contexts:
+  staging:
+    endpoint: https://staging.datarobot.com/api/v2
+    template: Talk to My Data
+  prod:
+    endpoint: https://app.datarobot.com/api/v2
+`
+
+func loadContextsConfig(t *testing.T) string {
+	t.Helper()
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	ConfigureEnv(viper.GetViper())
+
+	configPath := filepath.Join(t.TempDir(), "drconfig.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(contextsYAML), 0o600))
+	require.NoError(t, ReadConfigFile(configPath))
+
+	return configPath
+}
+
+func TestContexts(t *testing.T) {
+	loadContextsConfig(t)
+	viper.Set(ContextNameKey, "staging")
+
+	assert.Equal(t, []string{"prod", "staging"}, ContextNames())
+	assert.True(t, HasContext("prod"))
+	assert.False(t, HasContext("dev"))
+
+	assert.Equal(t, []Context{
+		{Name: "prod", Endpoint: "https://app.datarobot.com/api/v2"},
+		{Name: "staging", Endpoint: "https://staging.datarobot.com/api/v2", Template: "Talk to My Data", Active: true},
+	}, Contexts())
+	assert.Equal(t, "Talk to My Data", ContextTemplate())
+}
+
+func TestApplyContextLayersOverProfile(t *testing.T) {
+	loadContextsConfig(t)
+
+	require.NoError(t, ApplyProfile("eu"))
+	require.NoError(t, ApplyContext("staging"))
+
+	assert.Equal(t, "https://staging.datarobot.com/api/v2", viper.GetString(DataRobotURL))
+	assert.Equal(t, 1, viper.GetInt("max-retries"), "profile settings the context doesn't set are kept")
+	assert.Empty(t, viper.GetString(ContextTemplateKey), "the template is not a setting")
+	assert.Equal(t, "Talk to My Data", viper.GetString("contexts.staging.template"))
+}
+
+func TestSaveURLWithContextKeepsBaseConfig(t *testing.T) {
+	configPath := loadContextsConfig(t)
+	viper.Set(ContextNameKey, "staging")
+
+	require.NoError(t, ApplyContext("staging"))
+	require.NoError(t, SaveURLToConfig("https://new.datarobot.com"))
+
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+
+	// The new URL goes to the context that sets it; nothing else changes
+	want := strings.Replace(contextsYAML, "https://staging.datarobot.com/api/v2", "https://new.datarobot.com/api/v2", 1)
+	assert.Equal(t, want, string(data))
+}
+
+func TestApplyContextEnvStillWins(t *testing.T) {
+	loadContextsConfig(t)
+	t.Setenv("DATAROBOT_CLI_ENDPOINT", "https://env.datarobot.com/api/v2")
+
+	require.NoError(t, ApplyContext("staging"))
+
+	assert.Equal(t, "https://env.datarobot.com/api/v2", viper.GetString(DataRobotURL))
+}
+
+func TestApplyContextUnknown(t *testing.T) {
+	loadContextsConfig(t)
+
+	err := ApplyContext("dev")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `"dev"`)
+
+	require.NoError(t, ApplyContext(""))
+}
+
+func TestSetCurrentContext(t *testing.T) {
+	configPath := loadContextsConfig(t)
+
+	require.NoError(t, SetCurrentContext("prod"))
+
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "context-name: prod")
+
+	require.Error(t, SetCurrentContext("dev"))
+
+	require.NoError(t, SetCurrentContext(""))
+
+	data, err = os.ReadFile(configPath)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "context-name")
+}
+
+func TestContextTokensAreScoped(t *testing.T) {
+	keyring.MockInitWithError(os.ErrPermission)
+	t.Cleanup(keyring.MockInit)
+	testutil.SetTestHomeDir(t, t.TempDir())
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	viper.Set(ProfileKey, "staging")
+	_, err := StoreToken("profile-token")
+	require.NoError(t, err)
+
+	viper.Set(ContextNameKey, "staging")
+	_, err = StoreToken("context-token")
+	require.NoError(t, err)
+
+	dir, err := TokensDir()
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(dir, "context_staging"))
+
+	token, _, err := LoadStoredToken()
+	require.NoError(t, err)
+	assert.Equal(t, "context-token", token)
+
+	viper.Set(ContextNameKey, "")
+
+	token, _, err = LoadStoredToken()
+	require.NoError(t, err)
+	assert.Equal(t, "profile-token", token)
+}
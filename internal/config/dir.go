@@ -0,0 +1,83 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/spf13/viper"
+)
+
+// ConfigDirKey overrides the directory holding the config file, caches,
+// sessions, plugins, and fallback token files. It is set with --config-dir
+// or DATAROBOT_CLI_CONFIG_DIR; a value in the config file itself has no
+// effect, since the file is found through this directory.
+const ConfigDirKey = "config-dir"
+
+// appDirName is the CLI's directory inside the platform's config directory
+const appDirName = "datarobot"
+
+// Dir returns the directory the CLI keeps its files in. Every subsystem
+// that stores files locates them through Dir, so --config-dir moves all of
+// them together.
+//
+// Without an override, it is $XDG_CONFIG_HOME/datarobot when that is set,
+// and otherwise ~/.config/datarobot on Linux and other Unix systems,
+// ~/Library/Application Support/datarobot on macOS, and
+// %AppData%\datarobot on Windows. Existing setups keep working: when that
+// directory doesn't exist but ~/.config/datarobot, the location used by
+// earlier releases, does, that is used instead.
+func Dir() (string, error) {
+	if dir := viper.GetString(ConfigDirKey); dir != "" {
+		return filepath.Abs(dir)
+	}
+
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get home directory: %w", err)
+	}
+
+	legacy := filepath.Join(homeDir, ".config", appDirName)
+	preferred := platformDir(homeDir)
+
+	if preferred != legacy && !dirExists(preferred) && dirExists(legacy) {
+		return legacy, nil
+	}
+
+	return preferred, nil
+}
+
+func platformDir(homeDir string) string {
+	if xdg := os.Getenv("XDG_CONFIG_HOME"); filepath.IsAbs(xdg) {
+		return filepath.Join(xdg, appDirName)
+	}
+
+	if runtime.GOOS == "darwin" || runtime.GOOS == "windows" {
+		if dir, err := os.UserConfigDir(); err == nil {
+			return filepath.Join(dir, appDirName)
+		}
+	}
+
+	return filepath.Join(homeDir, ".config", appDirName)
+}
+
+func dirExists(path string) bool {
+	info, err := os.Stat(path)
+
+	return err == nil && info.IsDir()
+}
@@ -0,0 +1,130 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupDirTest(t *testing.T) string {
+	t.Helper()
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	home := t.TempDir()
+	testutil.SetTestHomeDir(t, home)
+
+	return home
+}
+
+func TestDirOverride(t *testing.T) {
+	setupDirTest(t)
+
+	override := t.TempDir()
+	t.Setenv(EnvVarName(ConfigDirKey), override)
+	ConfigureEnv(viper.GetViper())
+
+	dir, err := Dir()
+
+	require.NoError(t, err)
+	assert.Equal(t, override, dir)
+
+	path, err := ConfigFilePath()
+
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(override, "drconfig.yaml"), path)
+
+	cache, err := CacheDir()
+
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(override, "cache"), cache)
+}
+
+func TestDirOverrideIsMadeAbsolute(t *testing.T) {
+	setupDirTest(t)
+
+	viper.Set(ConfigDirKey, "relative")
+
+	dir, err := Dir()
+
+	require.NoError(t, err)
+	assert.True(t, filepath.IsAbs(dir))
+	assert.Equal(t, "relative", filepath.Base(dir))
+}
+
+func TestDirXDGConfigHome(t *testing.T) {
+	setupDirTest(t)
+
+	xdg := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", xdg)
+
+	dir, err := Dir()
+
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(xdg, "datarobot"), dir)
+}
+
+func TestDirIgnoresRelativeXDGConfigHome(t *testing.T) {
+	if runtime.GOOS == "darwin" || runtime.GOOS == "windows" {
+		t.Skip("the platform default is not under the home directory")
+	}
+
+	home := setupDirTest(t)
+
+	t.Setenv("XDG_CONFIG_HOME", "relative")
+
+	dir, err := Dir()
+
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(home, ".config", "datarobot"), dir)
+}
+
+func TestDirFallsBackToLegacyLocation(t *testing.T) {
+	home := setupDirTest(t)
+
+	legacy := filepath.Join(home, ".config", "datarobot")
+	require.NoError(t, os.MkdirAll(legacy, 0o755))
+
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	dir, err := Dir()
+
+	require.NoError(t, err)
+	assert.Equal(t, legacy, dir)
+}
+
+func TestDirPrefersExistingPlatformLocation(t *testing.T) {
+	home := setupDirTest(t)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(home, ".config", "datarobot"), 0o755))
+
+	xdg := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", xdg)
+	require.NoError(t, os.MkdirAll(filepath.Join(xdg, "datarobot"), 0o755))
+
+	dir, err := Dir()
+
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(xdg, "datarobot"), dir)
+}
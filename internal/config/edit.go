@@ -0,0 +1,178 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigFileTemplate returns the contents of a new config file: a header
+// and every key in Schema, commented out with the kind of value it takes
+func ConfigFileTemplate() string {
+	var b strings.Builder
+
+	b.WriteString("# DataRobot CLI configuration.\n")
+	b.WriteString("# Uncomment the keys you need and set their values. Run\n")
+	b.WriteString("# 'dr self config validate' to check the file.\n")
+	b.WriteString("#\n")
+
+	specs := slices.Clone(Schema)
+	slices.SortStableFunc(specs, func(a, b KeySpec) int { return strings.Compare(a.Key, b.Key) })
+
+	section := ""
+
+	for _, spec := range specs {
+		parent, key, nested := strings.Cut(spec.Key, ".")
+		if !nested {
+			key, parent = parent, ""
+		}
+
+		indent := ""
+
+		if parent != "" {
+			if parent != section {
+				fmt.Fprintf(&b, "# %s:\n", parent)
+			}
+
+			indent = "  "
+		}
+
+		section = parent
+
+		fmt.Fprintf(&b, "# %s%s: %s\n", indent, key, spec.placeholder())
+	}
+
+	return b.String()
+}
+
+// placeholder describes the value a key takes, in a commented template
+func (spec KeySpec) placeholder() string {
+	switch spec.Kind {
+	case KindEnum:
+		return "<" + strings.Join(spec.Values, "|") + ">"
+	case KindRegexps:
+		return "[<regexp>, ...]"
+	case KindBool:
+		return "<true|false>"
+	default:
+		return "<" + string(spec.Kind) + ">"
+	}
+}
+
+// LenientAnnotation marks commands that still run when the config file
+// can't be read or names an undefined profile, so they can repair it
+const LenientAnnotation = "lenient-config"
+
+// ProfileYAML returns the section of the named profile in the config file
+// at path, as a YAML document of its own. A profile that isn't defined yet
+// is empty.
+func ProfileYAML(path, name string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("Failed to read config file: %w", err)
+	}
+
+	var doc yaml.Node
+
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("Failed to parse config file %s: %w", path, err)
+	}
+
+	var section *yaml.Node
+
+	if len(doc.Content) > 0 {
+		if profiles := lookupNode(doc.Content[0], ProfilesKey); profiles != nil {
+			section = lookupNode(profiles, name)
+		}
+	}
+
+	if section == nil {
+		return nil, nil
+	}
+
+	return encodeYAML(section)
+}
+
+// ValidateProfileYAML checks data, the section of the named profile,
+// against Schema
+func ValidateProfileYAML(name string, data []byte, strict bool) ([]Issue, error) {
+	section, err := parseSection(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var values map[string]any
+
+	if err := section.Decode(&values); err != nil {
+		return nil, fmt.Errorf("Failed to parse profile %q: %w", name, err)
+	}
+
+	return ValidateConfig(map[string]any{ProfilesKey: map[string]any{name: values}}, strict), nil
+}
+
+// WriteProfileYAML replaces the section of the named profile in the config
+// file at path with data. The rest of the file is left untouched.
+func WriteProfileYAML(path, name string, data []byte) error {
+	section, err := parseSection(data)
+	if err != nil {
+		return err
+	}
+
+	return rewriteConfigFile(path, "", func(root *yaml.Node) (bool, error) {
+		setNode(root, []string{ProfilesKey, name}, section)
+
+		return true, nil
+	})
+}
+
+// parseSection parses data as a YAML mapping; an empty document is an
+// empty mapping
+func parseSection(data []byte) (*yaml.Node, error) {
+	var doc yaml.Node
+
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("Failed to parse YAML: %w", err)
+	}
+
+	if len(doc.Content) == 0 {
+		return &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}, nil
+	}
+
+	if doc.Content[0].Kind != yaml.MappingNode {
+		return nil, errors.New("The profile must be a YAML mapping of keys to values.")
+	}
+
+	return doc.Content[0], nil
+}
+
+func encodeYAML(node *yaml.Node) ([]byte, error) {
+	var buf bytes.Buffer
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(node); err != nil {
+		return nil, fmt.Errorf("Failed to encode config file: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+)
+
+func TestConfigFileTemplate(t *testing.T) {
+	template := ConfigFileTemplate()
+
+	assert.Contains(t, template, "# endpoint: <url>\n")
+	assert.Contains(t, template, "# output: <text|json|yaml|jsonl>\n")
+	assert.Contains(t, template, "# start:\n#   endpoint-env: <string>\n#   shell: <string>\n")
+
+	// Uncommenting nothing leaves a valid, empty config file
+	var root map[string]any
+
+	require.NoError(t, yaml.Unmarshal([]byte(template), &root))
+	assert.Empty(t, root)
+}
+
+func TestProfileYAMLRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "drconfig.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(profilesYAML), 0o600))
+
+	data, err := ProfileYAML(path, "staging")
+	require.NoError(t, err)
+	assert.Equal(t, "endpoint: https://staging.datarobot.com/api/v2\n", string(data))
+
+	edited := []byte("endpoint: https://staging2.datarobot.com/api/v2\nverbose: 2\n")
+
+	issues, err := ValidateProfileYAML("staging", edited, false)
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+
+	require.NoError(t, WriteProfileYAML(path, "staging", edited))
+
+	written, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, `# connection settings
+endpoint: https://app.datarobot.com/api/v2
+profiles:
+  staging:
+    endpoint: https://staging2.datarobot.com/api/v2
+    verbose: 2
+  dev:
+    endpoint: https://dev.datarobot.com/api/v2
+`, string(written))
+}
+
+func TestProfileYAMLUndefinedProfileIsEmpty(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "drconfig.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(profilesYAML), 0o600))
+
+	data, err := ProfileYAML(path, "prod")
+	require.NoError(t, err)
+	assert.Empty(t, data)
+}
+
+func TestValidateProfileYAML(t *testing.T) {
+	issues, err := ValidateProfileYAML("staging", []byte("endpoint: not a url\n"), false)
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "profiles.staging.endpoint", issues[0].Key)
+	assert.Equal(t, SeverityError, issues[0].Severity)
+
+	_, err = ValidateProfileYAML("staging", []byte("- a list\n"), false)
+	require.ErrorContains(t, err, "must be a YAML mapping")
+}
@@ -0,0 +1,41 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// ConfigureEnv makes v resolve keys from environment variables named by
+// EnvVarName. The "-" to "_" replacer is installed before AutomaticEnv so
+// dashed keys such as skip-auth are found as DATAROBOT_CLI_SKIP_AUTH; this
+// is the only place that wiring should happen.
+func ConfigureEnv(v *viper.Viper) {
+	v.SetEnvPrefix(EnvPrefix)
+	v.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
+	v.AutomaticEnv()
+}
+
+// NewViper returns a viper instance wired to the environment the same way
+// as the CLI's global configuration
+func NewViper() *viper.Viper {
+	v := viper.New()
+
+	ConfigureEnv(v)
+
+	return v
+}
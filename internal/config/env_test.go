@@ -0,0 +1,37 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewViperResolvesDashedKeysFromEnv(t *testing.T) {
+	t.Setenv(EnvVarName("plugin-discovery-timeout"), "5s")
+	t.Setenv(EnvVarName("skip-auth"), "true")
+
+	v := NewViper()
+
+	assert.Equal(t, "5s", v.GetString("plugin-discovery-timeout"))
+	assert.True(t, v.GetBool("skip-auth"))
+}
+
+func TestNewViperIgnoresUnprefixedEnv(t *testing.T) {
+	t.Setenv("SKIP_AUTH", "true")
+
+	assert.False(t, NewViper().GetBool("skip-auth"))
+}
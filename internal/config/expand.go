@@ -0,0 +1,169 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// AllowUnsetEnvKey makes ${VAR} references to unset variables in the config
+// file expand to an empty string instead of failing
+const AllowUnsetEnvKey = "allow-unset-env"
+
+var envNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// expandConfigFile replaces ${VAR} references in the string values of the
+// config file viper has read, including those inside profiles and lists,
+// with the values of the environment variables. The expanded values are
+// merged into the config layer, so flags and the environment still win.
+// Nothing writes that layer back: the file is changed only through
+// SetConfigFileValue, which edits the text on disk and keeps ${VAR}.
+func expandConfigFile() error {
+	path := viper.ConfigFileUsed()
+	if path == "" {
+		return nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("Failed to read config file %s: %w", path, err)
+	}
+
+	return expandConfig("file "+path, data)
+}
+
+// expandConfig expands the config in data, which source names in errors
+func expandConfig(source string, data []byte) error {
+	if !bytes.Contains(data, []byte("$")) {
+		return nil
+	}
+
+	var values map[string]any
+	if err := yaml.Unmarshal(data, &values); err != nil {
+		return fmt.Errorf("Failed to parse config %s: %w", source, err)
+	}
+
+	expanded, err := expandValue("", values, viper.GetBool(AllowUnsetEnvKey))
+	if err != nil {
+		return err
+	}
+
+	expandedValues, _ := expanded.(map[string]any)
+
+	return viper.MergeConfigMap(expandedValues)
+}
+
+// expandValue expands the strings in value, which key names in errors
+func expandValue(key string, value any, allowUnset bool) (any, error) {
+	switch v := value.(type) {
+	case string:
+		return ExpandEnv(key, v, allowUnset)
+	case map[string]any:
+		expanded := make(map[string]any, len(v))
+
+		for k, child := range v {
+			childKey := k
+			if key != "" {
+				childKey = key + "." + k
+			}
+
+			result, err := expandValue(childKey, child, allowUnset)
+			if err != nil {
+				return nil, err
+			}
+
+			expanded[k] = result
+		}
+
+		return expanded, nil
+	case []any:
+		expanded := make([]any, len(v))
+
+		for i, child := range v {
+			result, err := expandValue(fmt.Sprintf("%s[%d]", key, i), child, allowUnset)
+			if err != nil {
+				return nil, err
+			}
+
+			expanded[i] = result
+		}
+
+		return expanded, nil
+	}
+
+	return value, nil
+}
+
+// ExpandEnv replaces each ${VAR} in s with the value of the environment
+// variable VAR; $$ stands for a literal $, and a $ not followed by { is
+// left alone. An unset variable is an error naming it and key, unless
+// allowUnset is true, when it expands to an empty string. Values are not
+// expanded again, so a variable containing ${...} is used as is.
+func ExpandEnv(key, s string, allowUnset bool) (string, error) {
+	if !strings.Contains(s, "$") {
+		return s, nil
+	}
+
+	var sb strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		if s[i] != '$' || i+1 == len(s) {
+			sb.WriteByte(s[i])
+
+			continue
+		}
+
+		if s[i+1] == '$' {
+			sb.WriteByte('$')
+			i++
+
+			continue
+		}
+
+		if s[i+1] != '{' {
+			sb.WriteByte('$')
+
+			continue
+		}
+
+		end := strings.IndexByte(s[i+2:], '}')
+		if end < 0 {
+			return "", fmt.Errorf("Config value %s has a ${ without a closing }. Write $$ for a literal $.", key)
+		}
+
+		name := s[i+2 : i+2+end]
+		if !envNamePattern.MatchString(name) {
+			return "", fmt.Errorf("Config value %s refers to ${%s}, which is not a valid environment variable name.", key, name)
+		}
+
+		value, ok := os.LookupEnv(name)
+		if !ok && !allowUnset {
+			return "", fmt.Errorf("Config value %s refers to environment variable %s, which is not set. Set it, or pass --allow-unset-env to expand it to an empty string.", key, name)
+		}
+
+		sb.WriteString(value)
+
+		i += 2 + end
+	}
+
+	return sb.String(), nil
+}
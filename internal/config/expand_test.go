@@ -0,0 +1,147 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandEnv(t *testing.T) {
+	t.Setenv("DR_HOST", "app.datarobot.com")
+	t.Setenv("DR_EMPTY", "")
+	t.Setenv("DR_REF", "${DR_HOST}")
+
+	tests := []struct {
+		in, want string
+	}{
+		{"https://${DR_HOST}/api/v2", "https://app.datarobot.com/api/v2"},
+		{"${DR_HOST}${DR_HOST}", "app.datarobot.comapp.datarobot.com"},
+		{"a${DR_EMPTY}b", "ab"},
+		{"price: $$5", "price: $5"},
+		{"$${DR_HOST}", "${DR_HOST}"},
+		{"$$$${DR_HOST}", "$${DR_HOST}"},
+		{"$$${DR_HOST}", "$app.datarobot.com"},
+		{"$HOME and $", "$HOME and $"},
+		{"${DR_REF}", "${DR_HOST}"},
+		{"no references", "no references"},
+	}
+
+	for _, tt := range tests {
+		got, err := ExpandEnv("endpoint", tt.in, false)
+		require.NoError(t, err, tt.in)
+		assert.Equal(t, tt.want, got, tt.in)
+	}
+}
+
+func TestExpandEnvErrors(t *testing.T) {
+	_, err := ExpandEnv("endpoint", "https://${DR_UNSET_HOST}/api", false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "DR_UNSET_HOST")
+	assert.Contains(t, err.Error(), "endpoint")
+	assert.Contains(t, err.Error(), "--allow-unset-env")
+
+	got, err := ExpandEnv("endpoint", "https://${DR_UNSET_HOST}/api", true)
+	require.NoError(t, err)
+	assert.Equal(t, "https:///api", got)
+
+	_, err = ExpandEnv("endpoint", "${DR_HOST", false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "closing }")
+
+	_, err = ExpandEnv("endpoint", "${A_${B}}", false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not a valid environment variable name")
+}
+
+func TestReadConfigFileExpandsEnv(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	t.Setenv("DR_HOST", "app.eu.datarobot.com")
+	t.Setenv("DR_TOKEN_DIR", "/secrets")
+
+	path := filepath.Join(t.TempDir(), "drconfig.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`endpoint: https://${DR_HOST}/api/v2
+max-retries: 5
+note: costs $$10
+profiles:
+  staging:
+    endpoint: https://staging.${DR_HOST}/api/v2
+    token-file: ${DR_TOKEN_DIR}/staging
+start:
+  args:
+    - --host=${DR_HOST}
+`), 0o600))
+
+	require.NoError(t, ReadConfigFile(path))
+
+	assert.Equal(t, "https://app.eu.datarobot.com/api/v2", viper.GetString(DataRobotURL))
+	assert.Equal(t, 5, viper.GetInt("max-retries"))
+	assert.Equal(t, "costs $10", viper.GetString("note"))
+	assert.Equal(t, []string{"--host=app.eu.datarobot.com"}, viper.GetStringSlice("start.args"))
+
+	require.NoError(t, ApplyProfile("staging"))
+	assert.Equal(t, "https://staging.app.eu.datarobot.com/api/v2", viper.GetString(DataRobotURL))
+	assert.Equal(t, "/secrets/staging", viper.GetString(TokenFileKey))
+}
+
+func TestSaveAfterExpandKeepsReferences(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	t.Setenv("CA_PATH", "/etc/ssl/ca.pem")
+	t.Setenv("DR_TOKEN", "secret-token")
+
+	const initial = `ca-cert: ${CA_PATH}
+token: ${DR_TOKEN}
+`
+
+	path := filepath.Join(t.TempDir(), "drconfig.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(initial), 0o600))
+
+	require.NoError(t, ReadConfigFile(path))
+	assert.Equal(t, "/etc/ssl/ca.pem", viper.GetString("ca-cert"))
+
+	require.NoError(t, SaveURLToConfig("https://app.eu.datarobot.com"))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, initial+"endpoint: https://app.eu.datarobot.com/api/v2\n", string(data))
+}
+
+func TestReadConfigFileUnsetEnv(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	path := filepath.Join(t.TempDir(), "drconfig.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("profiles:\n  dev:\n    endpoint: https://${DR_UNSET_HOST}/api/v2\n"), 0o600))
+
+	err := ReadConfigFile(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "profiles.dev.endpoint")
+	assert.Contains(t, err.Error(), "DR_UNSET_HOST")
+
+	viper.Reset()
+	viper.Set(AllowUnsetEnvKey, true)
+
+	require.NoError(t, ReadConfigFile(path))
+	assert.Equal(t, "https:///api/v2", viper.GetString("profiles.dev.endpoint"))
+}
@@ -0,0 +1,272 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+const cacheDirName = "cache"
+
+// ConfigFilePath returns the config file viper loaded, or the default
+// location if no file was found. It returns ErrConfigFromStdin when the
+// config was read from stdin.
+func ConfigFilePath() (string, error) {
+	if FromStdin() {
+		return "", ErrConfigFromStdin
+	}
+
+	if used := viper.ConfigFileUsed(); used != "" {
+		return used, nil
+	}
+
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, configFileName), nil
+}
+
+// LoadedConfigFile returns the absolute path of the config file that was
+// read, StdinConfig when the config came from stdin, or "" when no file
+// was found
+func LoadedConfigFile() string {
+	if FromStdin() {
+		return StdinConfig
+	}
+
+	used := viper.ConfigFileUsed()
+	if used == "" {
+		return ""
+	}
+
+	if _, err := os.Stat(used); err != nil {
+		return ""
+	}
+
+	if abs, err := filepath.Abs(used); err == nil {
+		return abs
+	}
+
+	return used
+}
+
+// CacheDir returns the directory holding cached data, such as the result
+// of the last update check
+func CacheDir() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, cacheDirName), nil
+}
+
+// SetConfigFileValue writes a single value into the active config file at
+// the given key path, creating the file and intermediate maps as needed.
+// Unlike viper.WriteConfig, only the targeted key is touched, so comments
+// and values merged in from profiles or the environment are left alone.
+func SetConfigFileValue(keyPath []string, value any) error {
+	return updateConfigFile(func(root *yaml.Node) (bool, error) {
+		var valueNode yaml.Node
+
+		if err := valueNode.Encode(value); err != nil {
+			return false, err
+		}
+
+		setNode(root, keyPath, &valueNode)
+
+		return true, nil
+	})
+}
+
+// SettingKeyPath returns where key lives in the config file: in the active
+// context or profile when it sets key, since a top-level value would be
+// shadowed there, or else at the top level
+func SettingKeyPath(key string) []string {
+	if name := ActiveContext(); name != "" {
+		if _, ok := viper.GetStringMap(ContextsKey + "." + name)[key]; ok {
+			return []string{ContextsKey, name, key}
+		}
+	}
+
+	if name := ActiveProfile(); name != "" {
+		if _, ok := viper.GetStringMap(ProfilesKey + "." + name)[key]; ok {
+			return []string{ProfilesKey, name, key}
+		}
+	}
+
+	return []string{key}
+}
+
+// UnsetConfigFileValue removes the key at the given path from the active
+// config file. It reports whether the key was present.
+func UnsetConfigFileValue(keyPath []string) (bool, error) {
+	var removed bool
+
+	err := updateConfigFile(func(root *yaml.Node) (bool, error) {
+		removed = removeNode(root, keyPath)
+
+		return removed, nil
+	})
+
+	return removed, err
+}
+
+// updateConfigFile parses the active config file, lets fn modify it, and
+// writes it back if fn reports a change
+func updateConfigFile(fn func(root *yaml.Node) (bool, error)) error {
+	path, err := ConfigFilePath()
+	if err != nil {
+		return err
+	}
+
+	return rewriteConfigFile(path, "", fn)
+}
+
+// rewriteConfigFile parses the config file at path, lets fn modify it, and
+// writes it back if fn reports a change. The original contents are first
+// copied to backupPath, unless it is empty.
+func rewriteConfigFile(path, backupPath string, fn func(root *yaml.Node) (bool, error)) error {
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("Failed to read config file: %w", err)
+	}
+
+	var doc yaml.Node
+
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return fmt.Errorf("Failed to parse config file %s: %w", path, err)
+	}
+
+	if len(doc.Content) == 0 {
+		doc.Kind = yaml.DocumentNode
+		doc.Content = []*yaml.Node{{Kind: yaml.MappingNode}}
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return fmt.Errorf("Config file %s must contain a YAML mapping.", path)
+	}
+
+	changed, err := fn(root)
+	if err != nil || !changed {
+		return err
+	}
+
+	var buf bytes.Buffer
+
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(&doc); err != nil {
+		return fmt.Errorf("Failed to encode config file: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf("Failed to create config file directory: %w", err)
+	}
+
+	if backupPath != "" {
+		if err := os.WriteFile(backupPath, data, 0o600); err != nil {
+			return fmt.Errorf("Failed to write config file backup: %w", err)
+		}
+	}
+
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("Failed to write config file: %w", err)
+	}
+
+	return nil
+}
+
+// lookupNode returns the value node for key in a mapping node
+func lookupNode(mapping *yaml.Node, key string) *yaml.Node {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value == key {
+			return mapping.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+func setNode(mapping *yaml.Node, keyPath []string, value *yaml.Node) {
+	key := keyPath[0]
+	existing := lookupNode(mapping, key)
+
+	if len(keyPath) == 1 {
+		if existing != nil {
+			// Keep any comments attached to the previous value
+			value.HeadComment, value.LineComment, value.FootComment =
+				existing.HeadComment, existing.LineComment, existing.FootComment
+			*existing = *value
+
+			return
+		}
+
+		mapping.Content = append(mapping.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, value)
+
+		return
+	}
+
+	if existing == nil || existing.Kind != yaml.MappingNode {
+		child := &yaml.Node{Kind: yaml.MappingNode}
+
+		if existing != nil {
+			*existing = *child
+			child = existing
+		} else {
+			mapping.Content = append(mapping.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, child)
+		}
+
+		existing = child
+	}
+
+	setNode(existing, keyPath[1:], value)
+}
+
+func removeNode(mapping *yaml.Node, keyPath []string) bool {
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value != keyPath[0] {
+			continue
+		}
+
+		if len(keyPath) == 1 {
+			mapping.Content = append(mapping.Content[:i], mapping.Content[i+2:]...)
+
+			return true
+		}
+
+		child := mapping.Content[i+1]
+		if child.Kind != yaml.MappingNode {
+			return false
+		}
+
+		return removeNode(child, keyPath[1:])
+	}
+
+	return false
+}
@@ -0,0 +1,87 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/viper"
+)
+
+// ErrKeyNotSet is returned by GetValue when a key has no value from any
+// source
+var ErrKeyNotSet = errors.New("key not set")
+
+// ConfigKeyPath returns where key is stored in the config file. Dotted
+// keys such as oauth.client-id are nested maps. While a profile is active,
+// keys are stored in that profile's section, except for the profile
+// selection itself.
+func ConfigKeyPath(key string) []string {
+	path := strings.Split(strings.ToLower(key), ".")
+
+	profile := ActiveProfile()
+	if profile == "" || path[0] == ProfileKey || path[0] == ProfilesKey {
+		return path
+	}
+
+	return append([]string{ProfilesKey, profile}, path...)
+}
+
+// ParseValue converts a command line value to the type viper would decode
+// from YAML: integers and true/false become ints and bools, and everything
+// else, including durations such as 2s, stays a string
+func ParseValue(raw string) any {
+	if n, err := strconv.Atoi(raw); err == nil {
+		return n
+	}
+
+	switch strings.ToLower(raw) {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+
+	return raw
+}
+
+// GetValue returns the effective value of key from flags, the environment,
+// the active profile, the config file, or defaults
+func GetValue(key string) (any, error) {
+	if !viper.IsSet(key) {
+		return nil, fmt.Errorf("%w: %s", ErrKeyNotSet, key)
+	}
+
+	return viper.Get(key), nil
+}
+
+// SetValue writes key to the active config file, in the active profile's
+// section if there is one
+func SetValue(key, raw string) error {
+	if key == "" {
+		return errors.New("Key must not be empty.")
+	}
+
+	return SetConfigFileValue(ConfigKeyPath(key), ParseValue(raw))
+}
+
+// UnsetValue removes key from the active config file, in the active
+// profile's section if there is one. It reports whether the key was there.
+func UnsetValue(key string) (bool, error) {
+	return UnsetConfigFileValue(ConfigKeyPath(key))
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseValue(t *testing.T) {
+	assert.Equal(t, 3, ParseValue("3"))
+	assert.Equal(t, true, ParseValue("true"))
+	assert.Equal(t, false, ParseValue("False"))
+	assert.Equal(t, "2s", ParseValue("2s"))
+	assert.Equal(t, "https://app.datarobot.com", ParseValue("https://app.datarobot.com"))
+}
+
+func TestConfigKeyPath(t *testing.T) {
+	loadProfilesConfig(t)
+
+	assert.Equal(t, []string{"oauth", "client-id"}, ConfigKeyPath("oauth.client-id"))
+
+	viper.Set(ProfileKey, "dev")
+
+	assert.Equal(t, []string{ProfilesKey, "dev", "max-retries"}, ConfigKeyPath("max-retries"))
+	assert.Equal(t, []string{ProfileKey}, ConfigKeyPath("profile"), "the profile selection stays top-level")
+}
+
+func TestSetAndUnsetValue(t *testing.T) {
+	configPath := loadProfilesConfig(t)
+
+	require.NoError(t, SetValue("max-retries", "5"))
+	require.NoError(t, SetValue("oauth.client-id", "my-client"))
+
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(data), "# connection settings")
+	assert.Contains(t, string(data), "max-retries: 5\n")
+	assert.Contains(t, string(data), "oauth:\n  client-id: my-client")
+
+	removed, err := UnsetValue("max-retries")
+	require.NoError(t, err)
+	assert.True(t, removed)
+
+	removed, err = UnsetValue("max-retries")
+	require.NoError(t, err)
+	assert.False(t, removed)
+}
+
+func TestSetValueTargetsActiveProfile(t *testing.T) {
+	configPath := loadProfilesConfig(t)
+	viper.Set(ProfileKey, "staging")
+
+	require.NoError(t, SetValue("endpoint", "https://new-staging.datarobot.com/api/v2"))
+
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(data), "endpoint: https://app.datarobot.com/api/v2", "top-level value is untouched")
+	assert.Contains(t, string(data), "endpoint: https://new-staging.datarobot.com/api/v2")
+	assert.NotContains(t, string(data), "https://staging.datarobot.com")
+}
+
+func TestGetValue(t *testing.T) {
+	loadProfilesConfig(t)
+
+	value, err := GetValue("endpoint")
+	require.NoError(t, err)
+	assert.Equal(t, "https://app.datarobot.com/api/v2", value)
+
+	_, err = GetValue("missing")
+	require.ErrorIs(t, err, ErrKeyNotSet)
+}
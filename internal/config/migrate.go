@@ -0,0 +1,294 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/version"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// ConfigVersionKey records which migrations the config file has had.
+	// A file without it is version 0.
+	ConfigVersionKey = "config-version"
+	// NoAutoMigrateKey stops an old config file being migrated when it is
+	// read
+	NoAutoMigrateKey = "no-auto-migrate"
+	// CurrentConfigVersion is the config-version this CLI writes
+	CurrentConfigVersion = 1
+)
+
+// keyRename moves a deprecated key to its current name
+type keyRename struct {
+	from, to string
+}
+
+// configMigration upgrades a config file to version
+type configMigration struct {
+	version int
+	renames []keyRename
+}
+
+// configMigrations lists every migration in version order. Each one is
+// applied to the top level and to every profile and context. Add new
+// migrations at the end and bump CurrentConfigVersion.
+var configMigrations = []configMigration{
+	{version: 1, renames: []keyRename{
+		{from: "apikey", to: DataRobotAPIKey},
+		{from: "api-key", to: DataRobotAPIKey},
+		{from: "api-token", to: DataRobotAPIKey},
+		{from: "url", to: DataRobotURL},
+		{from: "datarobot-url", to: DataRobotURL},
+	}},
+}
+
+// MigrationChange is a key a migration renamed
+type MigrationChange struct {
+	Key    string `json:"key"     yaml:"key"`
+	NewKey string `json:"new_key" yaml:"new_key"`
+	// Dropped is set when NewKey was already present, so the deprecated
+	// key was removed rather than renamed
+	Dropped bool `json:"dropped" yaml:"dropped"`
+}
+
+// MigrationResult reports what MigrateConfigFile did, or would do
+type MigrationResult struct {
+	File        string            `json:"file"             yaml:"file"`
+	FromVersion int               `json:"from_version"     yaml:"from_version"`
+	ToVersion   int               `json:"to_version"       yaml:"to_version"`
+	Migrated    bool              `json:"migrated"         yaml:"migrated"`
+	Backup      string            `json:"backup,omitempty" yaml:"backup,omitempty"`
+	Changes     []MigrationChange `json:"changes"          yaml:"changes"`
+}
+
+// MigrateConfigFile upgrades the config file at path to
+// CurrentConfigVersion, renaming deprecated keys and recording the new
+// config-version. The original file is kept next to it with a .bak suffix.
+// With dryRun the file is left alone and the result shows what would
+// change.
+func MigrateConfigFile(path string, dryRun bool) (MigrationResult, error) {
+	if _, err := os.Stat(path); err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return MigrationResult{}, fmt.Errorf("Config file not found: %s.", path)
+		}
+
+		return MigrationResult{}, fmt.Errorf("Failed to access config file %s: %w", path, err)
+	}
+
+	result := MigrationResult{File: path, ToVersion: CurrentConfigVersion, Changes: []MigrationChange{}}
+
+	backup := ""
+	if !dryRun {
+		backup = path + ".bak"
+	}
+
+	err := rewriteConfigFile(path, backup, func(root *yaml.Node) (bool, error) {
+		from, err := configVersion(root)
+		if err != nil {
+			return false, err
+		}
+
+		result.FromVersion = from
+
+		if from > CurrentConfigVersion {
+			return false, fmt.Errorf("Config file %s has %s %d, but this version of %s only supports up to %d.",
+				path, ConfigVersionKey, from, version.CliName, CurrentConfigVersion)
+		}
+
+		if from == CurrentConfigVersion {
+			return false, nil
+		}
+
+		result.Changes = append(result.Changes, migrateConfig(root, from)...)
+		result.Migrated = true
+
+		setConfigVersion(root, CurrentConfigVersion)
+
+		return !dryRun, nil
+	})
+	if err != nil {
+		return MigrationResult{}, err
+	}
+
+	if result.Migrated {
+		result.Backup = backup
+	}
+
+	return result, nil
+}
+
+// configVersion reads config-version from the root of a config file
+func configVersion(root *yaml.Node) (int, error) {
+	node := lookupNode(root, ConfigVersionKey)
+	if node == nil {
+		return 0, nil
+	}
+
+	n, err := strconv.Atoi(node.Value)
+	if err != nil || node.Kind != yaml.ScalarNode || n < 0 {
+		return 0, fmt.Errorf("%s must be a non-negative integer, got %q.", ConfigVersionKey, node.Value)
+	}
+
+	return n, nil
+}
+
+// setConfigVersion records the version, adding the key at the top of the
+// file if it is missing so it is easy to spot
+func setConfigVersion(root *yaml.Node, n int) {
+	value := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: strconv.Itoa(n)}
+
+	if lookupNode(root, ConfigVersionKey) != nil {
+		setNode(root, []string{ConfigVersionKey}, value)
+
+		return
+	}
+
+	key := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: ConfigVersionKey}
+	root.Content = append([]*yaml.Node{key, value}, root.Content...)
+}
+
+// configSection is the top level of a config file or one profile or
+// context in it
+type configSection struct {
+	prefix string
+	node   *yaml.Node
+}
+
+func configSections(root *yaml.Node) []configSection {
+	sections := []configSection{{node: root}}
+
+	for _, key := range []string{ProfilesKey, ContextsKey} {
+		named := lookupNode(root, key)
+		if named == nil || named.Kind != yaml.MappingNode {
+			continue
+		}
+
+		for i := 0; i+1 < len(named.Content); i += 2 {
+			if entry := named.Content[i+1]; entry.Kind == yaml.MappingNode {
+				prefix := key + "." + named.Content[i].Value + "."
+				sections = append(sections, configSection{prefix: prefix, node: entry})
+			}
+		}
+	}
+
+	return sections
+}
+
+// migrateConfig applies every migration newer than from
+func migrateConfig(root *yaml.Node, from int) []MigrationChange {
+	var changes []MigrationChange
+
+	for _, migration := range configMigrations {
+		if migration.version <= from {
+			continue
+		}
+
+		for _, section := range configSections(root) {
+			for _, rename := range migration.renames {
+				if change, ok := renameKey(section.node, rename); ok {
+					change.Key = section.prefix + change.Key
+					change.NewKey = section.prefix + change.NewKey
+					changes = append(changes, change)
+				}
+			}
+		}
+	}
+
+	return changes
+}
+
+// renameKey renames the key in place, keeping its value, position and
+// comments. If the current name is already set it wins and the deprecated
+// key is removed.
+func renameKey(section *yaml.Node, rename keyRename) (MigrationChange, bool) {
+	for i := 0; i+1 < len(section.Content); i += 2 {
+		if section.Content[i].Value != rename.from {
+			continue
+		}
+
+		change := MigrationChange{Key: rename.from, NewKey: rename.to}
+
+		if lookupNode(section, rename.to) != nil {
+			section.Content = append(section.Content[:i], section.Content[i+2:]...)
+			change.Dropped = true
+		} else {
+			section.Content[i].Value = rename.to
+		}
+
+		return change, true
+	}
+
+	return MigrationChange{}, false
+}
+
+// renamedKey returns the current name of a deprecated key, or "" if key is
+// not one
+func renamedKey(key string) string {
+	for _, migration := range configMigrations {
+		for _, rename := range migration.renames {
+			if rename.from == key {
+				return rename.to
+			}
+		}
+	}
+
+	return ""
+}
+
+// autoMigrateConfigFile migrates the config file viper read when it is
+// older than CurrentConfigVersion and has deprecated keys, then reads it
+// again. A file with nothing to rename is left untouched.
+func autoMigrateConfigFile() error {
+	path := viper.ConfigFileUsed()
+	if path == "" || viper.GetBool(NoAutoMigrateKey) {
+		return nil
+	}
+
+	preview, err := MigrateConfigFile(path, true)
+	if err != nil {
+		log.Warn("Skipping config file migration", "error", err)
+
+		return nil
+	}
+
+	if len(preview.Changes) == 0 {
+		return nil
+	}
+
+	result, err := MigrateConfigFile(path, false)
+	if err != nil {
+		return err
+	}
+
+	log.Warn(fmt.Sprintf("Migrated config file %s from version %d to %d; the original is saved as %s. Pass --%s to turn this off.",
+		result.File, result.FromVersion, result.ToVersion, result.Backup, NoAutoMigrateKey))
+
+	for _, change := range result.Changes {
+		if change.Dropped {
+			log.Warn("Removed deprecated config key", "key", change.Key, "kept", change.NewKey)
+		} else {
+			log.Warn("Renamed config key", "from", change.Key, "to", change.NewKey)
+		}
+	}
+
+	return viper.ReadInConfig()
+}
@@ -0,0 +1,187 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const legacyYAML = `# connection settings
+url: https://app.datarobot.com/api/v2
+apikey: base-token # from the web UI
+profiles:
+  dev:
+    api-token: dev-token
+    token: already-set
+contexts:
+  staging:
+    datarobot-url: https://staging.datarobot.com/api/v2
+`
+
+func writeLegacyConfig(t *testing.T) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "drconfig.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(legacyYAML), 0o600))
+
+	return path
+}
+
+func TestMigrateConfigFileRenamesKeys(t *testing.T) {
+	path := writeLegacyConfig(t)
+
+	result, err := MigrateConfigFile(path, false)
+	require.NoError(t, err)
+
+	assert.True(t, result.Migrated)
+	assert.Equal(t, 0, result.FromVersion)
+	assert.Equal(t, CurrentConfigVersion, result.ToVersion)
+	assert.Equal(t, path+".bak", result.Backup)
+	assert.ElementsMatch(t, []MigrationChange{
+		{Key: "apikey", NewKey: "token"},
+		{Key: "url", NewKey: "endpoint"},
+		{Key: "profiles.dev.api-token", NewKey: "profiles.dev.token", Dropped: true},
+		{Key: "contexts.staging.datarobot-url", NewKey: "contexts.staging.endpoint"},
+	}, result.Changes)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, `config-version: 1
+# connection settings
+endpoint: https://app.datarobot.com/api/v2
+token: base-token # from the web UI
+profiles:
+  dev:
+    token: already-set
+contexts:
+  staging:
+    endpoint: https://staging.datarobot.com/api/v2
+`, string(data))
+
+	backup, err := os.ReadFile(result.Backup)
+	require.NoError(t, err)
+	assert.Equal(t, legacyYAML, string(backup))
+}
+
+func TestMigrateConfigFileDryRun(t *testing.T) {
+	path := writeLegacyConfig(t)
+
+	result, err := MigrateConfigFile(path, true)
+	require.NoError(t, err)
+
+	assert.True(t, result.Migrated)
+	assert.Len(t, result.Changes, 4)
+	assert.Empty(t, result.Backup)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, legacyYAML, string(data))
+	assert.NoFileExists(t, path+".bak")
+}
+
+func TestMigrateConfigFileCurrentVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "drconfig.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("config-version: 1\napikey: kept\n"), 0o600))
+
+	result, err := MigrateConfigFile(path, false)
+	require.NoError(t, err)
+
+	assert.False(t, result.Migrated)
+	assert.Empty(t, result.Changes)
+	assert.NoFileExists(t, path+".bak")
+}
+
+func TestMigrateConfigFileStampsVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "drconfig.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("endpoint: https://app.datarobot.com\n"), 0o600))
+
+	result, err := MigrateConfigFile(path, false)
+	require.NoError(t, err)
+
+	assert.True(t, result.Migrated)
+	assert.Empty(t, result.Changes)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "config-version: 1\nendpoint: https://app.datarobot.com\n", string(data))
+}
+
+func TestMigrateConfigFileNewerVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "drconfig.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("config-version: 99\n"), 0o600))
+
+	_, err := MigrateConfigFile(path, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "only supports up to 1")
+}
+
+func TestMigrateConfigFileInvalidVersion(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "drconfig.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("config-version: two\n"), 0o600))
+
+	_, err := MigrateConfigFile(path, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "config-version must be a non-negative integer")
+}
+
+func TestReadConfigFileAutoMigrates(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	ConfigureEnv(viper.GetViper())
+
+	path := writeLegacyConfig(t)
+	require.NoError(t, ReadConfigFile(path))
+
+	assert.Equal(t, "base-token", viper.GetString(DataRobotAPIKey))
+	assert.Equal(t, CurrentConfigVersion, viper.GetInt(ConfigVersionKey))
+	assert.FileExists(t, path+".bak")
+}
+
+func TestReadConfigFileNoAutoMigrate(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	ConfigureEnv(viper.GetViper())
+	viper.Set(NoAutoMigrateKey, true)
+
+	path := writeLegacyConfig(t)
+	require.NoError(t, ReadConfigFile(path))
+
+	assert.Empty(t, viper.GetString(DataRobotAPIKey))
+	assert.NoFileExists(t, path+".bak")
+}
+
+func TestReadConfigFileLeavesCurrentKeysAlone(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	ConfigureEnv(viper.GetViper())
+
+	path := filepath.Join(t.TempDir(), "drconfig.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("endpoint: https://app.datarobot.com\n"), 0o600))
+	require.NoError(t, ReadConfigFile(path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "endpoint: https://app.datarobot.com\n", string(data))
+}
@@ -0,0 +1,262 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/log"
+	"github.com/spf13/viper"
+)
+
+const (
+	// OAuthClientIDKey is the OAuth client the device flow authenticates as
+	OAuthClientIDKey = "oauth.client-id"
+	// OAuthDeviceURLKey overrides the device authorization endpoint
+	OAuthDeviceURLKey = "oauth.device-authorization-url"
+	// OAuthTokenURLKey overrides the token endpoint
+	OAuthTokenURLKey = "oauth.token-url"
+	// OAuthScopeKey sets the scopes requested by the device flow
+	OAuthScopeKey = "oauth.scope"
+
+	defaultOAuthClientID   = "datarobot-cli"
+	defaultOAuthDevicePath = "/oauth/device/code/"
+	defaultOAuthTokenPath  = "/oauth/token/"
+
+	// Refresh slightly early so a token doesn't expire mid-request
+	oauthExpiryLeeway = 30 * time.Second
+)
+
+// OAuthEndpoints holds the URLs and client used for the OAuth device flow
+type OAuthEndpoints struct {
+	DeviceAuthorizationURL string
+	TokenURL               string
+	ClientID               string
+	Scope                  string
+}
+
+// GetOAuthEndpoints returns the OAuth endpoints for the configured DataRobot
+// URL. Each one can be overridden in the config file under "oauth".
+func GetOAuthEndpoints() (OAuthEndpoints, error) {
+	endpoints := OAuthEndpoints{
+		DeviceAuthorizationURL: viper.GetString(OAuthDeviceURLKey),
+		TokenURL:               viper.GetString(OAuthTokenURLKey),
+		ClientID:               viper.GetString(OAuthClientIDKey),
+		Scope:                  viper.GetString(OAuthScopeKey),
+	}
+
+	if endpoints.ClientID == "" {
+		endpoints.ClientID = defaultOAuthClientID
+	}
+
+	if endpoints.DeviceAuthorizationURL == "" || endpoints.TokenURL == "" {
+		baseURL := GetBaseURL()
+		if baseURL == "" {
+			return endpoints, errors.New("Empty URL.")
+		}
+
+		if endpoints.DeviceAuthorizationURL == "" {
+			endpoints.DeviceAuthorizationURL = baseURL + defaultOAuthDevicePath
+		}
+
+		if endpoints.TokenURL == "" {
+			endpoints.TokenURL = baseURL + defaultOAuthTokenPath
+		}
+	}
+
+	return endpoints, nil
+}
+
+// OAuthToken is an access/refresh token pair obtained through OAuth. The
+// token URL and client are kept alongside so the token can be refreshed
+// without any further configuration.
+type OAuthToken struct {
+	AccessToken  string    `json:"access_token"`
+	RefreshToken string    `json:"refresh_token,omitempty"`
+	TokenType    string    `json:"token_type,omitempty"`
+	Expiry       time.Time `json:"expiry,omitzero"`
+	TokenURL     string    `json:"token_url"`
+	ClientID     string    `json:"client_id"`
+}
+
+// Expired reports whether the access token has expired or is about to
+func (t *OAuthToken) Expired() bool {
+	return !t.Expiry.IsZero() && time.Now().Add(oauthExpiryLeeway).After(t.Expiry)
+}
+
+// OAuthError is an error response from an OAuth endpoint, as defined in
+// RFC 6749 section 5.2
+type OAuthError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+}
+
+func (e *OAuthError) Error() string {
+	if e.Description != "" {
+		return e.Description + " (" + e.Code + ")"
+	}
+
+	return e.Code
+}
+
+// PostOAuthForm sends a form-encoded request to an OAuth endpoint and
+// decodes the JSON response into v. Error responses carrying an "error"
+// field are returned as *OAuthError.
+func PostOAuthForm(ctx context.Context, endpoint string, form url.Values, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", GetUserAgentHeader())
+
+	// The form carries device codes and refresh tokens, so only log the URL
+	log.Debug("OAuth request", "url", endpoint)
+
+	client := apiclient.New(0)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var oauthErr OAuthError
+
+		if err := json.NewDecoder(resp.Body).Decode(&oauthErr); err == nil && oauthErr.Code != "" {
+			return &oauthErr
+		}
+
+		return errors.New("Response status code is " + resp.Status + ".")
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("Failed to decode OAuth response: %w", err)
+	}
+
+	return nil
+}
+
+// ParseOAuthTokenResponse builds an OAuthToken from a token endpoint
+// response body
+func ParseOAuthTokenResponse(resp OAuthTokenResponse, tokenURL, clientID string) (*OAuthToken, error) {
+	if resp.AccessToken == "" {
+		return nil, errors.New("OAuth response did not include an access token.")
+	}
+
+	token := &OAuthToken{
+		AccessToken:  resp.AccessToken,
+		RefreshToken: resp.RefreshToken,
+		TokenType:    resp.TokenType,
+		TokenURL:     tokenURL,
+		ClientID:     clientID,
+	}
+
+	if resp.ExpiresIn > 0 {
+		token.Expiry = time.Now().Add(time.Duration(resp.ExpiresIn) * time.Second)
+	}
+
+	return token, nil
+}
+
+// OAuthTokenResponse is a successful token endpoint response
+type OAuthTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+}
+
+// RefreshOAuthToken exchanges the refresh token for a new access token. The
+// refresh token is kept if the server doesn't rotate it.
+func RefreshOAuthToken(ctx context.Context, token *OAuthToken) (*OAuthToken, error) {
+	if token.RefreshToken == "" {
+		return nil, errors.New("OAuth token has expired and cannot be refreshed.")
+	}
+
+	form := url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {token.RefreshToken},
+		"client_id":     {token.ClientID},
+	}
+
+	var resp OAuthTokenResponse
+
+	if err := PostOAuthForm(ctx, token.TokenURL, form, &resp); err != nil {
+		return nil, fmt.Errorf("Failed to refresh OAuth token: %w", err)
+	}
+
+	refreshed, err := ParseOAuthTokenResponse(resp, token.TokenURL, token.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	if refreshed.RefreshToken == "" {
+		refreshed.RefreshToken = token.RefreshToken
+	}
+
+	return refreshed, nil
+}
+
+// StoreOAuthToken saves the OAuth token for the active profile, in the same
+// locations as StoreToken
+func StoreOAuthToken(token *OAuthToken) (TokenLocation, error) {
+	data, err := json.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+
+	return StoreToken(string(data))
+}
+
+// resolveOAuthSecret returns the access token held in a stored OAuth
+// token, refreshing and re-storing it first if it has expired
+func resolveOAuthSecret(secret string) (string, error) {
+	var token OAuthToken
+
+	if err := json.Unmarshal([]byte(secret), &token); err != nil {
+		return "", fmt.Errorf("Failed to parse stored OAuth token: %w", err)
+	}
+
+	if !token.Expired() {
+		return token.AccessToken, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	refreshed, err := RefreshOAuthToken(ctx, &token)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := StoreOAuthToken(refreshed); err != nil {
+		log.Warn("Failed to store refreshed OAuth token", "error", err)
+	}
+
+	return refreshed.AccessToken, nil
+}
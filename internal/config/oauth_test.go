@@ -0,0 +1,96 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+func TestLoadStoredTokenRefreshesExpiredOAuthToken(t *testing.T) {
+	keyring.MockInit()
+	testutil.SetTestHomeDir(t, t.TempDir())
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		assert.Equal(t, "refresh_token", r.PostForm.Get("grant_type"))
+		assert.Equal(t, "refresh-1", r.PostForm.Get("refresh_token"))
+
+		_, _ = w.Write([]byte(`{"access_token":"access-2","expires_in":3600}`))
+	}))
+	t.Cleanup(server.Close)
+
+	_, err := StoreOAuthToken(&OAuthToken{
+		AccessToken:  "access-1",
+		RefreshToken: "refresh-1",
+		Expiry:       time.Now().Add(-time.Minute),
+		TokenURL:     server.URL,
+		ClientID:     "datarobot-cli",
+	})
+	require.NoError(t, err)
+
+	token, location, err := LoadStoredToken()
+	require.NoError(t, err)
+	assert.Equal(t, "access-2", token)
+	assert.Equal(t, TokenLocationKeyring, location)
+
+	// The refreshed token is stored and keeps the original refresh token
+	secret, err := keyring.Get(keyringService, defaultTokenName)
+	require.NoError(t, err)
+	assert.Contains(t, secret, `"access_token":"access-2"`)
+	assert.Contains(t, secret, `"refresh_token":"refresh-1"`)
+}
+
+func TestLoadStoredTokenValidOAuthToken(t *testing.T) {
+	keyring.MockInit()
+	testutil.SetTestHomeDir(t, t.TempDir())
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	_, err := StoreOAuthToken(&OAuthToken{AccessToken: "access-1", Expiry: time.Now().Add(time.Hour)})
+	require.NoError(t, err)
+
+	token, _, err := LoadStoredToken()
+	require.NoError(t, err)
+	assert.Equal(t, "access-1", token)
+}
+
+func TestGetOAuthEndpointsDefaults(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.Set(DataRobotURL, "https://app.datarobot.com/api/v2")
+
+	endpoints, err := GetOAuthEndpoints()
+	require.NoError(t, err)
+	assert.Equal(t, "https://app.datarobot.com/oauth/device/code/", endpoints.DeviceAuthorizationURL)
+	assert.Equal(t, "https://app.datarobot.com/oauth/token/", endpoints.TokenURL)
+	assert.Equal(t, "datarobot-cli", endpoints.ClientID)
+
+	viper.Set(OAuthTokenURLKey, "https://sso.example.com/token")
+
+	endpoints, err = GetOAuthEndpoints()
+	require.NoError(t, err)
+	assert.Equal(t, "https://sso.example.com/token", endpoints.TokenURL)
+}
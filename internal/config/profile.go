@@ -0,0 +1,89 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	// ProfileKey selects the active profile. It is settable via --profile,
+	// DATAROBOT_CLI_PROFILE, or a top-level "profile" key in the config file.
+	ProfileKey = "profile"
+	// ProfilesKey holds the named profiles in the config file
+	ProfilesKey = "profiles"
+)
+
+// ActiveProfile returns the name of the profile currently selected, if any
+func ActiveProfile() string {
+	return viper.GetString(ProfileKey)
+}
+
+// ProfileNames returns the names of all profiles defined in the config file
+func ProfileNames() []string {
+	profiles := viper.GetStringMap(ProfilesKey)
+
+	names := make([]string, 0, len(profiles))
+	for name := range profiles {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// HasProfile reports whether a profile with the given name is defined
+func HasProfile(name string) bool {
+	_, ok := viper.GetStringMap(ProfilesKey)[name]
+
+	return ok
+}
+
+// ApplyProfile overlays the keys of the named profile on top of the base
+// config file values. Flags and environment variables still take
+// precedence since the profile is merged into the config layer. That
+// layer is only read: the file is changed through SetConfigFileValue,
+// which edits it on disk, so the overlay is never written back.
+func ApplyProfile(name string) error {
+	if name == "" {
+		return nil
+	}
+
+	if !HasProfile(name) {
+		return fmt.Errorf("Profile %q is not defined in the config file.", name)
+	}
+
+	return viper.MergeConfigMap(viper.GetStringMap(ProfilesKey + "." + name))
+}
+
+// SetDefaultProfile persists the given profile as the default in the config
+// file. An empty name clears the default.
+func SetDefaultProfile(name string) error {
+	if name == "" {
+		_, err := UnsetConfigFileValue([]string{ProfileKey})
+
+		return err
+	}
+
+	if !HasProfile(name) {
+		return fmt.Errorf("Profile %q is not defined in the config file.", name)
+	}
+
+	return SetConfigFileValue([]string{ProfileKey}, name)
+}
@@ -0,0 +1,121 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const profilesYAML = `# connection settings
+endpoint: https://app.datarobot.com/api/v2
+profiles:
+  staging:
+    endpoint: https://staging.datarobot.com/api/v2
+  dev:
+    endpoint: https://dev.datarobot.com/api/v2
+`
+
+func loadProfilesConfig(t *testing.T) string {
+	t.Helper()
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	ConfigureEnv(viper.GetViper())
+
+	configPath := filepath.Join(t.TempDir(), "drconfig.yaml")
+	require.NoError(t, os.WriteFile(configPath, []byte(profilesYAML), 0o600))
+	require.NoError(t, ReadConfigFile(configPath))
+
+	return configPath
+}
+
+func TestProfileNames(t *testing.T) {
+	loadProfilesConfig(t)
+
+	assert.Equal(t, []string{"dev", "staging"}, ProfileNames())
+	assert.True(t, HasProfile("dev"))
+	assert.False(t, HasProfile("prod"))
+}
+
+func TestApplyProfileOverlaysBaseConfig(t *testing.T) {
+	loadProfilesConfig(t)
+
+	require.NoError(t, ApplyProfile("staging"))
+
+	assert.Equal(t, "https://staging.datarobot.com/api/v2", viper.GetString(DataRobotURL))
+}
+
+func TestSettingKeyPath(t *testing.T) {
+	loadProfilesConfig(t)
+
+	assert.Equal(t, []string{DataRobotURL}, SettingKeyPath(DataRobotURL))
+
+	viper.Set(ProfileKey, "staging")
+
+	assert.Equal(t, []string{ProfilesKey, "staging", DataRobotURL}, SettingKeyPath(DataRobotURL))
+	assert.Equal(t, []string{DataRobotAPIKey}, SettingKeyPath(DataRobotAPIKey))
+}
+
+func TestApplyProfileEnvStillWins(t *testing.T) {
+	loadProfilesConfig(t)
+	t.Setenv("DATAROBOT_CLI_ENDPOINT", "https://env.datarobot.com/api/v2")
+
+	require.NoError(t, ApplyProfile("staging"))
+
+	assert.Equal(t, "https://env.datarobot.com/api/v2", viper.GetString(DataRobotURL))
+}
+
+func TestApplyProfileUnknown(t *testing.T) {
+	loadProfilesConfig(t)
+
+	err := ApplyProfile("prod")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "prod")
+}
+
+func TestActiveProfileFromEnv(t *testing.T) {
+	loadProfilesConfig(t)
+	t.Setenv("DATAROBOT_CLI_PROFILE", "dev")
+
+	assert.Equal(t, "dev", ActiveProfile())
+}
+
+func TestSetDefaultProfilePersistsAndPreservesComments(t *testing.T) {
+	configPath := loadProfilesConfig(t)
+
+	require.NoError(t, SetDefaultProfile("dev"))
+
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(data), "# connection settings")
+	assert.Contains(t, string(data), "profile: dev")
+	assert.Contains(t, string(data), "endpoint: https://app.datarobot.com/api/v2")
+
+	require.NoError(t, SetDefaultProfile(""))
+
+	data, err = os.ReadFile(configPath)
+	require.NoError(t, err)
+
+	assert.NotContains(t, string(data), "profile: dev")
+	require.Error(t, SetDefaultProfile("prod"))
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Region is a DataRobot cloud environment, offered by name so users do not
+// have to remember its URL
+type Region struct {
+	ID   string `json:"id"   yaml:"id"`
+	Name string `json:"name" yaml:"name"`
+	Flag string `json:"-"    yaml:"-"`
+	URL  string `json:"url"  yaml:"url"`
+}
+
+// Regions lists the known DataRobot cloud endpoints in the order they are
+// offered. Prompts, shortcuts, and 'dr endpoints list' all read from here,
+// so a new region only needs to be added to this list.
+var Regions = []Region{
+	{ID: "us", Name: "US Cloud", Flag: "🇺🇸", URL: "https://app.datarobot.com"},
+	{ID: "eu", Name: "EU Cloud", Flag: "🇪🇺", URL: "https://app.eu.datarobot.com"},
+	{ID: "jp", Name: "Japan Cloud", Flag: "🇯🇵", URL: "https://app.jp.datarobot.com"},
+}
+
+// Title returns the region's name with its flag, for menus
+func (r Region) Title() string {
+	if r.Flag == "" {
+		return r.Name
+	}
+
+	return r.Flag + " " + r.Name
+}
+
+// FindRegion returns the region selected by its number in the prompt
+// (starting at 1) or its ID, ignoring case
+func FindRegion(selection string) (Region, bool) {
+	selection = strings.TrimSpace(selection)
+
+	if n, err := strconv.Atoi(selection); err == nil {
+		if n >= 1 && n <= len(Regions) {
+			return Regions[n-1], true
+		}
+
+		return Region{}, false
+	}
+
+	for _, region := range Regions {
+		if strings.EqualFold(region.ID, selection) {
+			return region, true
+		}
+	}
+
+	return Region{}, false
+}
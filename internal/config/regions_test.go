@@ -0,0 +1,63 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindRegion(t *testing.T) {
+	tests := []struct {
+		selection string
+		want      string
+		found     bool
+	}{
+		{"1", "https://app.datarobot.com", true},
+		{" 2\n", "https://app.eu.datarobot.com", true},
+		{"jp", "https://app.jp.datarobot.com", true},
+		{"EU", "https://app.eu.datarobot.com", true},
+		{"0", "", false},
+		{"4", "", false},
+		{"https://datarobot.example.com", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.selection, func(t *testing.T) {
+			region, found := FindRegion(tt.selection)
+			assert.Equal(t, tt.found, found)
+			assert.Equal(t, tt.want, region.URL)
+		})
+	}
+}
+
+func TestURLFromShortcut(t *testing.T) {
+	assert.Equal(t, "https://app.eu.datarobot.com", urlFromShortcut("eu\n"))
+	assert.Equal(t, "https://datarobot.example.com", urlFromShortcut("https://datarobot.example.com\n"))
+	assert.Empty(t, urlFromShortcut("\n"))
+}
+
+func TestRegionIDsAreUnique(t *testing.T) {
+	seen := map[string]bool{}
+
+	for _, region := range Regions {
+		assert.NotEmpty(t, region.ID)
+		assert.NotEmpty(t, region.URL)
+		assert.False(t, seen[region.ID], "duplicate region ID %q", region.ID)
+
+		seen[region.ID] = true
+	}
+}
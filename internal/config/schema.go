@@ -0,0 +1,404 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/misc/suggest"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/internal/version"
+	"gopkg.in/yaml.v3"
+)
+
+// ValueKind is the type a config key must have
+type ValueKind string
+
+const (
+	KindString   ValueKind = "string"
+	KindBool     ValueKind = "bool"
+	KindInt      ValueKind = "int"
+	KindNumber   ValueKind = "number"
+	KindDuration ValueKind = "duration"
+	KindURL      ValueKind = "url"
+	KindEnum     ValueKind = "enum"
+	KindRegexps  ValueKind = "regexps"
+)
+
+// KeySpec describes a key the config file may contain
+type KeySpec struct {
+	Key  string
+	Kind ValueKind
+	// Min is the smallest value allowed for KindInt and KindNumber keys
+	Min int
+	// Values lists the allowed values for KindEnum keys
+	Values []string
+	// TopLevelOnly keys may not appear inside a profile
+	TopLevelOnly bool
+}
+
+// Schema lists every key the CLI reads from the config file. Dotted keys are
+// nested maps in YAML.
+var Schema = []KeySpec{
+	{Key: DataRobotURL, Kind: KindURL},
+	{Key: DataRobotAPIKey, Kind: KindString},
+	{Key: TokenFileKey, Kind: KindString},
+	{Key: WebURLKey, Kind: KindURL},
+	{Key: ConfigVersionKey, Kind: KindInt, TopLevelOnly: true},
+	{Key: NoAutoMigrateKey, Kind: KindBool, TopLevelOnly: true},
+	{Key: ProfileKey, Kind: KindString, TopLevelOnly: true},
+	{Key: ContextNameKey, Kind: KindString, TopLevelOnly: true},
+	{Key: AllowUnsetEnvKey, Kind: KindBool, TopLevelOnly: true},
+	{Key: printer.OutputKey, Kind: KindEnum, Values: printer.Formats},
+	{Key: log.LevelKey, Kind: KindEnum, Values: []string{"trace", "debug", "info", "warn", "error"}},
+	{Key: log.FormatKey, Kind: KindEnum, Values: []string{log.FormatText, log.FormatJSON}},
+	{Key: log.FileKey, Kind: KindString},
+	{Key: log.FileAppendKey, Kind: KindBool},
+	{Key: log.RedactPatternsKey, Kind: KindRegexps},
+	{Key: log.RedactBuiltinPatternsKey, Kind: KindBool},
+	{Key: "verbose", Kind: KindInt},
+	{Key: "quiet", Kind: KindBool},
+	{Key: "debug", Kind: KindBool},
+	{Key: "no-color", Kind: KindBool},
+	{Key: "assume-yes", Kind: KindBool},
+	{Key: "skip-auth", Kind: KindBool},
+	{Key: "force-interactive", Kind: KindBool},
+	{Key: "skip-onboarding", Kind: KindBool, TopLevelOnly: true},
+	{Key: "default-command", Kind: KindEnum, Values: []string{"help", "start", "onboarding"}},
+	{Key: "external-editor", Kind: KindString},
+	{Key: "plugin-discovery-timeout", Kind: KindDuration},
+	{Key: "plugin.manifest_timeout_ms", Kind: KindInt},
+	{Key: apiclient.RequestTimeoutKey, Kind: KindDuration},
+	{Key: apiclient.PollIntervalKey, Kind: KindDuration},
+	{Key: apiclient.PollTimeoutKey, Kind: KindDuration},
+	{Key: apiclient.RateLimitKey, Kind: KindNumber},
+	{Key: apiclient.OfflineKey, Kind: KindBool},
+	{Key: "download-timeout", Kind: KindDuration},
+	{Key: "templates-cache-ttl", Kind: KindDuration},
+	{Key: "templates-dir", Kind: KindString},
+	{Key: "update-check-interval", Kind: KindDuration},
+	{Key: "disable-self-update", Kind: KindBool},
+	{Key: "telemetry", Kind: KindBool, TopLevelOnly: true},
+	{Key: "telemetry-endpoint", Kind: KindURL, TopLevelOnly: true},
+	{Key: "start.token-env", Kind: KindString},
+	{Key: "start.endpoint-env", Kind: KindString},
+	{Key: "start.shell", Kind: KindString},
+	{Key: apiclient.MaxRetriesKey, Kind: KindInt},
+	{Key: apiclient.RetryBaseDelayKey, Kind: KindDuration},
+	{Key: apiclient.ProxyKey, Kind: KindURL},
+	{Key: apiclient.CACertKey, Kind: KindString},
+	{Key: apiclient.InsecureSkipTLSVerifyKey, Kind: KindBool},
+	{Key: apiclient.TraceFileKey, Kind: KindString},
+	{Key: apiclient.TraceAppendKey, Kind: KindBool},
+	{Key: OAuthClientIDKey, Kind: KindString},
+	{Key: OAuthDeviceURLKey, Kind: KindURL},
+	{Key: OAuthTokenURLKey, Kind: KindURL},
+	{Key: OAuthScopeKey, Kind: KindString},
+}
+
+// Severity tells whether a validation issue fails validation
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Issue is a problem found in the config file
+type Issue struct {
+	Key      string   `json:"key"      yaml:"key"`
+	Severity Severity `json:"severity" yaml:"severity"`
+	Message  string   `json:"message"  yaml:"message"`
+}
+
+// ValidateConfigFile checks the config file at path against Schema. Unknown
+// keys are warnings, or errors when strict. A missing file has no issues.
+func ValidateConfigFile(path string, strict bool) ([]Issue, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("Failed to read config file: %w", err)
+	}
+
+	var root map[string]any
+
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("Failed to parse config file %s: %w", path, err)
+	}
+
+	return ValidateConfig(root, strict), nil
+}
+
+// ValidateConfig checks parsed config file contents against Schema
+func ValidateConfig(root map[string]any, strict bool) []Issue {
+	v := validator{strict: strict}
+
+	profiles, hasProfiles := root[ProfilesKey]
+	contexts, hasContexts := root[ContextsKey]
+
+	top := maps.Clone(root)
+	delete(top, ProfilesKey)
+	delete(top, ContextsKey)
+
+	v.validateSection("", top, false)
+
+	if hasProfiles {
+		v.validateNamed(ProfilesKey, "Profile", profiles)
+	}
+
+	if hasContexts {
+		v.validateNamed(ContextsKey, "Context", contexts)
+	}
+
+	sort.SliceStable(v.issues, func(i, j int) bool { return v.issues[i].Key < v.issues[j].Key })
+
+	return v.issues
+}
+
+type validator struct {
+	strict bool
+	issues []Issue
+}
+
+func (v *validator) add(key string, severity Severity, message string) {
+	v.issues = append(v.issues, Issue{Key: key, Severity: severity, Message: message})
+}
+
+// validateNamed checks the profiles or contexts under key, each a mapping
+// of settings. A context may also name its default template.
+func (v *validator) validateNamed(key, noun string, named any) {
+	section, ok := named.(map[string]any)
+	if !ok {
+		v.add(key, SeverityError, fmt.Sprintf("Must be a mapping of %s names to settings.", strings.ToLower(noun)))
+
+		return
+	}
+
+	for name, entry := range section {
+		prefix := key + "." + name
+
+		settings, ok := entry.(map[string]any)
+		if !ok {
+			if entry != nil {
+				v.add(prefix, SeverityError, noun+" must be a mapping of settings.")
+			}
+
+			continue
+		}
+
+		if key == ContextsKey {
+			if template, ok := settings[ContextTemplateKey]; ok {
+				if _, isString := template.(string); !isString {
+					v.add(prefix+"."+ContextTemplateKey, SeverityError, "Must be a string.")
+				}
+
+				settings = maps.Clone(settings)
+				delete(settings, ContextTemplateKey)
+			}
+		}
+
+		v.validateSection(prefix, settings, true)
+	}
+}
+
+// validateSection checks the keys of the top level or of one profile or
+// context
+func (v *validator) validateSection(prefix string, section map[string]any, inProfile bool) {
+	for key, value := range flatten("", section) {
+		fullKey := key
+		if prefix != "" {
+			fullKey = prefix + "." + key
+		}
+
+		spec, ok := lookupSpec(key)
+		if !ok {
+			v.unknownKey(fullKey, key)
+
+			continue
+		}
+
+		if inProfile && spec.TopLevelOnly {
+			v.add(fullKey, SeverityError, fmt.Sprintf("%s can only be set at the top level.", key))
+
+			continue
+		}
+
+		if err := spec.check(value); err != nil {
+			v.add(fullKey, SeverityError, err.Error())
+		}
+	}
+}
+
+func (v *validator) unknownKey(fullKey, key string) {
+	severity := SeverityWarning
+	if v.strict {
+		severity = SeverityError
+	}
+
+	message := "Unknown key."
+	if current := renamedKey(key); current != "" {
+		message = fmt.Sprintf("Deprecated key, now %q; run %s self config migrate to rename it.", current, version.CliName)
+	} else if suggestion := suggestKey(key); suggestion != "" {
+		message = fmt.Sprintf("Unknown key; did you mean %q?", suggestion)
+	}
+
+	v.add(fullKey, severity, message)
+}
+
+// flatten turns nested maps into dotted keys, stopping at maps that are
+// known keys' values
+func flatten(prefix string, section map[string]any) map[string]any {
+	out := make(map[string]any)
+
+	for key, value := range section {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		if nested, ok := value.(map[string]any); ok {
+			if _, known := lookupSpec(key); !known {
+				for k, val := range flatten(key, nested) {
+					out[k] = val
+				}
+
+				continue
+			}
+		}
+
+		out[key] = value
+	}
+
+	return out
+}
+
+// checkRegexps validates a list of regular expressions
+func checkRegexps(value any) error {
+	list, ok := value.([]any)
+	if !ok {
+		return fmt.Errorf("Must be a list of regular expressions, got %v.", value)
+	}
+
+	for _, item := range list {
+		s, ok := item.(string)
+		if !ok {
+			return fmt.Errorf("Must be a list of regular expressions, got %v.", item)
+		}
+
+		if _, err := regexp.Compile(s); err != nil {
+			return fmt.Errorf("Invalid regular expression %q: %v.", s, err)
+		}
+	}
+
+	return nil
+}
+
+func lookupSpec(key string) (KeySpec, bool) {
+	key = strings.ToLower(key)
+
+	for _, spec := range Schema {
+		if spec.Key == key {
+			return spec, true
+		}
+	}
+
+	return KeySpec{}, false
+}
+
+// check validates a value decoded from YAML against the spec
+func (spec KeySpec) check(value any) error {
+	switch spec.Kind {
+	case KindBool:
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("Must be true or false, got %v.", value)
+		}
+	case KindInt:
+		n, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("Must be an integer, got %v.", value)
+		}
+
+		if n < spec.Min {
+			return fmt.Errorf("Must be at least %d, got %d.", spec.Min, n)
+		}
+	case KindNumber:
+		var n float64
+
+		switch v := value.(type) {
+		case int:
+			n = float64(v)
+		case float64:
+			n = v
+		default:
+			return fmt.Errorf("Must be a number, got %v.", value)
+		}
+
+		if n < float64(spec.Min) {
+			return fmt.Errorf("Must be at least %d, got %v.", spec.Min, value)
+		}
+	case KindDuration:
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("Must be a duration such as 30s or 2m, got %v.", value)
+		}
+
+		if _, err := time.ParseDuration(s); err != nil {
+			return fmt.Errorf("Must be a duration such as 30s or 2m, got %q.", s)
+		}
+	case KindURL:
+		s, _ := value.(string)
+
+		u, err := url.Parse(s)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("Must be an http or https URL, got %v.", value)
+		}
+	case KindEnum:
+		s, _ := value.(string)
+		if !slices.Contains(spec.Values, strings.ToLower(s)) {
+			return fmt.Errorf("Must be one of %s, got %v.", strings.Join(spec.Values, ", "), value)
+		}
+	case KindString:
+		if _, ok := value.(string); !ok {
+			return fmt.Errorf("Must be a string, got %v.", value)
+		}
+	case KindRegexps:
+		return checkRegexps(value)
+	}
+
+	return nil
+}
+
+// suggestKey returns the known key closest to key, if it is likely a typo
+func suggestKey(key string) string {
+	keys := make([]string, 0, len(Schema))
+	for _, spec := range Schema {
+		keys = append(keys, spec.Key)
+	}
+
+	return suggest.Closest(key, keys, 2)
+}
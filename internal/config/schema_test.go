@@ -0,0 +1,121 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func issuesByKey(issues []Issue) map[string]Issue {
+	byKey := make(map[string]Issue, len(issues))
+
+	for _, issue := range issues {
+		byKey[issue.Key] = issue
+	}
+
+	return byKey
+}
+
+func TestValidateConfigValid(t *testing.T) {
+	issues := ValidateConfig(map[string]any{
+		"endpoint":    "https://app.datarobot.com/api/v2",
+		"max-retries": 0,
+		"rate-limit":  2,
+		"output":      "json",
+		"oauth":       map[string]any{"client-id": "dr-cli"},
+		"redact":      map[string]any{"patterns": []any{`internal-[0-9]{4}`}, "builtin-patterns": false},
+		"profile":     "dev",
+		"profiles": map[string]any{
+			"dev": map[string]any{"endpoint": "https://dev.datarobot.com", "skip-auth": true, "rate-limit": 0.5},
+		},
+		"context-name": "staging",
+		"contexts": map[string]any{
+			"staging": map[string]any{"endpoint": "https://staging.datarobot.com", "template": "Talk to My Data"},
+		},
+	}, true)
+
+	assert.Empty(t, issues)
+}
+
+func TestValidateConfigTypesAndRanges(t *testing.T) {
+	byKey := issuesByKey(ValidateConfig(map[string]any{
+		"endpoint":                 "app.datarobot.com",
+		"max-retries":              -1,
+		"rate-limit":               -0.5,
+		"skip-auth":                "yes",
+		"plugin-discovery-timeout": "soon",
+		"output":                   "xml",
+		"redact":                   map[string]any{"patterns": []any{"(unclosed"}},
+		"profiles": map[string]any{
+			"dev": map[string]any{"retry-base-delay": 5, "profile": "other"},
+		},
+		"contexts": map[string]any{
+			"staging": map[string]any{"template": 3, "context-name": "prod"},
+		},
+	}, false))
+
+	for _, key := range []string{
+		"endpoint", "max-retries", "rate-limit", "skip-auth", "plugin-discovery-timeout", "output", "redact.patterns",
+		"profiles.dev.retry-base-delay", "profiles.dev.profile",
+		"contexts.staging.template", "contexts.staging.context-name",
+	} {
+		assert.Equal(t, SeverityError, byKey[key].Severity, key)
+	}
+}
+
+func TestValidateConfigUnknownKeys(t *testing.T) {
+	root := map[string]any{"endpont": "https://app.datarobot.com", "oauth": map[string]any{"colour": "green"}}
+
+	byKey := issuesByKey(ValidateConfig(root, false))
+
+	assert.Equal(t, SeverityWarning, byKey["endpont"].Severity)
+	assert.Contains(t, byKey["endpont"].Message, `did you mean "endpoint"`)
+	assert.Equal(t, SeverityWarning, byKey["oauth.colour"].Severity)
+
+	byKey = issuesByKey(ValidateConfig(root, true))
+
+	assert.Equal(t, SeverityError, byKey["endpont"].Severity)
+}
+
+func TestValidateConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "drconfig.yaml")
+
+	issues, err := ValidateConfigFile(path, false)
+	require.NoError(t, err)
+	assert.Empty(t, issues, "a missing file is valid")
+
+	require.NoError(t, os.WriteFile(path, []byte("max-retries: lots\n"), 0o600))
+
+	issues, err = ValidateConfigFile(path, false)
+	require.NoError(t, err)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "max-retries", issues[0].Key)
+}
+
+func TestValidateConfigDeprecatedKey(t *testing.T) {
+	byKey := issuesByKey(ValidateConfig(map[string]any{
+		"config-version": 0,
+		"apikey":         "secret",
+	}, false))
+
+	require.Len(t, byKey, 1)
+	assert.Equal(t, SeverityWarning, byKey["apikey"].Severity)
+	assert.Contains(t, byKey["apikey"].Message, `Deprecated key, now "token"`)
+}
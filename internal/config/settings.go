@@ -0,0 +1,161 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// Source describes where the effective value of a configuration key came from
+type Source string
+
+const (
+	SourceFlag    Source = "flag"
+	SourceEnv     Source = "env"
+	SourceFile    Source = "file"
+	SourceDefault Source = "default"
+)
+
+const redactedValue = "****"
+
+// envAliases lists the additional environment variables that are bound
+// explicitly to a config key (see initializeConfig in cmd/root.go and
+// auth.EnsureAuthenticated). Viper consults them after the prefixed name.
+var envAliases = map[string][]string{
+	"external-editor": {"VISUAL", "EDITOR"},
+	DataRobotURL:      {"DATAROBOT_ENDPOINT", "DATAROBOT_API_ENDPOINT"},
+	DataRobotAPIKey:   {"DATAROBOT_API_TOKEN"},
+}
+
+// Setting is the resolved state of a single configuration key
+type Setting struct {
+	Key    string   `json:"key" yaml:"key"`
+	Value  any      `json:"value" yaml:"value"`
+	Source Source   `json:"source" yaml:"source"`
+	EnvVar string   `json:"env" yaml:"env"`
+	Alias  []string `json:"env_aliases,omitempty" yaml:"env_aliases,omitempty"`
+}
+
+// EnvVarName returns the environment variable viper consults for a key,
+// applying EnvPrefix and the "-" to "_" key replacer
+func EnvVarName(key string) string {
+	name := strings.ReplaceAll(key, "-", "_")
+
+	return EnvPrefix + "_" + strings.ToUpper(name)
+}
+
+// IsSecretKey reports whether the value of a key should be redacted.
+// Matches keys such as "token", "api-token" or "oauth.client-secret".
+func IsSecretKey(key string) bool {
+	key = strings.ToLower(key)
+
+	for _, marker := range []string{"token", "secret", "password", "apikey", "api-key"} {
+		if key == marker || strings.HasSuffix(key, "-"+marker) || strings.HasSuffix(key, "."+marker) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ResolveSettings returns every key registered with viper along with its
+// effective value and the source it was resolved from. Flags are used to
+// detect values explicitly set on the command line and may be nil.
+func ResolveSettings(flags *pflag.FlagSet, showSecrets bool) []Setting {
+	keys := viper.AllKeys()
+	sort.Strings(keys)
+
+	settings := make([]Setting, 0, len(keys))
+
+	for _, key := range keys {
+		setting := Setting{
+			Key:    key,
+			Value:  viper.Get(key),
+			Source: resolveSource(flags, key),
+			EnvVar: EnvVarName(key),
+			Alias:  envAliases[key],
+		}
+
+		if !showSecrets && IsSecretKey(key) {
+			setting.Value = redactedValue
+		}
+
+		settings = append(settings, setting)
+	}
+
+	return settings
+}
+
+func resolveSource(flags *pflag.FlagSet, key string) Source {
+	if flags != nil {
+		if flag := flags.Lookup(key); flag != nil && flag.Changed {
+			return SourceFlag
+		}
+	}
+
+	if _, ok := os.LookupEnv(EnvVarName(key)); ok {
+		return SourceEnv
+	}
+
+	for _, alias := range envAliases[key] {
+		if _, ok := os.LookupEnv(alias); ok {
+			return SourceEnv
+		}
+	}
+
+	if viper.InConfig(key) {
+		return SourceFile
+	}
+
+	return SourceDefault
+}
+
+// EnvVar is an environment variable consulted for a config key
+type EnvVar struct {
+	Key   string `json:"key"             yaml:"key"`
+	Name  string `json:"name"            yaml:"name"`
+	Set   bool   `json:"set"             yaml:"set"`
+	Value string `json:"value,omitempty" yaml:"value,omitempty"`
+}
+
+// ConsultedEnvVars returns the environment variables viper consults for
+// every registered key, in the order they are tried: the prefixed name,
+// then any aliases. Values of secret keys are redacted.
+func ConsultedEnvVars() []EnvVar {
+	keys := viper.AllKeys()
+	sort.Strings(keys)
+
+	var vars []EnvVar
+
+	for _, key := range keys {
+		for _, name := range append([]string{EnvVarName(key)}, envAliases[key]...) {
+			envVar := EnvVar{Key: key, Name: name}
+
+			envVar.Value, envVar.Set = os.LookupEnv(name)
+			if envVar.Set && envVar.Value != "" && IsSecretKey(key) {
+				envVar.Value = redactedValue
+			}
+
+			vars = append(vars, envVar)
+		}
+	}
+
+	return vars
+}
@@ -0,0 +1,118 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnvVarName(t *testing.T) {
+	assert.Equal(t, "DATAROBOT_CLI_SKIP_AUTH", EnvVarName("skip-auth"))
+	assert.Equal(t, "DATAROBOT_CLI_ENDPOINT", EnvVarName("endpoint"))
+	assert.Equal(t, "DATAROBOT_CLI_PLUGIN_DISCOVERY_TIMEOUT", EnvVarName("plugin-discovery-timeout"))
+}
+
+func TestIsSecretKey(t *testing.T) {
+	tests := []struct {
+		key    string
+		secret bool
+	}{
+		{"token", true},
+		{"api-token", true},
+		{"oauth.client-secret", true},
+		{"proxy-password", true},
+		{"endpoint", false},
+		{"show-secrets", false},
+		{"token-file", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.secret, IsSecretKey(tt.key), tt.key)
+	}
+}
+
+func TestResolveSettingsSources(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	ConfigureEnv(viper.GetViper())
+	viper.SetConfigType("yaml")
+
+	err := viper.ReadConfig(strings.NewReader("endpoint: https://app.datarobot.com/api/v2\ntoken: secret-value\n"))
+	require.NoError(t, err)
+
+	viper.SetDefault("external-editor", "vi")
+	viper.SetDefault("force-interactive", false)
+
+	t.Setenv("DATAROBOT_CLI_SKIP_AUTH", "true")
+	viper.SetDefault("skip-auth", false)
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.Bool("debug", false, "")
+	require.NoError(t, flags.Parse([]string{"--debug"}))
+	require.NoError(t, viper.BindPFlags(flags))
+
+	byKey := make(map[string]Setting)
+
+	for _, s := range ResolveSettings(flags, false) {
+		byKey[s.Key] = s
+	}
+
+	assert.Equal(t, SourceFlag, byKey["debug"].Source)
+	assert.Equal(t, SourceEnv, byKey["skip-auth"].Source)
+	assert.Equal(t, "DATAROBOT_CLI_SKIP_AUTH", byKey["skip-auth"].EnvVar)
+	assert.Equal(t, SourceFile, byKey["endpoint"].Source)
+	assert.Equal(t, SourceDefault, byKey["force-interactive"].Source)
+	assert.Equal(t, []string{"VISUAL", "EDITOR"}, byKey["external-editor"].Alias)
+	assert.Equal(t, redactedValue, byKey["token"].Value)
+
+	for _, s := range ResolveSettings(flags, true) {
+		if s.Key == "token" {
+			assert.Equal(t, "secret-value", s.Value)
+		}
+	}
+}
+
+func TestConsultedEnvVars(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	ConfigureEnv(viper.GetViper())
+
+	viper.SetDefault("skip-auth", false)
+	viper.SetDefault("external-editor", "vi")
+	viper.SetDefault("token", "")
+
+	t.Setenv("DATAROBOT_CLI_SKIP_AUTH", "true")
+	t.Setenv("EDITOR", "nano")
+	t.Setenv("DATAROBOT_CLI_TOKEN", "secret-value")
+
+	byName := make(map[string]EnvVar)
+
+	for _, v := range ConsultedEnvVars() {
+		byName[v.Name] = v
+	}
+
+	assert.Equal(t, EnvVar{Key: "skip-auth", Name: "DATAROBOT_CLI_SKIP_AUTH", Set: true, Value: "true"}, byName["DATAROBOT_CLI_SKIP_AUTH"])
+	assert.Equal(t, EnvVar{Key: "external-editor", Name: "EDITOR", Set: true, Value: "nano"}, byName["EDITOR"])
+	assert.Equal(t, "external-editor", byName["VISUAL"].Key)
+	assert.Equal(t, redactedValue, byName["DATAROBOT_CLI_TOKEN"].Value)
+}
@@ -0,0 +1,104 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// StdinConfig is the --config value that reads the config from stdin
+	StdinConfig = "-"
+	// ConfigTypeKey is the viper key for the format of a config read from
+	// stdin, settable via --config-type
+	ConfigTypeKey = "config-type"
+)
+
+// ConfigTypes are the formats a config read from stdin may be in
+var ConfigTypes = []string{"yaml", "json"}
+
+// ErrConfigFromStdin is returned by ConfigFilePath when the config was read
+// from stdin, so there is no file to change
+var ErrConfigFromStdin = errors.New("The config was read from stdin (--config -), so there is no config file to change.")
+
+// stdin and stdinIsTerminal are stubbed in tests
+var (
+	stdin           io.Reader = os.Stdin
+	stdinIsTerminal           = func() bool { return term.IsTerminal(int(os.Stdin.Fd())) }
+)
+
+// stdinConfig holds the config read from stdin, or nil if it came from a
+// file
+var stdinConfig []byte
+
+// FromStdin reports whether the config was read from stdin
+func FromStdin() bool {
+	return stdinConfig != nil
+}
+
+// readConfigStdin reads the config from stdin in the format set by
+// --config-type. Like a config file, it is overridden by the environment
+// and flags, and ${VAR} references in it are expanded.
+func readConfigStdin() error {
+	if stdinIsTerminal() {
+		return errors.New("--config - reads the config from stdin, but stdin is a terminal. Pipe the config in, e.g. cat drconfig.yaml | dr --config - ...")
+	}
+
+	configType := strings.ToLower(viper.GetString(ConfigTypeKey))
+	if configType == "" {
+		configType = "yaml"
+	}
+
+	if !slices.Contains(ConfigTypes, configType) {
+		return fmt.Errorf("Unsupported --config-type %q; use %s.", configType, strings.Join(ConfigTypes, " or "))
+	}
+
+	data, err := io.ReadAll(stdin)
+	if err != nil {
+		return fmt.Errorf("Failed to read config from stdin: %w", err)
+	}
+
+	viper.SetConfigType(configType)
+
+	if err := viper.ReadConfig(bytes.NewReader(data)); err != nil {
+		return fmt.Errorf("Failed to parse config from stdin: %w", err)
+	}
+
+	stdinConfig = data
+
+	return expandConfig("from stdin", data)
+}
+
+// ValidateStdinConfig checks the config read from stdin against Schema
+func ValidateStdinConfig(strict bool) ([]Issue, error) {
+	var root map[string]any
+
+	// JSON is also valid YAML
+	if err := yaml.Unmarshal(stdinConfig, &root); err != nil {
+		return nil, fmt.Errorf("Failed to parse config from stdin: %w", err)
+	}
+
+	return ValidateConfig(root, strict), nil
+}
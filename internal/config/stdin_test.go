@@ -0,0 +1,86 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func pipeConfig(t *testing.T, data string) {
+	t.Helper()
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	ConfigureEnv(viper.GetViper())
+
+	prevStdin, prevIsTerminal := stdin, stdinIsTerminal
+	stdin = strings.NewReader(data)
+	stdinIsTerminal = func() bool { return false }
+
+	t.Cleanup(func() {
+		stdin, stdinIsTerminal = prevStdin, prevIsTerminal
+		stdinConfig = nil
+	})
+}
+
+func TestReadConfigStdin(t *testing.T) {
+	t.Setenv("DR_TEST_TOKEN_FILE", "/run/secrets/token")
+	t.Setenv(EnvPrefix+"_MAX_RETRIES", "7")
+	pipeConfig(t, "endpoint: https://stdin.datarobot.com/api/v2\nmax-retries: 1\ntoken-file: ${DR_TEST_TOKEN_FILE}\n")
+
+	require.NoError(t, ReadConfigFile(StdinConfig))
+
+	assert.True(t, FromStdin())
+	assert.Equal(t, "https://stdin.datarobot.com/api/v2", viper.GetString(DataRobotURL))
+	assert.Equal(t, "/run/secrets/token", viper.GetString(TokenFileKey))
+	// The environment still overrides the piped config
+	assert.Equal(t, 7, viper.GetInt("max-retries"))
+
+	_, err := ConfigFilePath()
+	require.ErrorIs(t, err, ErrConfigFromStdin)
+
+	issues, err := ValidateStdinConfig(false)
+	require.NoError(t, err)
+	assert.Empty(t, issues)
+}
+
+func TestReadConfigStdinJSON(t *testing.T) {
+	pipeConfig(t, `{"endpoint": "https://json.datarobot.com/api/v2"}`)
+	viper.Set(ConfigTypeKey, "json")
+
+	require.NoError(t, ReadConfigFile(StdinConfig))
+	assert.Equal(t, "https://json.datarobot.com/api/v2", viper.GetString(DataRobotURL))
+}
+
+func TestReadConfigStdinRejectsTerminal(t *testing.T) {
+	pipeConfig(t, "")
+	stdinIsTerminal = func() bool { return true }
+
+	require.ErrorContains(t, ReadConfigFile(StdinConfig), "stdin is a terminal")
+	assert.False(t, FromStdin())
+}
+
+func TestReadConfigStdinRejectsUnknownType(t *testing.T) {
+	pipeConfig(t, "endpoint: https://app.datarobot.com\n")
+	viper.Set(ConfigTypeKey, "toml")
+
+	require.ErrorContains(t, ReadConfigFile(StdinConfig), `Unsupported --config-type "toml"`)
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+
+	"github.com/datarobot/cli/internal/log"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// TokenFileKey names a file holding the API token, such as a mounted secret
+const TokenFileKey = "token-file"
+
+// TokenOrigin describes which source an API token was resolved from
+type TokenOrigin string
+
+const (
+	TokenOriginNone      TokenOrigin = "none"
+	TokenOriginFlag      TokenOrigin = "flag"
+	TokenOriginTokenFile TokenOrigin = "token-file"
+	TokenOriginKeyring   TokenOrigin = "keyring"
+	TokenOriginFile      TokenOrigin = "file"
+	TokenOriginEnv       TokenOrigin = "env"
+	TokenOriginConfig    TokenOrigin = "config"
+)
+
+// tokenFlag is the root --token flag. It is registered so a token given on
+// the command line can be told apart from one in the environment or config
+// file, which viper merges into the same key.
+var tokenFlag *pflag.Flag
+
+// RegisterTokenFlag records the flag holding an explicit API token
+func RegisterTokenFlag(flag *pflag.Flag) {
+	tokenFlag = flag
+}
+
+// ResolveToken returns the API token along with where it came from. The
+// precedence is: --token, --token-file, a token saved by 'dr auth login',
+// DATAROBOT_CLI_TOKEN, and finally the config file. The token is kept out
+// of the --log-file transcript.
+func ResolveToken() (string, TokenOrigin, error) {
+	token, origin, err := resolveToken()
+
+	log.AddSecret(token)
+
+	return token, origin, err
+}
+
+func resolveToken() (string, TokenOrigin, error) {
+	if tokenFlag != nil && tokenFlag.Changed {
+		return strings.TrimSpace(tokenFlag.Value.String()), TokenOriginFlag, nil
+	}
+
+	if path := viper.GetString(TokenFileKey); path != "" {
+		token, err := ReadTokenFile(path)
+		if err != nil {
+			return "", TokenOriginNone, err
+		}
+
+		return token, TokenOriginTokenFile, nil
+	}
+
+	stored, location, err := LoadStoredToken()
+	if err == nil {
+		if location == TokenLocationKeyring {
+			return stored, TokenOriginKeyring, nil
+		}
+
+		return stored, TokenOriginFile, nil
+	}
+
+	if !errors.Is(err, ErrNoStoredToken) {
+		log.Debug("Failed to load stored token", "error", err)
+	}
+
+	token := viper.GetString(DataRobotAPIKey)
+	if token == "" {
+		return "", TokenOriginNone, nil
+	}
+
+	if _, ok := os.LookupEnv(EnvVarName(DataRobotAPIKey)); ok {
+		return token, TokenOriginEnv, nil
+	}
+
+	return token, TokenOriginConfig, nil
+}
+
+// ReadTokenFile reads an API token from path, trimming surrounding
+// whitespace. Files readable by other users are refused.
+func ReadTokenFile(path string) (string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return "", fmt.Errorf("Failed to read token file: %w", err)
+	}
+
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0o004 != 0 {
+		return "", fmt.Errorf("Token file %s is readable by other users. Restrict it with 'chmod 600 %s'.", path, path)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("Failed to read token file: %w", err)
+	}
+
+	token := strings.TrimSpace(string(data))
+	if token == "" {
+		return "", fmt.Errorf("Token file %s is empty.", path)
+	}
+
+	return token, nil
+}
@@ -0,0 +1,129 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+func writeTokenFile(t *testing.T, contents string, perm os.FileMode) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte(contents), perm))
+	require.NoError(t, os.Chmod(path, perm))
+
+	return path
+}
+
+func TestResolveTokenPrecedence(t *testing.T) {
+	tests := []struct {
+		name       string
+		flag       string
+		tokenFile  bool
+		stored     bool
+		env        bool
+		configFile bool
+		wantToken  string
+		wantOrigin TokenOrigin
+	}{
+		{"flag beats everything", "flag-token", true, true, true, true, "flag-token", TokenOriginFlag},
+		{"token file beats keyring", "", true, true, true, true, "file-token", TokenOriginTokenFile},
+		{"keyring beats env", "", false, true, true, true, "stored-token", TokenOriginKeyring},
+		{"env beats config file", "", false, false, true, true, "env-token", TokenOriginEnv},
+		{"config file", "", false, false, false, true, "config-token", TokenOriginConfig},
+		{"nothing set", "", false, false, false, false, "", TokenOriginNone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyring.MockInit()
+			testutil.SetTestHomeDir(t, t.TempDir())
+			viper.Reset()
+			t.Cleanup(viper.Reset)
+			t.Cleanup(func() { RegisterTokenFlag(nil) })
+
+			ConfigureEnv(viper.GetViper())
+
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flags.String(DataRobotAPIKey, "", "")
+			RegisterTokenFlag(flags.Lookup(DataRobotAPIKey))
+
+			if tt.flag != "" {
+				require.NoError(t, flags.Set(DataRobotAPIKey, tt.flag))
+			}
+
+			if tt.tokenFile {
+				viper.Set(TokenFileKey, writeTokenFile(t, "  file-token\n", 0o600))
+			}
+
+			if tt.stored {
+				_, err := StoreToken("stored-token")
+				require.NoError(t, err)
+			}
+
+			if tt.env {
+				t.Setenv(EnvVarName(DataRobotAPIKey), "env-token")
+			}
+
+			if tt.configFile {
+				configPath := filepath.Join(t.TempDir(), "drconfig.yaml")
+				require.NoError(t, os.WriteFile(configPath, []byte("token: config-token\n"), 0o600))
+				require.NoError(t, ReadConfigFile(configPath))
+			}
+
+			token, origin, err := ResolveToken()
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantToken, token)
+			assert.Equal(t, tt.wantOrigin, origin)
+		})
+	}
+}
+
+func TestReadTokenFileRefusesWorldReadable(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file modes are not enforced on Windows")
+	}
+
+	path := writeTokenFile(t, "secret", 0o644)
+
+	_, err := ReadTokenFile(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "readable by other users")
+}
+
+func TestReadTokenFileRejectsEmpty(t *testing.T) {
+	path := writeTokenFile(t, " \n", 0o600)
+
+	_, err := ReadTokenFile(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "is empty")
+}
+
+func TestReadTokenFileMissing(t *testing.T) {
+	_, err := ReadTokenFile(filepath.Join(t.TempDir(), "missing"))
+	require.ErrorIs(t, err, os.ErrNotExist)
+}
@@ -0,0 +1,231 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/datarobot/cli/internal/log"
+	"github.com/zalando/go-keyring"
+)
+
+// TokenLocation describes where an API token is stored
+type TokenLocation string
+
+const (
+	TokenLocationKeyring TokenLocation = "keyring"
+	TokenLocationFile    TokenLocation = "file"
+)
+
+const (
+	keyringService   = "datarobot-cli"
+	defaultTokenName = "default"
+	tokensDirName    = "tokens"
+)
+
+// ErrNoStoredToken is returned when neither the keyring nor the fallback
+// file hold a token for the active profile
+var ErrNoStoredToken = errors.New("no stored token")
+
+// storedTokenName returns the name tokens are stored under: the active
+// context, or else the active profile, so each can keep its own credentials
+func storedTokenName() string {
+	if context := ActiveContext(); context != "" {
+		return contextTokenName(context)
+	}
+
+	if profile := ActiveProfile(); profile != "" {
+		return profile
+	}
+
+	return defaultTokenName
+}
+
+// TokensDir returns the directory holding fallback token files
+func TokensDir() (string, error) {
+	dir, err := Dir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, tokensDirName), nil
+}
+
+func tokenFilePath(name string) (string, error) {
+	dir, err := TokensDir()
+	if err != nil {
+		return "", err
+	}
+
+	// Context token names contain a colon, which Windows file names can't
+	return filepath.Join(dir, strings.ReplaceAll(name, ":", "_")), nil
+}
+
+// StoreToken saves the token for the active profile in the OS keyring,
+// falling back to a 0600 file in the config directory when no keyring
+// is available.
+func StoreToken(token string) (TokenLocation, error) {
+	name := storedTokenName()
+
+	err := keyring.Set(keyringService, name, token)
+	if err == nil {
+		return TokenLocationKeyring, nil
+	}
+
+	log.Debug("Keyring unavailable, storing token in file", "error", err)
+
+	path, err := tokenFilePath(name)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return "", fmt.Errorf("Failed to create token directory: %w", err)
+	}
+
+	if err := os.WriteFile(path, []byte(token), 0o600); err != nil {
+		return "", fmt.Errorf("Failed to write token file: %w", err)
+	}
+
+	return TokenLocationFile, nil
+}
+
+// LoadStoredToken returns the token saved for the active profile and
+// where it was found
+func LoadStoredToken() (string, TokenLocation, error) {
+	name := storedTokenName()
+
+	token, err := keyring.Get(keyringService, name)
+	if err == nil && token != "" {
+		return resolveStoredSecret(token, TokenLocationKeyring)
+	}
+
+	path, err := tokenFilePath(name)
+	if err != nil {
+		return "", "", err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return "", "", ErrNoStoredToken
+		}
+
+		return "", "", err
+	}
+
+	token = strings.TrimSpace(string(data))
+	if token == "" {
+		return "", "", ErrNoStoredToken
+	}
+
+	return resolveStoredSecret(token, TokenLocationFile)
+}
+
+// resolveStoredSecret turns a stored secret into an API token. Secrets
+// saved by the OAuth device flow are JSON and refreshed when expired.
+func resolveStoredSecret(secret string, location TokenLocation) (string, TokenLocation, error) {
+	if !strings.HasPrefix(secret, "{") {
+		return secret, location, nil
+	}
+
+	token, err := resolveOAuthSecret(secret)
+	if err != nil {
+		return "", "", err
+	}
+
+	return token, location, nil
+}
+
+// DeleteStoredToken removes the token saved for the active profile from
+// both the keyring and the fallback file, returning where it was found
+func DeleteStoredToken() ([]TokenLocation, error) {
+	return deleteStoredTokens([]string{storedTokenName()})
+}
+
+// DeleteAllStoredTokens removes the tokens saved for every profile, as
+// well as any leftover fallback token files
+func DeleteAllStoredTokens() ([]TokenLocation, error) {
+	names := append([]string{defaultTokenName}, ProfileNames()...)
+	for _, name := range ContextNames() {
+		names = append(names, contextTokenName(name))
+	}
+
+	dir, err := TokensDir()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("Failed to read token directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			names = append(names, entry.Name())
+		}
+	}
+
+	return deleteStoredTokens(names)
+}
+
+func deleteStoredTokens(names []string) ([]TokenLocation, error) {
+	var fromKeyring, fromFile bool
+
+	seen := make(map[string]bool, len(names))
+
+	for _, name := range names {
+		if seen[name] {
+			continue
+		}
+
+		seen[name] = true
+
+		if err := keyring.Delete(keyringService, name); err == nil {
+			fromKeyring = true
+		} else if !errors.Is(err, keyring.ErrNotFound) {
+			log.Debug("Failed to delete token from keyring", "name", name, "error", err)
+		}
+
+		path, err := tokenFilePath(name)
+		if err != nil {
+			return nil, err
+		}
+
+		err = os.Remove(path)
+		if err == nil {
+			fromFile = true
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("Failed to remove token file: %w", err)
+		}
+	}
+
+	var locations []TokenLocation
+
+	if fromKeyring {
+		locations = append(locations, TokenLocationKeyring)
+	}
+
+	if fromFile {
+		locations = append(locations, TokenLocationFile)
+	}
+
+	return locations, nil
+}
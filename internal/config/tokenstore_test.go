@@ -0,0 +1,79 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+func TestStoreTokenUsesKeyring(t *testing.T) {
+	keyring.MockInit()
+	testutil.SetTestHomeDir(t, t.TempDir())
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	location, err := StoreToken("keyring-token")
+	require.NoError(t, err)
+	assert.Equal(t, TokenLocationKeyring, location)
+
+	token, location, err := LoadStoredToken()
+	require.NoError(t, err)
+	assert.Equal(t, "keyring-token", token)
+	assert.Equal(t, TokenLocationKeyring, location)
+}
+
+func TestStoreTokenFallsBackToFile(t *testing.T) {
+	keyring.MockInitWithError(errors.New("no keyring"))
+	t.Cleanup(keyring.MockInit)
+	testutil.SetTestHomeDir(t, t.TempDir())
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.Set(ProfileKey, "staging")
+
+	location, err := StoreToken("file-token")
+	require.NoError(t, err)
+	assert.Equal(t, TokenLocationFile, location)
+
+	dir, err := TokensDir()
+	require.NoError(t, err)
+
+	info, err := os.Stat(filepath.Join(dir, "staging"))
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	token, location, err := LoadStoredToken()
+	require.NoError(t, err)
+	assert.Equal(t, "file-token", token)
+	assert.Equal(t, TokenLocationFile, location)
+}
+
+func TestLoadStoredTokenMissing(t *testing.T) {
+	keyring.MockInit()
+	testutil.SetTestHomeDir(t, t.TempDir())
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	_, _, err := LoadStoredToken()
+	require.ErrorIs(t, err, ErrNoStoredToken)
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/spf13/viper"
+)
+
+// WebURLKey sets the address of the DataRobot web application, for
+// self-managed installations that serve it from a different host than the
+// API
+const WebURLKey = "web-url"
+
+// WebBaseURL returns the address of the DataRobot web application for the
+// API endpoint, or for web-url when it is set. A cloud endpoint maps to its
+// region's address. A self-managed one maps to the endpoint without the API
+// path, keeping any prefix the installation is served under.
+func WebBaseURL(endpoint string) (string, error) {
+	if webURL := viper.GetString(WebURLKey); webURL != "" {
+		endpoint = webURL
+	}
+
+	base, err := apiclient.NormalizeEndpoint(endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	if region, ok := regionForURL(base); ok {
+		return region.URL, nil
+	}
+
+	return base, nil
+}
+
+// regionForURL returns the cloud region served from the host of rawURL
+func regionForURL(rawURL string) (Region, bool) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return Region{}, false
+	}
+
+	for _, region := range Regions {
+		regionURL, err := url.Parse(region.URL)
+		if err == nil && strings.EqualFold(regionURL.Hostname(), parsed.Hostname()) {
+			return region, true
+		}
+	}
+
+	return Region{}, false
+}
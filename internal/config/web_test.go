@@ -0,0 +1,67 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package config
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebBaseURL(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     string
+	}{
+		{"https://app.datarobot.com/api/v2", "https://app.datarobot.com"},
+		{"http://APP.EU.datarobot.com:443/api/v2/", "https://app.eu.datarobot.com"},
+		{"app.jp.datarobot.com", "https://app.jp.datarobot.com"},
+		{"https://datarobot.example.com/api/v2", "https://datarobot.example.com"},
+		{"https://example.com/datarobot/api/v2", "https://example.com/datarobot"},
+		{"http://localhost:8080", "http://localhost:8080"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.endpoint, func(t *testing.T) {
+			viper.Reset()
+			t.Cleanup(viper.Reset)
+
+			got, err := WebBaseURL(tt.endpoint)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWebBaseURLOverride(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	viper.Set(WebURLKey, "https://ui.datarobot.example.com/")
+
+	got, err := WebBaseURL("https://api.datarobot.example.com/api/v2")
+	require.NoError(t, err)
+	assert.Equal(t, "https://ui.datarobot.example.com", got)
+}
+
+func TestWebBaseURLInvalid(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	_, err := WebBaseURL("")
+	assert.Error(t, err)
+}
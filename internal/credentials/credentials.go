@@ -0,0 +1,107 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package credentials decides which endpoint and API token the CLI uses.
+// Tokens can come from several places; Precedence lists them in the order
+// they are tried, and Resolve applies that order.
+package credentials
+
+import (
+	"os"
+	"slices"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/spf13/viper"
+)
+
+// Source describes where the active API token was resolved from
+type Source string
+
+const (
+	SourceNone      = Source(config.TokenOriginNone)
+	SourceFlag      = Source(config.TokenOriginFlag)
+	SourceTokenFile = Source(config.TokenOriginTokenFile)
+	SourceKeyring   = Source(config.TokenOriginKeyring)
+	SourceFile      = Source(config.TokenOriginFile)
+	SourceEnv       = Source(config.TokenOriginEnv)
+	SourceConfig    = Source(config.TokenOriginConfig)
+)
+
+// Precedence lists the token sources from strongest to weakest: --token,
+// --token-file, a token saved by 'dr auth login' (in the keyring, or the
+// fallback file when there is no keyring), the environment, and the config
+// file. Finding no token at all ranks below every source.
+var Precedence = []Source{SourceFlag, SourceTokenFile, SourceKeyring, SourceFile, SourceEnv, SourceConfig}
+
+// Outranks reports whether a token from a is used in preference to one
+// from b
+func Outranks(a, b Source) bool {
+	rank := func(source Source) int {
+		if i := slices.Index(Precedence, source); i >= 0 {
+			return i
+		}
+
+		return len(Precedence)
+	}
+
+	return rank(a) < rank(b)
+}
+
+// Credentials is the endpoint and token pair the CLI will use, along with
+// where the token came from
+type Credentials struct {
+	Endpoint string
+	Token    string
+	Source   Source
+}
+
+// SDKEnvironment returns the endpoint and token set for the DataRobot
+// Python SDK: DATAROBOT_ENDPOINT, or DATAROBOT_API_ENDPOINT when that is
+// unset, and DATAROBOT_API_TOKEN
+func SDKEnvironment() (string, string) {
+	endpoint := os.Getenv("DATAROBOT_ENDPOINT")
+	if endpoint == "" {
+		endpoint = os.Getenv("DATAROBOT_API_ENDPOINT")
+	}
+
+	return endpoint, os.Getenv("DATAROBOT_API_TOKEN")
+}
+
+// Resolve determines the credentials without contacting the server. The
+// token follows config.ResolveToken. A complete SDK environment pair (see
+// SDKEnvironment) counts as the environment source, so it replaces a token
+// from the config file, endpoint included, but not one from a stronger
+// source. An error is returned if --token-file cannot be used.
+func Resolve() (Credentials, error) {
+	creds := Credentials{
+		Endpoint: viper.GetString(config.DataRobotURL),
+		Source:   SourceNone,
+	}
+
+	token, origin, err := config.ResolveToken()
+	if err != nil {
+		return creds, err
+	}
+
+	if Outranks(SourceEnv, Source(origin)) {
+		if endpoint, envToken := SDKEnvironment(); endpoint != "" && envToken != "" {
+			return Credentials{Endpoint: endpoint, Token: envToken, Source: SourceEnv}, nil
+		}
+	}
+
+	creds.Token = token
+	creds.Source = Source(origin)
+
+	return creds, nil
+}
@@ -0,0 +1,165 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credentials
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+const configEndpoint = "https://config.datarobot.com"
+
+// sources says where a test case puts a token
+type sources struct {
+	flag         bool
+	tokenFile    bool // via --token-file
+	tokenFileEnv bool // via DATAROBOT_CLI_TOKEN_FILE
+	keyring      bool
+	env          bool // DATAROBOT_CLI_TOKEN
+	sdkEnv       bool // DATAROBOT_ENDPOINT and DATAROBOT_API_TOKEN
+	configFile   bool
+}
+
+// setup wires viper to the environment the way the root command does and
+// places a distinct token in each requested source
+func setup(t *testing.T, s sources) {
+	t.Helper()
+
+	keyring.MockInit()
+	testutil.SetTestHomeDir(t, t.TempDir())
+
+	for _, name := range []string{"DATAROBOT_ENDPOINT", "DATAROBOT_API_ENDPOINT", "DATAROBOT_API_TOKEN"} {
+		t.Setenv(name, "")
+	}
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	config.ConfigureEnv(viper.GetViper())
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	flags.String(config.DataRobotAPIKey, "", "")
+	config.RegisterTokenFlag(flags.Lookup(config.DataRobotAPIKey))
+	t.Cleanup(func() { config.RegisterTokenFlag(nil) })
+
+	if s.flag {
+		require.NoError(t, flags.Set(config.DataRobotAPIKey, "flag-token"))
+	}
+
+	if s.tokenFile {
+		viper.Set(config.TokenFileKey, writeFile(t, "token", "file-token\n"))
+	}
+
+	if s.tokenFileEnv {
+		t.Setenv(config.EnvVarName(config.TokenFileKey), writeFile(t, "env-token-file", "env-file-token\n"))
+	}
+
+	if s.keyring {
+		_, err := config.StoreToken("keyring-token")
+		require.NoError(t, err)
+	}
+
+	if s.env {
+		t.Setenv(config.EnvVarName(config.DataRobotAPIKey), "env-token")
+	}
+
+	if s.sdkEnv {
+		t.Setenv("DATAROBOT_ENDPOINT", "https://sdk.datarobot.com")
+		t.Setenv("DATAROBOT_API_TOKEN", "sdk-token")
+	}
+
+	configFile := "endpoint: " + configEndpoint + "\n"
+	if s.configFile {
+		configFile += "token: config-token\n"
+	}
+
+	require.NoError(t, config.ReadConfigFile(writeFile(t, "drconfig.yaml", configFile)))
+}
+
+func writeFile(t *testing.T, name, contents string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0o600))
+
+	return path
+}
+
+func TestResolvePrecedence(t *testing.T) {
+	all := sources{flag: true, tokenFile: true, keyring: true, env: true, sdkEnv: true, configFile: true}
+
+	tests := []struct {
+		name         string
+		sources      sources
+		wantToken    string
+		wantSource   Source
+		wantEndpoint string
+	}{
+		{"flag beats everything", all, "flag-token", SourceFlag, configEndpoint},
+		{"token file beats keyring", sources{tokenFile: true, keyring: true, env: true, configFile: true}, "file-token", SourceTokenFile, configEndpoint},
+		{"dashed token-file key resolves from underscored env", sources{tokenFileEnv: true, keyring: true}, "env-file-token", SourceTokenFile, configEndpoint},
+		{"keyring beats env", sources{keyring: true, env: true, sdkEnv: true, configFile: true}, "keyring-token", SourceKeyring, configEndpoint},
+		{"env beats config file", sources{env: true, configFile: true}, "env-token", SourceEnv, configEndpoint},
+		{"cli env beats sdk env", sources{env: true, sdkEnv: true}, "env-token", SourceEnv, configEndpoint},
+		{"sdk env pair beats config file", sources{sdkEnv: true, configFile: true}, "sdk-token", SourceEnv, "https://sdk.datarobot.com"},
+		{"config file", sources{configFile: true}, "config-token", SourceConfig, configEndpoint},
+		{"nothing set", sources{}, "", SourceNone, configEndpoint},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setup(t, tt.sources)
+
+			creds, err := Resolve()
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.wantToken, creds.Token)
+			assert.Equal(t, tt.wantSource, creds.Source)
+			assert.Equal(t, tt.wantEndpoint, creds.Endpoint)
+		})
+	}
+}
+
+func TestResolveEndpointFromUnderscoredEnv(t *testing.T) {
+	setup(t, sources{configFile: true})
+	t.Setenv(config.EnvVarName(config.DataRobotURL), "https://env.datarobot.com")
+
+	creds, err := Resolve()
+	require.NoError(t, err)
+
+	assert.Equal(t, "DATAROBOT_CLI_ENDPOINT", config.EnvVarName(config.DataRobotURL))
+	assert.Equal(t, "https://env.datarobot.com", creds.Endpoint)
+}
+
+func TestOutranksFollowsPrecedence(t *testing.T) {
+	for i, stronger := range Precedence {
+		for _, weaker := range Precedence[i+1:] {
+			assert.True(t, Outranks(stronger, weaker), "%s over %s", stronger, weaker)
+			assert.False(t, Outranks(weaker, stronger), "%s over %s", weaker, stronger)
+		}
+
+		assert.True(t, Outranks(stronger, SourceNone))
+	}
+
+	assert.False(t, Outranks(SourceEnv, SourceEnv))
+}
@@ -0,0 +1,341 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package doctor diagnoses common setup problems: a missing or invalid
+// config file, unusable credentials, an unreachable endpoint, bad proxy or
+// TLS settings, and missing tools.
+package doctor
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/tools"
+	"github.com/spf13/viper"
+)
+
+// Status is the outcome of a single check
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+)
+
+// reachTimeout bounds the request made to check the endpoint is reachable
+const reachTimeout = 10 * time.Second
+
+// Result is the outcome of a check, with how to fix it. Every field is
+// always present in JSON, so CI can gate on a check by name.
+type Result struct {
+	Name        string `json:"name"        yaml:"name"`
+	Status      Status `json:"status"      yaml:"status"`
+	Detail      string `json:"detail"      yaml:"detail"`
+	Remediation string `json:"remediation" yaml:"remediation"`
+}
+
+// FailedCount returns the number of hard failures in results
+func FailedCount(results []Result) int {
+	failed := 0
+
+	for _, result := range results {
+		if result.Status == StatusFail {
+			failed++
+		}
+	}
+
+	return failed
+}
+
+// Run performs every check in order. Later checks build on earlier ones,
+// e.g. the token is only verified when the endpoint is reachable.
+func Run(ctx context.Context) []Result {
+	results := []Result{
+		checkConfigFile(),
+		checkConfigDir(),
+		checkNetworkConfig(),
+	}
+
+	endpoint, result := checkEndpoint()
+	results = append(results, result)
+
+	reachable := false
+
+	if endpoint != "" {
+		result = checkReachable(ctx, endpoint)
+		reachable = result.Status == StatusPass
+
+		results = append(results, result)
+	}
+
+	results = append(results, checkToken(endpoint, reachable))
+
+	return append(results, checkTools()...)
+}
+
+func pass(name, message string) Result {
+	return Result{Name: name, Status: StatusPass, Detail: message}
+}
+
+func warn(name, message, hint string) Result {
+	return Result{Name: name, Status: StatusWarn, Detail: message, Remediation: hint}
+}
+
+func fail(name, message, hint string) Result {
+	return Result{Name: name, Status: StatusFail, Detail: message, Remediation: hint}
+}
+
+func checkConfigFile() Result {
+	const name = "Config file"
+
+	var (
+		path   string
+		issues []config.Issue
+		err    error
+	)
+
+	if config.FromStdin() {
+		path = "The config from stdin"
+
+		issues, err = config.ValidateStdinConfig(false)
+		if err != nil {
+			return fail(name, err.Error(), "Fix the syntax of the config piped to stdin.")
+		}
+	} else {
+		path, err = config.ConfigFilePath()
+		if err != nil {
+			return fail(name, err.Error(), "Set --config to the path of your config file.")
+		}
+
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			return warn(name, "No config file at "+path+".", "Run 'dr auth set-url' to create one.")
+		}
+
+		issues, err = config.ValidateConfigFile(path, false)
+		if err != nil {
+			return fail(name, err.Error(), "Fix the YAML syntax in "+path+".")
+		}
+	}
+
+	errorCount := 0
+
+	for _, issue := range issues {
+		if issue.Severity == config.SeverityError {
+			errorCount++
+		}
+	}
+
+	switch {
+	case errorCount > 0:
+		return fail(name, fmt.Sprintf("%s has %d invalid value(s).", path, errorCount),
+			"Run 'dr self config validate' for details.")
+	case len(issues) > 0:
+		return warn(name, fmt.Sprintf("%s has %d unknown key(s).", path, len(issues)),
+			"Run 'dr self config validate' for details.")
+	}
+
+	return pass(name, path+" is valid.")
+}
+
+// configDir is the directory holding the config file, or the default
+// config directory when the config was read from stdin
+func configDir() (string, error) {
+	if config.FromStdin() {
+		return config.Dir()
+	}
+
+	path, err := config.ConfigFilePath()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Dir(path), nil
+}
+
+func checkConfigDir() Result {
+	const name = "Config directory"
+
+	dir, err := configDir()
+	if err != nil {
+		return fail(name, err.Error(), "")
+	}
+
+	// The directory is created on first write, so check the closest
+	// existing parent when it is missing
+	for {
+		if _, err := os.Stat(dir); err == nil || filepath.Dir(dir) == dir {
+			break
+		}
+
+		dir = filepath.Dir(dir)
+	}
+
+	probe, err := os.CreateTemp(dir, ".dr-doctor-*")
+	if err != nil {
+		return fail(name, dir+" is not writable.", "Fix the permissions of "+dir+" or set --config to a writable location.")
+	}
+
+	probe.Close()
+	os.Remove(probe.Name())
+
+	return pass(name, dir+" is writable.")
+}
+
+func checkNetworkConfig() Result {
+	const name = "Proxy and TLS"
+
+	if err := apiclient.CheckConfig(); err != nil {
+		return fail(name, err.Error(), "Fix --proxy and --ca-cert, or the matching DATAROBOT_CLI_ variables.")
+	}
+
+	if viper.GetBool(apiclient.InsecureSkipTLSVerifyKey) {
+		return warn(name, "TLS certificate verification is disabled.",
+			"Unset --insecure-skip-tls-verify and use --ca-cert to trust an internal CA instead.")
+	}
+
+	return pass(name, "Proxy and TLS settings are valid.")
+}
+
+func checkEndpoint() (string, Result) {
+	const name = "Endpoint"
+
+	raw := viper.GetString(config.DataRobotURL)
+	if raw == "" {
+		return "", fail(name, "No DataRobot URL is configured.", "Run 'dr auth set-url'.")
+	}
+
+	endpoint, err := apiclient.NormalizeEndpoint(raw)
+	if err != nil {
+		return "", fail(name, err.Error(), "Run 'dr auth set-url' to set a valid URL.")
+	}
+
+	return endpoint, pass(name, endpoint)
+}
+
+func checkReachable(ctx context.Context, endpoint string) Result {
+	const name = "Connectivity"
+
+	if apiclient.Offline() {
+		return warn(name, "Skipped in offline mode.", "Run without --offline to check connectivity.")
+	}
+
+	target, err := apiclient.APIURL(endpoint, "/version/")
+	if err != nil {
+		return fail(name, err.Error(), "")
+	}
+
+	via := "directly"
+	if proxy, err := apiclient.ProxyFor(target); err == nil && proxy != "" {
+		via = "via " + proxy
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, target, nil)
+	if err != nil {
+		return fail(name, err.Error(), "")
+	}
+
+	req.Header.Set("User-Agent", config.GetUserAgentHeader())
+
+	resp, err := apiclient.New(reachTimeout).Do(req)
+	if err != nil {
+		return fail(name, fmt.Sprintf("Cannot reach %s %s: %v", endpoint, via, err), reachHint(err))
+	}
+
+	resp.Body.Close()
+
+	// Any response, even 401, shows the server can be reached
+	return pass(name, fmt.Sprintf("Reached %s %s (%s).", endpoint, via, resp.Status))
+}
+
+func reachHint(err error) string {
+	var (
+		unknownAuthority x509.UnknownAuthorityError
+		verifyErr        *tls.CertificateVerificationError
+		dnsErr           *net.DNSError
+		netErr           net.Error
+	)
+
+	switch {
+	case errors.As(err, &unknownAuthority), errors.As(err, &verifyErr):
+		return "The server certificate is not trusted. Set --ca-cert to your organization's CA bundle."
+	case errors.As(err, &dnsErr):
+		return "The host name could not be resolved. Check the URL with 'dr auth set-url'."
+	case errors.As(err, &netErr) && netErr.Timeout():
+		return "The request timed out. Check your network connection and proxy settings."
+	}
+
+	return "Check your network connection, the endpoint URL, and proxy settings."
+}
+
+func checkToken(endpoint string, reachable bool) Result {
+	const name = "API token"
+
+	token, origin, err := config.ResolveToken()
+	if err != nil {
+		return fail(name, err.Error(), "Fix --token-file or unset it.")
+	}
+
+	if token == "" {
+		return fail(name, "No API token found.", "Run 'dr auth login'.")
+	}
+
+	if !reachable {
+		return warn(name, fmt.Sprintf("Token loaded from %s, but not verified because the endpoint is unreachable.", origin), "")
+	}
+
+	if err := config.VerifyToken(endpoint, token); err != nil {
+		return fail(name, fmt.Sprintf("Token from %s was rejected: %v.", origin, err), "Run 'dr auth login' to get a new token.")
+	}
+
+	return pass(name, fmt.Sprintf("Token from %s is valid.", origin))
+}
+
+// checkTools reports the tools quickstart scripts and tasks rely on. A
+// missing tool is a warning, since only some commands need it.
+func checkTools() []Result {
+	requirements := tools.RequiredTools
+	if fromRepo, err := tools.GetRequirements(); err == nil && len(fromRepo) > 0 {
+		requirements = fromRepo
+	}
+
+	results := make([]Result, 0, len(requirements))
+
+	for _, tool := range requirements {
+		if err := tool.Check(); err != nil {
+			hint := ""
+			if tool.URL != "" {
+				hint = "Install it from " + tool.URL
+			}
+
+			results = append(results, warn(tool.Name, err.Error(), hint))
+
+			continue
+		}
+
+		results = append(results, pass(tool.Name, tool.Name+" is installed."))
+	}
+
+	return results
+}
@@ -0,0 +1,174 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package doctor
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+func setupDoctor(t *testing.T, configYAML string) {
+	t.Helper()
+
+	keyring.MockInit()
+	testutil.SetTestHomeDir(t, t.TempDir())
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	config.ConfigureEnv(viper.GetViper())
+
+	if configYAML != "" {
+		path := filepath.Join(t.TempDir(), "drconfig.yaml")
+		require.NoError(t, os.WriteFile(path, []byte(configYAML), 0o600))
+		require.NoError(t, config.ReadConfigFile(path))
+	}
+}
+
+func newServer(t *testing.T, token string) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/version/" || r.Header.Get("Authorization") != "Bearer "+token {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func resultByName(t *testing.T, results []Result, name string) Result {
+	t.Helper()
+
+	for _, result := range results {
+		if result.Name == name {
+			return result
+		}
+	}
+
+	t.Fatalf("no %q check in results", name)
+
+	return Result{}
+}
+
+func TestRunHealthySetup(t *testing.T) {
+	server := newServer(t, "good-token")
+	setupDoctor(t, "endpoint: "+server.URL+"/api/v2\ntoken: good-token\n")
+
+	results := Run(context.Background())
+
+	for _, name := range []string{"Config file", "Config directory", "Proxy and TLS", "Endpoint", "Connectivity", "API token"} {
+		assert.Equal(t, StatusPass, resultByName(t, results, name).Status, name)
+	}
+
+	assert.Contains(t, resultByName(t, results, "API token").Detail, "config")
+}
+
+func TestRunRejectedToken(t *testing.T) {
+	server := newServer(t, "good-token")
+	setupDoctor(t, "endpoint: "+server.URL+"\ntoken: stale-token\n")
+
+	results := Run(context.Background())
+
+	assert.Equal(t, StatusPass, resultByName(t, results, "Connectivity").Status,
+		"a 401 still shows the endpoint is reachable")
+
+	token := resultByName(t, results, "API token")
+	assert.Equal(t, StatusFail, token.Status)
+	assert.Contains(t, token.Remediation, "dr auth login")
+	assert.Positive(t, FailedCount(results))
+}
+
+func TestRunMissingEndpointAndToken(t *testing.T) {
+	setupDoctor(t, "")
+
+	results := Run(context.Background())
+
+	assert.Equal(t, StatusWarn, resultByName(t, results, "Config file").Status)
+	assert.Equal(t, StatusFail, resultByName(t, results, "Endpoint").Status)
+	assert.Equal(t, StatusFail, resultByName(t, results, "API token").Status)
+
+	for _, result := range results {
+		assert.NotEqual(t, "Connectivity", result.Name, "connectivity is skipped without an endpoint")
+	}
+}
+
+func TestRunUnreachableEndpoint(t *testing.T) {
+	server := httptest.NewServer(http.NotFoundHandler())
+	url := server.URL
+	server.Close()
+
+	setupDoctor(t, "endpoint: "+url+"\ntoken: some-token\nmax-retries: 0\n")
+
+	results := Run(context.Background())
+
+	assert.Equal(t, StatusFail, resultByName(t, results, "Connectivity").Status)
+	assert.Equal(t, StatusWarn, resultByName(t, results, "API token").Status,
+		"the token cannot be verified without a connection")
+}
+
+func TestRunInvalidConfigValues(t *testing.T) {
+	setupDoctor(t, "max-retries: lots\n")
+
+	assert.Equal(t, StatusFail, resultByName(t, Run(context.Background()), "Config file").Status)
+}
+
+func TestRunInvalidNetworkConfig(t *testing.T) {
+	setupDoctor(t, "")
+	viper.Set(apiclient.CACertKey, filepath.Join(t.TempDir(), "missing.pem"))
+
+	result := resultByName(t, Run(context.Background()), "Proxy and TLS")
+
+	assert.Equal(t, StatusFail, result.Status)
+	assert.Contains(t, result.Detail, "CA certificate")
+}
+
+func TestResultJSON(t *testing.T) {
+	data, err := json.Marshal([]Result{pass("Endpoint", "https://app.datarobot.com"), warn("pulumi", "pulumi is not installed.", "Install it.")})
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `[
+		{"name": "Endpoint", "status": "pass", "detail": "https://app.datarobot.com", "remediation": ""},
+		{"name": "pulumi", "status": "warn", "detail": "pulumi is not installed.", "remediation": "Install it."}
+	]`, string(data))
+}
+
+func TestFailedCountIgnoresWarnings(t *testing.T) {
+	results := []Result{
+		pass("Endpoint", "ok"),
+		warn("pulumi", "missing", "install"),
+		fail("API token", "rejected", "log in"),
+	}
+
+	assert.Equal(t, 1, FailedCount(results))
+	assert.Zero(t, FailedCount(results[:2]))
+}
@@ -0,0 +1,200 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drapi
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/log"
+	"github.com/spf13/viper"
+)
+
+const (
+	// CatalogTTLKey sets how long the cached template catalog is used before
+	// it is fetched again; 0 disables the cache
+	CatalogTTLKey = "templates-cache-ttl"
+	// DefaultCatalogTTL is used when CatalogTTLKey is not set
+	DefaultCatalogTTL = 24 * time.Hour
+
+	catalogCacheFile = "templates.json"
+)
+
+// now is stubbed in tests to control cache expiry
+var now = time.Now
+
+// Catalog is the list of templates available on the configured endpoint
+type Catalog struct {
+	Templates []Template
+	FetchedAt time.Time
+	// Stale is set when the catalog could not be fetched and an expired
+	// cached copy was used instead
+	Stale bool
+}
+
+type catalogCache struct {
+	Endpoint  string     `json:"endpoint"`
+	FetchedAt time.Time  `json:"fetched_at"`
+	Templates []Template `json:"templates"`
+}
+
+// CatalogTTL returns how long a cached catalog stays fresh
+func CatalogTTL() time.Duration {
+	if !viper.IsSet(CatalogTTLKey) {
+		return DefaultCatalogTTL
+	}
+
+	return max(viper.GetDuration(CatalogTTLKey), 0)
+}
+
+// GetCatalog returns the template catalog, from the local cache while it is
+// fresh, or from the local templates directory when one is configured.
+// With refresh the catalog is always fetched. When fetching fails, an
+// expired cached copy is used so the catalog stays available offline. In
+// offline mode the cached copy is always used, and refresh is ignored.
+func GetCatalog(refresh bool) (*Catalog, error) {
+	// A local templates directory is cheap to read, so it is never cached
+	if UsesLocalTemplates() {
+		list, err := GetTemplates()
+		if err != nil {
+			return nil, err
+		}
+
+		return &Catalog{Templates: list.Templates, FetchedAt: now().UTC()}, nil
+	}
+
+	cache, cached := loadCatalogCache()
+
+	if apiclient.Offline() {
+		if !cached {
+			return nil, errNoCachedCatalog()
+		}
+
+		stale := now().Sub(cache.FetchedAt) >= CatalogTTL()
+
+		return &Catalog{Templates: cache.Templates, FetchedAt: cache.FetchedAt, Stale: stale}, nil
+	}
+
+	if cached && !refresh && now().Sub(cache.FetchedAt) < CatalogTTL() {
+		return &Catalog{Templates: cache.Templates, FetchedAt: cache.FetchedAt}, nil
+	}
+
+	list, err := GetTemplates()
+	if err != nil {
+		if cached && !refresh {
+			log.Warn("Failed to fetch the template catalog, using the cached copy",
+				"fetched_at", cache.FetchedAt.Format(time.RFC3339), "error", err)
+
+			return &Catalog{Templates: cache.Templates, FetchedAt: cache.FetchedAt, Stale: true}, nil
+		}
+
+		return nil, err
+	}
+
+	fetchedAt := now().UTC()
+
+	saveCatalogCache(catalogCache{Endpoint: config.GetBaseURL(), FetchedAt: fetchedAt, Templates: list.Templates})
+
+	return &Catalog{Templates: list.Templates, FetchedAt: fetchedAt}, nil
+}
+
+// HasCachedCatalog reports whether a catalog for the configured endpoint is
+// cached, however old, so it can be shown without contacting the server
+func HasCachedCatalog() bool {
+	_, ok := loadCatalogCache()
+
+	return ok
+}
+
+// cachedTemplates returns the cached catalog as a template list, for
+// offline mode
+func cachedTemplates() (*TemplateList, error) {
+	cache, ok := loadCatalogCache()
+	if !ok {
+		return nil, errNoCachedCatalog()
+	}
+
+	return &TemplateList{Templates: cache.Templates, Count: len(cache.Templates), TotalCount: len(cache.Templates)}, nil
+}
+
+func errNoCachedCatalog() error {
+	return fmt.Errorf("No template catalog is cached for %s and %w is on. "+
+		"Run once without --offline to cache it, or use --templates-dir.", config.GetBaseURL(), apiclient.ErrOffline)
+}
+
+func catalogCachePath() (string, error) {
+	dir, err := config.CacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, catalogCacheFile), nil
+}
+
+// loadCatalogCache returns the cached catalog if it belongs to the
+// configured endpoint
+func loadCatalogCache() (catalogCache, bool) {
+	var cache catalogCache
+
+	path, err := catalogCachePath()
+	if err != nil {
+		return cache, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Debug("Failed to read template catalog cache", "error", err)
+		}
+
+		return cache, false
+	}
+
+	if err := json.Unmarshal(data, &cache); err != nil || cache.Endpoint != config.GetBaseURL() {
+		return cache, false
+	}
+
+	return cache, true
+}
+
+// saveCatalogCache persists the catalog. Failures only cost a request next
+// time, so they are logged rather than returned.
+func saveCatalogCache(cache catalogCache) {
+	path, err := catalogCachePath()
+	if err != nil {
+		return
+	}
+
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		log.Debug("Failed to create cache directory", "error", err)
+
+		return
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		log.Debug("Failed to write template catalog cache", "error", err)
+	}
+}
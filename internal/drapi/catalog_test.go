@@ -0,0 +1,204 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// catalogServer serves a single-template catalog and counts the requests
+// it receives; it fails them while down is set
+type catalogServer struct {
+	*httptest.Server
+	requests atomic.Int32
+	down     atomic.Bool
+}
+
+func setupCatalog(t *testing.T) *catalogServer {
+	t.Helper()
+
+	testutil.SetTestHomeDir(t, t.TempDir())
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	config.ConfigureEnv(viper.GetViper())
+
+	server := &catalogServer{}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		server.requests.Add(1)
+
+		if server.down.Load() || r.URL.Path != "/api/v2/applicationTemplates/" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		_, _ = w.Write([]byte(`{"data": [{"id": "t1", "name": "Talk to my docs", "tags": ["Python"]}]}`))
+	}))
+	t.Cleanup(server.Close)
+
+	viper.Set(config.DataRobotURL, server.URL)
+	viper.Set(apiclient.MaxRetriesKey, 0)
+
+	// Skip token verification, which Get memoizes
+	token = "test-token"
+
+	t.Cleanup(func() { token = "" })
+
+	current := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return current }
+
+	t.Cleanup(func() { now = time.Now })
+
+	return server
+}
+
+func advance(d time.Duration) {
+	current := now().Add(d)
+	now = func() time.Time { return current }
+}
+
+func TestGetCatalogUsesFreshCache(t *testing.T) {
+	server := setupCatalog(t)
+
+	catalog, err := GetCatalog(false)
+	require.NoError(t, err)
+	require.Len(t, catalog.Templates, 1)
+	assert.Equal(t, "Talk to my docs", catalog.Templates[0].Name)
+	assert.True(t, HasCachedCatalog())
+
+	advance(time.Hour)
+
+	catalog, err = GetCatalog(false)
+	require.NoError(t, err)
+	assert.Len(t, catalog.Templates, 1)
+	assert.False(t, catalog.Stale)
+	assert.Equal(t, int32(1), server.requests.Load())
+}
+
+func TestGetCatalogRefetchesAfterTTL(t *testing.T) {
+	server := setupCatalog(t)
+	viper.Set(CatalogTTLKey, "30m")
+
+	_, err := GetCatalog(false)
+	require.NoError(t, err)
+
+	advance(time.Hour)
+
+	_, err = GetCatalog(false)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), server.requests.Load())
+}
+
+func TestGetCatalogRefresh(t *testing.T) {
+	server := setupCatalog(t)
+
+	_, err := GetCatalog(false)
+	require.NoError(t, err)
+
+	_, err = GetCatalog(true)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), server.requests.Load())
+
+	// A forced refresh does not hide a failure behind the cache
+	server.down.Store(true)
+
+	_, err = GetCatalog(true)
+	assert.Error(t, err)
+}
+
+func TestGetCatalogFallsBackToStaleCache(t *testing.T) {
+	server := setupCatalog(t)
+
+	_, err := GetCatalog(false)
+	require.NoError(t, err)
+
+	server.down.Store(true)
+	advance(48 * time.Hour)
+
+	catalog, err := GetCatalog(false)
+	require.NoError(t, err)
+	assert.True(t, catalog.Stale)
+	assert.Len(t, catalog.Templates, 1)
+}
+
+func TestGetCatalogIgnoresCacheOfOtherEndpoint(t *testing.T) {
+	setupCatalog(t)
+
+	_, err := GetCatalog(false)
+	require.NoError(t, err)
+
+	viper.Set(config.DataRobotURL, "https://other.datarobot.com")
+
+	assert.False(t, HasCachedCatalog())
+}
+
+func TestTemplateLanguageAndLastUpdated(t *testing.T) {
+	created := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	edited := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	template := Template{Tags: []string{"GenAI", "Python"}, CreatedAt: created}
+	assert.Equal(t, "Python", template.LanguageName())
+	assert.Equal(t, created, template.LastUpdated())
+
+	template.Language = "TypeScript"
+	template.EditedAt = edited
+	assert.Equal(t, "TypeScript", template.LanguageName())
+	assert.Equal(t, edited, template.LastUpdated())
+}
+
+func TestGetCatalogOfflineUsesCacheOfAnyAge(t *testing.T) {
+	server := setupCatalog(t)
+
+	_, err := GetCatalog(false)
+	require.NoError(t, err)
+
+	viper.Set(apiclient.OfflineKey, true)
+	advance(48 * time.Hour)
+
+	catalog, err := GetCatalog(true)
+	require.NoError(t, err)
+	assert.True(t, catalog.Stale)
+	assert.Len(t, catalog.Templates, 1)
+
+	list, err := GetPublicTemplatesSorted()
+	require.NoError(t, err)
+	assert.Len(t, list.Templates, 1)
+	assert.Equal(t, int32(1), server.requests.Load(), "offline mode should not contact the server")
+}
+
+func TestGetCatalogOfflineWithoutCache(t *testing.T) {
+	server := setupCatalog(t)
+	viper.Set(apiclient.OfflineKey, true)
+
+	_, err := GetCatalog(false)
+	require.ErrorIs(t, err, apiclient.ErrOffline)
+	assert.Contains(t, err.Error(), "--templates-dir")
+
+	_, err = GetTemplates()
+	require.ErrorIs(t, err, apiclient.ErrOffline)
+	assert.Zero(t, server.requests.Load())
+}
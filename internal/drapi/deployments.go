@@ -0,0 +1,152 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drapi
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/datarobot/cli/internal/apiclient"
+)
+
+// deploymentsPageSize is how many deployments are requested per page
+const deploymentsPageSize = 100
+
+type Deployment struct {
+	ID                    string                 `json:"id"`
+	Label                 string                 `json:"label"`
+	Description           string                 `json:"description,omitempty"`
+	Status                string                 `json:"status"`
+	Importance            string                 `json:"importance,omitempty"`
+	PredictionEnvironment *PredictionEnvironment `json:"predictionEnvironment,omitempty"`
+	CreatedAt             time.Time              `json:"createdAt"`
+}
+
+// PredictionEnvironment is where a deployment serves predictions
+type PredictionEnvironment struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Platform string `json:"platform,omitempty"`
+}
+
+// PredictionEnvironmentName returns the name of the deployment's
+// prediction environment, or "" if it has none
+func (d Deployment) PredictionEnvironmentName() string {
+	if d.PredictionEnvironment == nil {
+		return ""
+	}
+
+	return d.PredictionEnvironment.Name
+}
+
+// DeploymentFilterKeys lists the fields deployments can be filtered on
+var DeploymentFilterKeys = []string{"status", "label", "prediction-environment"}
+
+// DeploymentFilter keeps the deployments whose fields equal the given
+// values, ignoring case. Every filter must match.
+type DeploymentFilter map[string]string
+
+// ParseDeploymentFilters parses KEY=VALUE filters such as status=active
+func ParseDeploymentFilters(filters []string) (DeploymentFilter, error) {
+	parsed := DeploymentFilter{}
+
+	for _, filter := range filters {
+		key, value, ok := strings.Cut(filter, "=")
+		key = strings.ToLower(strings.TrimSpace(key))
+
+		if !ok || key == "" {
+			return nil, fmt.Errorf("Invalid filter %q (expected KEY=VALUE, such as status=active).", filter)
+		}
+
+		if !slices.Contains(DeploymentFilterKeys, key) {
+			return nil, fmt.Errorf("Unknown filter %q (must be one of: %s).", key, strings.Join(DeploymentFilterKeys, ", "))
+		}
+
+		parsed[key] = strings.TrimSpace(value)
+	}
+
+	return parsed, nil
+}
+
+// Matches reports whether d passes every filter
+func (f DeploymentFilter) Matches(d Deployment) bool {
+	for key, want := range f {
+		var got string
+
+		switch key {
+		case "status":
+			got = d.Status
+		case "label":
+			got = d.Label
+		case "prediction-environment":
+			got = d.PredictionEnvironmentName()
+		}
+
+		if !strings.EqualFold(got, want) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GetDeployments returns the deployments that pass filter and were
+// created at or after since, following the API's pages until limit of them
+// are found or maxPages pages are read. A zero since, or a limit or
+// maxPages of 0, means no cap.
+func GetDeployments(ctx context.Context, filter DeploymentFilter, since time.Time, limit, maxPages int) ([]Deployment, error) {
+	deployments := []Deployment{}
+
+	err := ListDeployments(ctx, filter, since, limit, maxPages, func(deployment Deployment) error {
+		deployments = append(deployments, deployment)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deployments, nil
+}
+
+// ListDeployments is GetDeployments, calling fn with each deployment as
+// soon as its page arrives instead of collecting them
+func ListDeployments(ctx context.Context, filter DeploymentFilter, since time.Time, limit, maxPages int, fn func(Deployment) error) error {
+	found := 0
+	opts := apiclient.PageOptions{PageSize: deploymentsPageSize, MaxPages: maxPages}
+
+	return Paginate(ctx, "/deployments/", "deployments", opts, func(page []Deployment) error {
+		for _, deployment := range page {
+			if !filter.Matches(deployment) || !createdSince(deployment.CreatedAt, since) {
+				continue
+			}
+
+			if err := fn(deployment); err != nil {
+				return err
+			}
+
+			found++
+
+			if limit > 0 && found == limit {
+				return apiclient.ErrStopPaging
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,174 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupDeployments serves two pages of deployments and counts the pages
+// requested
+func setupDeployments(t *testing.T) *int {
+	t.Helper()
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	requests := 0
+
+	var server *httptest.Server
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		if r.URL.Query().Get("offset") == "" {
+			fmt.Fprintf(w, `{"data": [
+				{"id": "d1", "label": "Churn", "status": "active", "predictionEnvironment": {"id": "p1", "name": "Production"}, "createdAt": "2026-01-02T10:00:00Z"},
+				{"id": "d2", "label": "Fraud", "status": "inactive"}
+			], "next": "%s/api/v2/deployments/?limit=100&offset=2"}`, server.URL)
+
+			return
+		}
+
+		fmt.Fprint(w, `{"data": [{"id": "d3", "label": "Pricing", "status": "active", "createdAt": "2026-03-04T10:00:00Z"}], "next": null}`)
+	}))
+	t.Cleanup(server.Close)
+
+	viper.Set(config.DataRobotURL, server.URL)
+	viper.Set(apiclient.MaxRetriesKey, 0)
+
+	token = "test-token"
+
+	t.Cleanup(func() { token = "" })
+
+	return &requests
+}
+
+func TestGetDeploymentsFollowsPages(t *testing.T) {
+	requests := setupDeployments(t)
+
+	deployments, err := GetDeployments(context.Background(), nil, time.Time{}, 0, 0)
+	require.NoError(t, err)
+
+	require.Len(t, deployments, 3)
+	assert.Equal(t, "Production", deployments[0].PredictionEnvironmentName())
+	assert.Empty(t, deployments[1].PredictionEnvironmentName())
+	assert.Equal(t, "d3", deployments[2].ID)
+	assert.Equal(t, 2, *requests)
+}
+
+func TestGetDeploymentsFilterAndLimit(t *testing.T) {
+	requests := setupDeployments(t)
+
+	filter, err := ParseDeploymentFilters([]string{"status=ACTIVE"})
+	require.NoError(t, err)
+
+	deployments, err := GetDeployments(context.Background(), filter, time.Time{}, 1, 0)
+	require.NoError(t, err)
+
+	require.Len(t, deployments, 1)
+	assert.Equal(t, "d1", deployments[0].ID)
+	assert.Equal(t, 1, *requests, "no more pages are fetched once the limit is reached")
+
+	deployments, err = GetDeployments(context.Background(), filter, time.Time{}, 0, 0)
+	require.NoError(t, err)
+	assert.Len(t, deployments, 2)
+}
+
+func TestGetDeploymentsSince(t *testing.T) {
+	setupDeployments(t)
+
+	deployments, err := GetDeployments(context.Background(), nil, time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC), 0, 0)
+	require.NoError(t, err)
+
+	// d2 has no creation time, so it can't be shown to be recent enough
+	require.Len(t, deployments, 2)
+	assert.Equal(t, "d1", deployments[0].ID)
+	assert.Equal(t, "d3", deployments[1].ID)
+
+	deployments, err = GetDeployments(context.Background(), nil, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), 0, 0)
+	require.NoError(t, err)
+	require.Len(t, deployments, 1)
+	assert.Equal(t, "d3", deployments[0].ID)
+}
+
+func TestGetDeploymentsStopsAtMaxPages(t *testing.T) {
+	requests := setupDeployments(t)
+
+	deployments, err := GetDeployments(context.Background(), nil, time.Time{}, 0, 1)
+	require.NoError(t, err, "truncating is a warning, not an error")
+
+	assert.Len(t, deployments, 2)
+	assert.Equal(t, 1, *requests)
+}
+
+func TestParseDeploymentFilters(t *testing.T) {
+	filter, err := ParseDeploymentFilters([]string{"Status=active", "prediction-environment=Production"})
+	require.NoError(t, err)
+	assert.Equal(t, DeploymentFilter{"status": "active", "prediction-environment": "Production"}, filter)
+
+	_, err = ParseDeploymentFilters([]string{"status"})
+	require.EqualError(t, err, `Invalid filter "status" (expected KEY=VALUE, such as status=active).`)
+
+	_, err = ParseDeploymentFilters([]string{"owner=me"})
+	require.EqualError(t, err, `Unknown filter "owner" (must be one of: status, label, prediction-environment).`)
+}
+
+func TestListDeploymentsStreamsPagesBeforeAFailure(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	var server *httptest.Server
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("offset") != "" {
+			w.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		fmt.Fprintf(w, `{"data": [{"id": "d1"}, {"id": "d2"}], "next": "%s/api/v2/deployments/?offset=2"}`, server.URL)
+	}))
+	t.Cleanup(server.Close)
+
+	viper.Set(config.DataRobotURL, server.URL)
+	viper.Set(apiclient.MaxRetriesKey, 0)
+
+	token = "test-token"
+
+	t.Cleanup(func() { token = "" })
+
+	var streamed []string
+
+	err := ListDeployments(context.Background(), nil, time.Time{}, 0, 0, func(deployment Deployment) error {
+		streamed = append(streamed, deployment.ID)
+
+		return nil
+	})
+
+	require.Error(t, err)
+	assert.Equal(t, []string{"d1", "d2"}, streamed, "the first page is delivered before the second fails")
+}
@@ -15,61 +15,96 @@
 package drapi
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
-	"time"
 
-	"github.com/charmbracelet/log"
+	"github.com/datarobot/cli/internal/apiclient"
 	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/errs"
+	"github.com/datarobot/cli/internal/log"
 )
 
 var token string
 
 func Get(url, info string) (*http.Response, error) {
-	var err error
-
-	// memoize token to avoid extra VerifyToken() calls
-	if token == "" {
-		token, err = config.GetAPIKey()
-		if err != nil {
-			return nil, err
-		}
-	}
+	return GetContext(context.Background(), url, info)
+}
 
-	req, err := http.NewRequest(http.MethodGet, url, nil)
+// GetContext is Get with a context that can cancel the request
+func GetContext(ctx context.Context, url, info string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Authorization", "Bearer "+token)
-	req.Header.Add("User-Agent", config.GetUserAgentHeader())
-
 	if info != "" {
 		log.Infof("Fetching %s from: %s", info, url)
 	}
 
-	log.Debug("Request Info: \n" + config.RedactedReqInfo(req))
-
-	client := &http.Client{
-		Timeout: 30 * time.Second,
-	}
-
-	resp, err := client.Do(req)
+	resp, err := Do(req)
 	if err != nil {
 		return nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
 		resp.Body.Close()
-		return nil, errors.New("Response status code is " + resp.Status + ".")
+
+		msg := "Response status code is " + resp.Status + "."
+
+		if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
+			return nil, errs.NewAuthError(msg, nil)
+		}
+
+		// An older server may not have the route at all
+		if resp.StatusCode == http.StatusNotFound {
+			if err := unsupportedError(ctx, url); err != nil {
+				return nil, err
+			}
+		}
+
+		return nil, errs.NewNetworkError(msg, nil)
 	}
 
 	return resp, err
 }
 
+// Do sends req with the user's token through the shared client. Unlike
+// Get, any response status is returned to the caller.
+func Do(req *http.Request) (*http.Response, error) {
+	var err error
+
+	// memoize token to avoid extra VerifyToken() calls
+	if token == "" {
+		token, err = config.GetAPIKey()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("User-Agent", config.GetUserAgentHeader())
+
+	log.Debug("Request Info: \n" + config.RedactedReqInfo(req))
+
+	client := apiclient.New(0)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, errs.NewNetworkError("", err)
+	}
+
+	return resp, nil
+}
+
 func GetJSON(url, info string, v any) error {
-	resp, err := Get(url, info)
+	return GetJSONContext(context.Background(), url, info, v)
+}
+
+// GetJSONContext is GetJSON with a context that can cancel the request
+func GetJSONContext(ctx context.Context, url, info string, v any) error {
+	resp, err := GetContext(ctx, url, info)
 	if err != nil {
 		return err
 	}
@@ -83,3 +118,39 @@ func GetJSON(url, info string, v any) error {
 
 	return nil
 }
+
+// Paginate calls fn with each page of items from an API route such as
+// "/deployments/", logging info for every page fetched. See
+// apiclient.Paginate for when it stops. Stopping at opts.MaxPages is not
+// an error: it warns that the results are truncated instead.
+func Paginate[T any](ctx context.Context, route, info string, opts apiclient.PageOptions, fn func(items []T) error) error {
+	start, err := config.GetAPIURL(route)
+	if err != nil {
+		return err
+	}
+
+	fetch := func(ctx context.Context, url string, v any) error {
+		return GetJSONContext(ctx, url, info, v)
+	}
+
+	err = apiclient.Paginate(ctx, fetch, start, opts, fn)
+	if errors.Is(err, apiclient.ErrMaxPages) {
+		log.Warnf("Stopped after %d pages of %s, so the results are truncated. Narrow them with a filter, or raise --max-pages.", opts.MaxPages, info)
+
+		return nil
+	}
+
+	return err
+}
+
+// PollUntil waits for the long-running operation at statusURL, such as
+// the Location of a 202 Accepted response, and returns the resource it
+// produced. The waits between polls and the overall timeout come from
+// --poll-interval and --poll-timeout; see apiclient.PollUntil.
+func PollUntil[T any](ctx context.Context, statusURL, info string) (T, error) {
+	fetch := func(ctx context.Context, url string, v any) error {
+		return GetJSONContext(ctx, url, info, v)
+	}
+
+	return apiclient.PollUntil[T](ctx, fetch, statusURL, apiclient.PollOptionsFromConfig())
+}
@@ -0,0 +1,101 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/errs"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetErrorExitCodes(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.Set(apiclient.MaxRetriesKey, 0)
+
+	token = "test-token"
+
+	t.Cleanup(func() { token = "" })
+
+	tests := []struct {
+		status int
+		want   int
+	}{
+		{http.StatusUnauthorized, errs.ExitCodeAuth},
+		{http.StatusForbidden, errs.ExitCodeAuth},
+		{http.StatusNotFound, errs.ExitCodeNetwork},
+		{http.StatusServiceUnavailable, errs.ExitCodeNetwork},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(tt.status)
+			}))
+			defer server.Close()
+
+			_, err := Get(server.URL, "")
+			assert.Equal(t, tt.want, errs.ExitCode(err))
+		})
+	}
+
+	t.Run("unreachable", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+
+		_, err := Get(server.URL, "")
+		assert.Equal(t, errs.ExitCodeNetwork, errs.ExitCode(err))
+	})
+}
+
+func TestPollUntilUsesPollSettings(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	viper.Set(apiclient.MaxRetriesKey, 0)
+	viper.Set(apiclient.PollIntervalKey, "1ms")
+	viper.Set(apiclient.PollTimeoutKey, "1s")
+
+	token = "test-token"
+
+	t.Cleanup(func() { token = "" })
+
+	polls := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		polls++
+
+		if polls < 3 {
+			fmt.Fprint(w, `{"status": "RUNNING"}`)
+
+			return
+		}
+
+		fmt.Fprint(w, `{"id": "d1", "label": "Churn", "status": "active"}`)
+	}))
+	t.Cleanup(server.Close)
+
+	deployment, err := PollUntil[Deployment](context.Background(), server.URL+"/api/v2/status/1/", "deployment")
+	require.NoError(t, err)
+	assert.Equal(t, "d1", deployment.ID)
+	assert.Equal(t, 3, polls)
+}
@@ -15,6 +15,8 @@
 package drapi
 
 import (
+	"context"
+
 	"github.com/datarobot/cli/internal/config"
 )
 
@@ -63,8 +65,14 @@ type LLMList struct {
 	Previous   string `json:"previous"`
 }
 
+// GetLLMs returns the active LLMs in the gateway catalog. On a server too
+// old for the LLM gateway the list is empty.
 func GetLLMs() (*LLMList, error) {
-	url, err := config.GetEndpointURL("/api/v2/genai/llmgw/catalog/?limit=100")
+	if !Supports(context.Background(), FeatureLLMGateway) {
+		return &LLMList{}, nil
+	}
+
+	url, err := config.GetAPIURL("/genai/llmgw/catalog/?limit=100")
 	if err != nil {
 		return nil, err
 	}
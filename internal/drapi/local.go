@@ -0,0 +1,187 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drapi
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/datarobot/cli/internal/fsutil"
+	"github.com/datarobot/cli/internal/log"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// TemplatesDirKey points at a local directory of templates that is used
+	// instead of the remote catalog, e.g. in air-gapped environments
+	TemplatesDirKey = "templates-dir"
+
+	manifestFile = "manifest.yaml"
+)
+
+// templateManifest describes a template in a local templates directory.
+// Each template lives in its own subdirectory with a manifest.yaml.
+type templateManifest struct {
+	ID          string   `yaml:"id"`
+	Name        string   `yaml:"name"`
+	Description string   `yaml:"description"`
+	Language    string   `yaml:"language"`
+	Tags        []string `yaml:"tags"`
+	Repository  struct {
+		URL string `yaml:"url"`
+		Tag string `yaml:"tag"`
+	} `yaml:"repository"`
+	Entrypoint    string    `yaml:"entrypoint"`
+	SetupTime     string    `yaml:"setup_time"`
+	Prerequisites []string  `yaml:"prerequisites"`
+	UpdatedAt     time.Time `yaml:"updated_at"`
+	EnvVars       []struct {
+		Name        string `yaml:"name"`
+		Description string `yaml:"description"`
+		Required    bool   `yaml:"required"`
+	} `yaml:"env_vars"`
+}
+
+// LocalTemplatesDir returns the configured local templates directory, or
+// an empty string when the remote catalog is used
+func LocalTemplatesDir() string {
+	dir := viper.GetString(TemplatesDirKey)
+	if dir == "" {
+		return ""
+	}
+
+	return fsutil.AbsolutePath(dir)
+}
+
+// UsesLocalTemplates reports whether templates are read from a local
+// directory rather than the remote catalog
+func UsesLocalTemplates() bool {
+	return LocalTemplatesDir() != ""
+}
+
+// LoadLocalTemplates reads the templates in dir, one per subdirectory.
+// Subdirectories with a malformed manifest, or an id already used by an
+// earlier subdirectory in name order, are skipped with a warning.
+func LoadLocalTemplates(dir string) ([]Template, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read templates directory %s: %w", dir, err)
+	}
+
+	var templates []Template
+
+	seen := make(map[string]string)
+
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		templateDir := filepath.Join(dir, entry.Name())
+
+		template, err := loadManifest(templateDir)
+		if errors.Is(err, os.ErrNotExist) {
+			log.Debug("Skipping directory without a template manifest", "dir", templateDir)
+
+			continue
+		}
+
+		if err != nil {
+			log.Warn("Skipping malformed template", "dir", templateDir, "error", err)
+
+			continue
+		}
+
+		if other, ok := seen[template.ID]; ok {
+			log.Warn("Skipping template with duplicate id", "dir", templateDir, "id", template.ID, "first", other)
+
+			continue
+		}
+
+		seen[template.ID] = templateDir
+
+		templates = append(templates, template)
+	}
+
+	sort.SliceStable(templates, func(i, j int) bool {
+		return templates[i].Name < templates[j].Name
+	})
+
+	return templates, nil
+}
+
+// loadManifest reads and validates the manifest of the template in dir
+func loadManifest(dir string) (Template, error) {
+	data, err := os.ReadFile(filepath.Join(dir, manifestFile))
+	if err != nil {
+		return Template{}, err
+	}
+
+	var manifest templateManifest
+
+	if err := yaml.Unmarshal(data, &manifest); err != nil {
+		return Template{}, fmt.Errorf("Invalid %s: %w", manifestFile, err)
+	}
+
+	if manifest.Name == "" {
+		return Template{}, fmt.Errorf("%s has no name.", manifestFile)
+	}
+
+	template := Template{
+		ID:            manifest.ID,
+		Name:          manifest.Name,
+		Description:   manifest.Description,
+		IsGlobal:      true,
+		Language:      manifest.Language,
+		Tags:          manifest.Tags,
+		Repository:    Repository{URL: manifest.Repository.URL, Tag: manifest.Repository.Tag},
+		Entrypoint:    manifest.Entrypoint,
+		SetupTime:     manifest.SetupTime,
+		Prerequisites: manifest.Prerequisites,
+		EditedAt:      manifest.UpdatedAt,
+	}
+
+	if template.ID == "" {
+		template.ID = filepath.Base(dir)
+	}
+
+	// Without a repository URL the template directory itself is cloned
+	if template.Repository.URL == "" {
+		if !fsutil.PathExists(filepath.Join(dir, ".git")) {
+			return Template{}, fmt.Errorf("%s has no repository url and %s is not a git repository.", manifestFile, dir)
+		}
+
+		template.Repository.URL = dir
+	}
+
+	for i, envVar := range manifest.EnvVars {
+		if envVar.Name == "" {
+			return Template{}, fmt.Errorf("%s: env_vars entry %d has no name.", manifestFile, i+1)
+		}
+
+		template.EnvVars = append(template.EnvVars, TemplateEnvVar{
+			Name:        envVar.Name,
+			Description: envVar.Description,
+			Required:    envVar.Required,
+		})
+	}
+
+	return template, nil
+}
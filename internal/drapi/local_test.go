@@ -0,0 +1,125 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drapi
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeManifest(t *testing.T, dir, name, manifest string) string {
+	t.Helper()
+
+	templateDir := filepath.Join(dir, name)
+	require.NoError(t, os.MkdirAll(templateDir, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(templateDir, manifestFile), []byte(manifest), 0o644))
+
+	return templateDir
+}
+
+func setupLocalTemplates(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	writeManifest(t, dir, "talk-to-my-docs", `name: Talk to my docs
+description: Chat with your documents.
+language: Python
+repository:
+  url: https://git.example.com/templates/talk-to-my-docs.git
+  tag: v1.2.0
+entrypoint: .datarobot/cli/bin/quickstart.py
+setup_time: about 10 minutes
+prerequisites: [uv]
+env_vars:
+  - name: OPENAI_API_KEY
+    description: Key for the LLM provider
+    required: true
+`)
+
+	gitDir := writeManifest(t, dir, "forecasting", "id: fc\nname: Forecasting\n")
+	require.NoError(t, os.Mkdir(filepath.Join(gitDir, ".git"), 0o755))
+
+	writeManifest(t, dir, "broken", "name: [unterminated\n")
+	writeManifest(t, dir, "nameless", "description: no name\nrepository:\n  url: https://git.example.com/x.git\n")
+	writeManifest(t, dir, "not-git", "name: Not git\n")
+	writeManifest(t, dir, "forecasting-copy", "id: fc\nname: Duplicate\nrepository:\n  url: https://git.example.com/d.git\n")
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "assets"), 0o755))
+
+	return dir
+}
+
+func TestLoadLocalTemplates(t *testing.T) {
+	dir := setupLocalTemplates(t)
+
+	templates, err := LoadLocalTemplates(dir)
+	require.NoError(t, err)
+	require.Len(t, templates, 2)
+
+	forecasting, docs := templates[0], templates[1]
+
+	assert.Equal(t, "fc", forecasting.ID)
+	assert.Equal(t, filepath.Join(dir, "forecasting"), forecasting.Repository.URL)
+
+	assert.Equal(t, "talk-to-my-docs", docs.ID)
+	assert.Equal(t, "Python", docs.LanguageName())
+	assert.Equal(t, Repository{URL: "https://git.example.com/templates/talk-to-my-docs.git", Tag: "v1.2.0"}, docs.Repository)
+	assert.Equal(t, ".datarobot/cli/bin/quickstart.py", docs.Entrypoint)
+	assert.Equal(t, "about 10 minutes", docs.SetupTime)
+	assert.Equal(t, []string{"uv"}, docs.Prerequisites)
+	assert.Equal(t, []TemplateEnvVar{{Name: "OPENAI_API_KEY", Description: "Key for the LLM provider", Required: true}}, docs.EnvVars)
+}
+
+func TestLoadManifestValidation(t *testing.T) {
+	dir := setupLocalTemplates(t)
+
+	for name, want := range map[string]string{
+		"broken":   "Invalid manifest.yaml",
+		"nameless": "has no name",
+		"not-git":  "is not a git repository",
+	} {
+		_, err := loadManifest(filepath.Join(dir, name))
+		require.Error(t, err, name)
+		assert.Contains(t, err.Error(), want, name)
+	}
+}
+
+func TestGetCatalogReadsLocalTemplatesDir(t *testing.T) {
+	testutil.SetTestHomeDir(t, t.TempDir())
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	config.ConfigureEnv(viper.GetViper())
+
+	// No endpoint or token: the remote catalog must not be contacted
+	viper.Set(TemplatesDirKey, setupLocalTemplates(t))
+
+	catalog, err := GetCatalog(false)
+	require.NoError(t, err)
+	assert.Len(t, catalog.Templates, 2)
+	assert.False(t, HasCachedCatalog())
+
+	viper.Set(TemplatesDirKey, filepath.Join(t.TempDir(), "missing"))
+
+	_, err = GetCatalog(false)
+	assert.ErrorContains(t, err, "Failed to read templates directory")
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drapi
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/datarobot/cli/internal/apiclient"
+)
+
+// projectsPageSize is how many projects are requested per page
+const projectsPageSize = 100
+
+type Project struct {
+	ID       string    `json:"id"`
+	Name     string    `json:"projectName"`
+	FileName string    `json:"fileName,omitempty"`
+	Stage    string    `json:"stage,omitempty"`
+	Created  time.Time `json:"created"`
+}
+
+// GetProjects returns the projects whose name contains nameContains,
+// ignoring case, and that were created at or after since, following the
+// API's pages until limit of them are found. Fetching stops after maxPages
+// pages. A zero since, or a limit or maxPages of 0, means no cap.
+func GetProjects(ctx context.Context, nameContains string, since time.Time, limit, maxPages int) ([]Project, error) {
+	projects := []Project{}
+
+	err := ListProjects(ctx, nameContains, since, limit, maxPages, func(project Project) error {
+		projects = append(projects, project)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return projects, nil
+}
+
+// ListProjects is GetProjects, calling fn with each project as soon as its
+// page arrives instead of collecting them
+func ListProjects(ctx context.Context, nameContains string, since time.Time, limit, maxPages int, fn func(Project) error) error {
+	nameContains = strings.ToLower(nameContains)
+	found := 0
+	opts := apiclient.PageOptions{PageSize: projectsPageSize, MaxPages: maxPages}
+
+	return Paginate(ctx, "/projects/", "projects", opts, func(page []Project) error {
+		for _, project := range page {
+			if !strings.Contains(strings.ToLower(project.Name), nameContains) || !createdSince(project.Created, since) {
+				continue
+			}
+
+			if err := fn(project); err != nil {
+				return err
+			}
+
+			found++
+
+			if limit > 0 && found == limit {
+				return apiclient.ErrStopPaging
+			}
+		}
+
+		return nil
+	})
+}
+
+// createdSince reports whether something created at created passes a
+// --since of since. With no since everything does; otherwise something
+// without a creation time does not.
+func createdSince(created, since time.Time) bool {
+	return since.IsZero() || !created.Before(since) && !created.IsZero()
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetProjectsFollowsPages(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	requests := 0
+
+	var server *httptest.Server
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+
+		assert.Equal(t, "/api/v2/projects/", r.URL.Path)
+
+		if r.URL.Query().Get("offset") == "" {
+			fmt.Fprintf(w, `{"data": [
+				{"id": "p1", "projectName": "Churn v1", "created": "2026-01-02T10:00:00Z"},
+				{"id": "p2", "projectName": "Fraud", "created": "2026-03-04T10:00:00Z"}
+			], "next": "%s/api/v2/projects/?limit=100&offset=2"}`, server.URL)
+
+			return
+		}
+
+		fmt.Fprint(w, `{"data": [{"id": "p3", "projectName": "churn v2"}], "next": null}`)
+	}))
+	t.Cleanup(server.Close)
+
+	viper.Set(config.DataRobotURL, server.URL)
+	viper.Set(apiclient.MaxRetriesKey, 0)
+
+	token = "test-token"
+
+	t.Cleanup(func() { token = "" })
+
+	projects, err := GetProjects(context.Background(), "", time.Time{}, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, projects, 3)
+	assert.Equal(t, 2026, projects[1].Created.Year())
+	assert.True(t, projects[2].Created.IsZero())
+	assert.Equal(t, 2, requests)
+
+	projects, err = GetProjects(context.Background(), "CHURN", time.Time{}, 0, 0)
+	require.NoError(t, err)
+	require.Len(t, projects, 2)
+	assert.Equal(t, "p3", projects[1].ID)
+
+	projects, err = GetProjects(context.Background(), "", time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), 0, 0)
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	assert.Equal(t, "p2", projects[0].ID)
+
+	requests = 0
+
+	projects, err = GetProjects(context.Background(), "", time.Time{}, 1, 0)
+	require.NoError(t, err)
+	assert.Len(t, projects, 1)
+	assert.Equal(t, 1, requests, "no more pages are fetched once the limit is reached")
+}
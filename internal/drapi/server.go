@@ -0,0 +1,342 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/log"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// serverInfoTTL is how long a detected server version is reused.
+	// Servers are upgraded rarely, and a stale version only affects how
+	// unsupported features are reported.
+	serverInfoTTL = 24 * time.Hour
+
+	serverInfoCacheFile = "server-info.json"
+)
+
+// Features that depend on the server's API version
+const (
+	FeatureApplicationTemplates = "application-templates"
+	FeatureLLMGateway           = "llm-gateway"
+)
+
+// Feature is a part of the API that older servers don't have
+type Feature struct {
+	Name        string
+	Description string
+	// Route is the API route the feature is served under
+	Route string
+	// MinVersion is the first API version with the feature
+	MinVersion string
+}
+
+// Features are the version-gated features, in the order they are shown
+var Features = []Feature{
+	{FeatureApplicationTemplates, "Application template catalog", "/applicationTemplates/", "2.36"},
+	{FeatureLLMGateway, "LLM gateway catalog", "/genai/llmgw/", "2.35"},
+}
+
+// ServerInfo is the API version of the configured endpoint
+type ServerInfo struct {
+	Endpoint        string    `json:"endpoint"                   yaml:"endpoint"`
+	Version         string    `json:"version"                    yaml:"version"`
+	ReleasedVersion string    `json:"released_version,omitempty" yaml:"released_version,omitempty"`
+	FetchedAt       time.Time `json:"fetched_at"                 yaml:"fetched_at"`
+}
+
+// Supports reports whether the server's API version has feature. A
+// version that cannot be parsed, or a feature that isn't version-gated,
+// is assumed to be supported.
+func (s ServerInfo) Supports(feature string) bool {
+	for _, f := range Features {
+		if f.Name == feature {
+			return versionAtLeast(s.Version, f.MinVersion)
+		}
+	}
+
+	return true
+}
+
+// Capabilities returns the names of the features the server supports
+func (s ServerInfo) Capabilities() []string {
+	var names []string
+
+	for _, f := range Features {
+		if s.Supports(f.Name) {
+			names = append(names, f.Name)
+		}
+	}
+
+	return names
+}
+
+type versionResponse struct {
+	Major           int    `json:"major"`
+	Minor           int    `json:"minor"`
+	VersionString   string `json:"versionString"`
+	ReleasedVersion string `json:"releasedVersion"`
+}
+
+var (
+	serverInfoMu sync.Mutex
+	// serverInfos memoizes the detected versions for this run, by endpoint
+	serverInfos = map[string]ServerInfo{}
+
+	// serverInfoGroup lets concurrent callers, such as parallel tasks,
+	// share one detection per endpoint
+	serverInfoGroup singleflight.Group
+
+	// serverInfoCacheMu serializes updates of the cache file
+	serverInfoCacheMu sync.Mutex
+)
+
+// GetServerInfo returns the API version of the configured endpoint. It is
+// detected once per run and cached on disk for a day per endpoint; with
+// refresh it is always fetched. When fetching fails, an expired cached
+// copy is used. Concurrent callers wait for the same detection, made with
+// the first caller's context.
+func GetServerInfo(ctx context.Context, refresh bool) (*ServerInfo, error) {
+	endpoint := config.GetBaseURL()
+
+	if info, ok := memoizedServerInfo(endpoint); ok && !refresh {
+		return &info, nil
+	}
+
+	// A refresh must not be answered by a detection that may have used
+	// the disk cache
+	key := endpoint
+	if refresh {
+		key = "refresh:" + endpoint
+	}
+
+	v, err, _ := serverInfoGroup.Do(key, func() (any, error) {
+		return detectServerInfo(ctx, endpoint, refresh)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	info := v.(ServerInfo)
+
+	return &info, nil
+}
+
+func detectServerInfo(ctx context.Context, endpoint string, refresh bool) (ServerInfo, error) {
+	// A detection that finished while this caller was getting here
+	// counts too
+	if info, ok := memoizedServerInfo(endpoint); ok && !refresh {
+		return info, nil
+	}
+
+	cached, ok := loadServerInfoCache()[endpoint]
+	if ok && !refresh && now().Sub(cached.FetchedAt) < serverInfoTTL {
+		memoizeServerInfo(cached)
+
+		return cached, nil
+	}
+
+	info, err := fetchServerInfo(ctx, endpoint)
+	if err != nil {
+		if ok && !refresh {
+			log.Debug("Failed to detect the server version, using the cached one", "error", err)
+
+			memoizeServerInfo(cached)
+
+			return cached, nil
+		}
+
+		return ServerInfo{}, err
+	}
+
+	memoizeServerInfo(info)
+
+	saveServerInfo(info)
+
+	return info, nil
+}
+
+func memoizedServerInfo(endpoint string) (ServerInfo, bool) {
+	serverInfoMu.Lock()
+	defer serverInfoMu.Unlock()
+
+	info, ok := serverInfos[endpoint]
+
+	return info, ok
+}
+
+func memoizeServerInfo(info ServerInfo) {
+	serverInfoMu.Lock()
+	defer serverInfoMu.Unlock()
+
+	serverInfos[info.Endpoint] = info
+}
+
+// Supports reports whether the configured endpoint has feature. If the
+// version cannot be detected, the feature is assumed to be there, so a
+// request is made and reports its own error.
+func Supports(ctx context.Context, feature string) bool {
+	info, err := GetServerInfo(ctx, false)
+	if err != nil {
+		log.Debug("Cannot detect the server version", "error", err)
+
+		return true
+	}
+
+	return info.Supports(feature)
+}
+
+func fetchServerInfo(ctx context.Context, endpoint string) (ServerInfo, error) {
+	url, err := config.GetAPIURL("/version/")
+	if err != nil {
+		return ServerInfo{}, err
+	}
+
+	var resp versionResponse
+
+	if err := GetJSONContext(ctx, url, "", &resp); err != nil {
+		return ServerInfo{}, err
+	}
+
+	version := resp.VersionString
+	if resp.Major > 0 {
+		version = fmt.Sprintf("%d.%d", resp.Major, resp.Minor)
+	}
+
+	return ServerInfo{
+		Endpoint:        endpoint,
+		Version:         version,
+		ReleasedVersion: resp.ReleasedVersion,
+		FetchedAt:       now().UTC(),
+	}, nil
+}
+
+// unsupportedError explains a 404 from url when the route belongs to a
+// feature the server's version does not have. It returns nil otherwise,
+// including when the version cannot be detected.
+func unsupportedError(ctx context.Context, url string) error {
+	for _, f := range Features {
+		if !strings.Contains(url, apiclient.APIPath+f.Route) {
+			continue
+		}
+
+		info, err := GetServerInfo(ctx, false)
+		if err != nil || info.Supports(f.Name) {
+			return nil
+		}
+
+		return fmt.Errorf("The DataRobot server at %s runs API version %s, which does not support %s; it needs API %s or later.",
+			info.Endpoint, info.Version, f.Name, f.MinVersion)
+	}
+
+	return nil
+}
+
+// versionAtLeast compares API versions such as 2.36. An unparsable
+// version is treated as new enough.
+func versionAtLeast(version, minimum string) bool {
+	have, err := semver.NewVersion(version)
+	if err != nil {
+		return true
+	}
+
+	want, err := semver.NewVersion(minimum)
+	if err != nil {
+		return true
+	}
+
+	return !have.LessThan(want)
+}
+
+func serverInfoCachePath() (string, error) {
+	dir, err := config.CacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, serverInfoCacheFile), nil
+}
+
+// loadServerInfoCache returns the cached versions by endpoint; a missing or
+// unreadable cache is empty
+func loadServerInfoCache() map[string]ServerInfo {
+	infos := map[string]ServerInfo{}
+
+	path, err := serverInfoCachePath()
+	if err != nil {
+		return infos
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Debug("Failed to read server info cache", "error", err)
+		}
+
+		return infos
+	}
+
+	if err := json.Unmarshal(data, &infos); err != nil {
+		log.Debug("Failed to parse server info cache", "error", err)
+
+		return map[string]ServerInfo{}
+	}
+
+	return infos
+}
+
+// saveServerInfo adds info to the cache. Failures only cost a request next
+// time, so they are logged rather than returned.
+func saveServerInfo(info ServerInfo) {
+	path, err := serverInfoCachePath()
+	if err != nil {
+		return
+	}
+
+	serverInfoCacheMu.Lock()
+	defer serverInfoCacheMu.Unlock()
+
+	infos := loadServerInfoCache()
+	infos[info.Endpoint] = info
+
+	data, err := json.Marshal(infos)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		log.Debug("Failed to create cache directory", "error", err)
+
+		return
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		log.Debug("Failed to write server info cache", "error", err)
+	}
+}
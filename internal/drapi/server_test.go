@@ -0,0 +1,193 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupServerInfo starts a server that reports API major.minor and has no
+// other routes, and returns a counter of its /version/ requests
+func setupServerInfo(t *testing.T, minor int) *atomic.Int32 {
+	t.Helper()
+
+	testutil.SetTestHomeDir(t, t.TempDir())
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	config.ConfigureEnv(viper.GetViper())
+
+	var versionRequests atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v2/version/" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		versionRequests.Add(1)
+
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"major": 2, "minor": %d, "versionString": "2.%d.0", "releasedVersion": "10.1.0"}`, minor, minor)))
+	}))
+	t.Cleanup(server.Close)
+
+	viper.Set(config.DataRobotURL, server.URL)
+	viper.Set(apiclient.MaxRetriesKey, 0)
+
+	token = "test-token"
+
+	current := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return current }
+
+	forgetServerInfo()
+
+	t.Cleanup(func() {
+		token = ""
+		now = time.Now
+
+		forgetServerInfo()
+	})
+
+	return &versionRequests
+}
+
+func forgetServerInfo() {
+	serverInfoMu.Lock()
+	defer serverInfoMu.Unlock()
+
+	serverInfos = map[string]ServerInfo{}
+}
+
+func TestGetServerInfo(t *testing.T) {
+	requests := setupServerInfo(t, 36)
+
+	info, err := GetServerInfo(context.Background(), false)
+	require.NoError(t, err)
+	assert.Equal(t, "2.36", info.Version)
+	assert.Equal(t, "10.1.0", info.ReleasedVersion)
+	assert.Equal(t, config.GetBaseURL(), info.Endpoint)
+	assert.Equal(t, []string{FeatureApplicationTemplates, FeatureLLMGateway}, info.Capabilities())
+
+	_, err = GetServerInfo(context.Background(), false)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), requests.Load(), "the version should be detected once per run")
+
+	// A new run reads the disk cache until it expires
+	forgetServerInfo()
+
+	_, err = GetServerInfo(context.Background(), false)
+	require.NoError(t, err)
+	assert.Equal(t, int32(1), requests.Load())
+
+	forgetServerInfo()
+	advance(serverInfoTTL)
+
+	_, err = GetServerInfo(context.Background(), false)
+	require.NoError(t, err)
+	assert.Equal(t, int32(2), requests.Load())
+
+	_, err = GetServerInfo(context.Background(), true)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), requests.Load())
+}
+
+func TestGetServerInfoConcurrentCallersShareOneRequest(t *testing.T) {
+	requests := setupServerInfo(t, 36)
+
+	const callers = 20
+
+	var (
+		wg    sync.WaitGroup
+		start = make(chan struct{})
+		errs  = make(chan error, callers)
+	)
+
+	for range callers {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			<-start
+
+			info, err := GetServerInfo(context.Background(), false)
+			if err == nil && info.Version != "2.36" {
+				err = fmt.Errorf("unexpected version %q", info.Version)
+			}
+
+			errs <- err
+		}()
+	}
+
+	close(start)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		require.NoError(t, err)
+	}
+
+	assert.Equal(t, int32(1), requests.Load(), "concurrent callers should share one request")
+}
+
+func TestServerInfoSupports(t *testing.T) {
+	info := ServerInfo{Version: "2.35"}
+
+	assert.False(t, info.Supports(FeatureApplicationTemplates))
+	assert.True(t, info.Supports(FeatureLLMGateway))
+	assert.True(t, info.Supports("not-version-gated"))
+	assert.True(t, ServerInfo{Version: "unknown"}.Supports(FeatureApplicationTemplates))
+	assert.True(t, ServerInfo{Version: "3.0"}.Supports(FeatureApplicationTemplates))
+}
+
+func TestNotFoundOnOldServerNamesTheVersion(t *testing.T) {
+	setupServerInfo(t, 30)
+
+	_, err := GetTemplates()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "runs API version 2.30, which does not support application-templates")
+	assert.Contains(t, err.Error(), "API 2.36 or later")
+}
+
+func TestNotFoundOnCurrentServerIsReportedAsIs(t *testing.T) {
+	setupServerInfo(t, 36)
+
+	_, err := GetTemplates()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "404")
+}
+
+func TestGetLLMsSkipsUnsupportedGateway(t *testing.T) {
+	setupServerInfo(t, 30)
+
+	llms, err := GetLLMs()
+	require.NoError(t, err)
+	assert.Empty(t, llms.LLMs)
+}
@@ -20,7 +20,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/datarobot/cli/internal/apiclient"
 	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/misc/suggest"
 )
 
 type Template struct {
@@ -30,12 +32,20 @@ type Template struct {
 	IsGlobal    bool   `json:"isGlobal"`
 	IsPremium   bool   `json:"isPremium"`
 
-	Readme     string     `json:"readme"`
-	Tags       []string   `json:"tags"`
+	Readme   string   `json:"readme"`
+	Tags     []string `json:"tags"`
+	Language string   `json:"language,omitempty"`
+
+	EnvVars       []TemplateEnvVar `json:"envVars,omitempty"`
+	Entrypoint    string           `json:"entrypoint,omitempty"`
+	SetupTime     string           `json:"setupTime,omitempty"`
+	Prerequisites []string         `json:"prerequisites,omitempty"`
+
 	Repository Repository `json:"repository"`
 	MediaURL   string     `json:"mediaURL"`
 
 	CreatedAt time.Time `json:"createdAt"`
+	EditedAt  time.Time `json:"editedAt"`
 	// CreatedBy        string `json:"createdBy"`
 	// CreatorFirstName string `json:"creatorFirstName"`
 	// CreatorLastName  string `json:"creatorLastName"`
@@ -44,12 +54,43 @@ type Template struct {
 	// EditorFirstName  string `json:"editorFirstName"`
 	// EditorLastName   string `json:"editorLastName"`
 	// EditorUserhash   string `json:"editorUserhash"`
-	// EditedAt         string `json:"editedAt"`
 }
 
+// FilterValue is the text a template is fuzzy matched on: its name and
+// description
 func (t Template) FilterValue() string {
-	// return fmt.Sprintf("%s\n%s", t.Name, t.Description)
-	return t.Name
+	return t.Name + " " + t.Description
+}
+
+// languageTags are tags that name the language a template is written in
+var languageTags = []string{"python", "typescript", "javascript", "go", "r", "java"}
+
+// LanguageName returns the template's language, falling back to the first
+// tag naming a language when the catalog does not set one
+func (t Template) LanguageName() string {
+	if t.Language != "" {
+		return t.Language
+	}
+
+	for _, tag := range t.Tags {
+		for _, language := range languageTags {
+			if strings.EqualFold(tag, language) {
+				return tag
+			}
+		}
+	}
+
+	return ""
+}
+
+// LastUpdated returns when the template was last edited, or created if it
+// never was
+func (t Template) LastUpdated() time.Time {
+	if !t.EditedAt.IsZero() {
+		return t.EditedAt
+	}
+
+	return t.CreatedAt
 }
 
 func (t Template) DefaultDir() string {
@@ -67,6 +108,13 @@ type Repository struct {
 	IsPublic bool   `json:"isPublic"`
 }
 
+// TemplateEnvVar is an environment variable a template reads
+type TemplateEnvVar struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required"`
+}
+
 type TemplateList struct {
 	Templates  []Template `json:"data"`
 	Count      int        `json:"count"`
@@ -121,8 +169,23 @@ func (tl TemplateList) SortByName() TemplateList {
 	return tl
 }
 
+// GetTemplates returns the templates in the local templates directory if
+// one is configured, and the remote catalog otherwise
 func GetTemplates() (*TemplateList, error) {
-	url, err := config.GetEndpointURL("/api/v2/applicationTemplates/?limit=100")
+	if dir := LocalTemplatesDir(); dir != "" {
+		templates, err := LoadLocalTemplates(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		return &TemplateList{Templates: templates, Count: len(templates), TotalCount: len(templates)}, nil
+	}
+
+	if apiclient.Offline() {
+		return cachedTemplates()
+	}
+
+	url, err := config.GetAPIURL("/applicationTemplates/?limit=100")
 	if err != nil {
 		return nil, err
 	}
@@ -148,6 +211,26 @@ func GetTemplates() (*TemplateList, error) {
 	return &templateList, nil
 }
 
+// FindTemplate returns the template with the given ID or name, ignoring
+// case. When there is none, the error suggests the closest name.
+func FindTemplate(templates []Template, name string) (Template, error) {
+	names := make([]string, 0, len(templates))
+
+	for _, template := range templates {
+		if template.ID == name || strings.EqualFold(template.Name, name) {
+			return template, nil
+		}
+
+		names = append(names, template.Name)
+	}
+
+	if closest := suggest.Closest(name, names, max(len(name)/3, 2)); closest != "" {
+		return Template{}, fmt.Errorf("Template %q not found. Did you mean %q?", name, closest)
+	}
+
+	return Template{}, fmt.Errorf("Template %q not found. Run 'dr templates list' to see the available templates.", name)
+}
+
 func GetPublicTemplatesSorted() (*TemplateList, error) {
 	templates, err := GetTemplates()
 	if err != nil {
@@ -0,0 +1,42 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindTemplate(t *testing.T) {
+	templates := []Template{{ID: "1", Name: "Talk to My Data"}, {ID: "2", Name: "Guarded RAG"}}
+
+	template, err := FindTemplate(templates, "guarded rag")
+	require.NoError(t, err)
+	assert.Equal(t, "2", template.ID)
+
+	template, err = FindTemplate(templates, "1")
+	require.NoError(t, err)
+	assert.Equal(t, "Talk to My Data", template.Name)
+
+	_, err = FindTemplate(templates, "talk to my dta")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `Did you mean "Talk to My Data"?`)
+
+	_, err = FindTemplate(templates, "forecasting")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "dr templates list")
+}
@@ -29,7 +29,7 @@ func knownVariables(allValues map[string]string) map[string]variableConfig {
 
 	err := config.VerifyToken(datarobotEndpoint, token)
 	if err != nil {
-		datarobotEndpoint, _ = config.GetEndpointURL("/api/v2")
+		datarobotEndpoint, _ = config.GetAPIURL("")
 		token, _ = config.GetAPIKey()
 	}
 
@@ -0,0 +1,149 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package errs defines the error types that map to the CLI's exit codes, so
+// scripts wrapping dr can branch on why a command failed.
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Exit codes. Scripts rely on them, so existing values must not change.
+const (
+	ExitCodeFailure = 1
+	ExitCodeAuth    = 10
+	ExitCodeConfig  = 11
+	ExitCodeNetwork = 12
+)
+
+// coded holds what every typed error has in common: a short message for
+// the user and the underlying cause, which is only shown at debug level
+type coded struct {
+	msg   string
+	cause error
+}
+
+func (e coded) Error() string {
+	if e.msg != "" {
+		return e.msg
+	}
+
+	if e.cause != nil {
+		return e.cause.Error()
+	}
+
+	return "Unknown error."
+}
+
+func (e coded) Unwrap() error {
+	return e.cause
+}
+
+func (e coded) hiddenCause() error {
+	if e.msg == "" {
+		return nil
+	}
+
+	return e.cause
+}
+
+// AuthError reports missing, invalid, or rejected credentials
+type AuthError struct{ coded }
+
+// NewAuthError returns an AuthError with the given message and cause;
+// either may be empty
+func NewAuthError(msg string, cause error) *AuthError {
+	return &AuthError{coded{msg: msg, cause: cause}}
+}
+
+func (*AuthError) ExitCode() int { return ExitCodeAuth }
+
+// ConfigError reports an invalid config file, flag, or setting
+type ConfigError struct{ coded }
+
+// NewConfigError returns a ConfigError with the given message and cause;
+// either may be empty
+func NewConfigError(msg string, cause error) *ConfigError {
+	return &ConfigError{coded{msg: msg, cause: cause}}
+}
+
+func (*ConfigError) ExitCode() int { return ExitCodeConfig }
+
+// NetworkError reports a request that could not be completed, or that
+// the server answered with an unexpected status
+type NetworkError struct{ coded }
+
+// NewNetworkError returns a NetworkError with the given message and cause;
+// either may be empty
+func NewNetworkError(msg string, cause error) *NetworkError {
+	return &NetworkError{coded{msg: msg, cause: cause}}
+}
+
+func (*NetworkError) ExitCode() int { return ExitCodeNetwork }
+
+// ScriptError reports a script, such as the quickstart script, that exited
+// with a non-zero code. The CLI exits with the same code.
+type ScriptError struct {
+	coded
+
+	code int
+}
+
+// NewScriptError returns a ScriptError for a script that exited with code
+func NewScriptError(code int, msg string) *ScriptError {
+	if msg == "" {
+		msg = fmt.Sprintf("Script exited with code %d.", code)
+	}
+
+	return &ScriptError{coded: coded{msg: msg}, code: code}
+}
+
+func (e *ScriptError) ExitCode() int { return e.code }
+
+// ExitCode returns the exit code for err: that of the first typed error in
+// its chain, ExitCodeFailure for any other error, and 0 for nil
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var coder interface{ ExitCode() int }
+	if errors.As(err, &coder) && coder.ExitCode() > 0 {
+		return coder.ExitCode()
+	}
+
+	return ExitCodeFailure
+}
+
+// Detail returns err's message along with the cause a typed error keeps
+// from the user, for debug logs
+func Detail(err error) string {
+	if err == nil {
+		return ""
+	}
+
+	msg := err.Error()
+
+	var typed interface{ hiddenCause() error }
+	if errors.As(err, &typed) {
+		if cause := typed.hiddenCause(); cause != nil && !strings.Contains(msg, cause.Error()) {
+			return msg + " Cause: " + cause.Error()
+		}
+	}
+
+	return msg
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExitCode(t *testing.T) {
+	cause := errors.New("dial tcp: connection refused")
+
+	tests := []struct {
+		name string
+		err  error
+		want int
+	}{
+		{"nil", nil, 0},
+		{"plain", errors.New("Something failed."), ExitCodeFailure},
+		{"auth", NewAuthError("Authentication failed.", nil), ExitCodeAuth},
+		{"config", NewConfigError("", cause), ExitCodeConfig},
+		{"network", NewNetworkError("", cause), ExitCodeNetwork},
+		{"script", NewScriptError(42, ""), 42},
+		{"wrapped", fmt.Errorf("Fetching templates: %w", NewNetworkError("", cause)), ExitCodeNetwork},
+		{"outermost typed error wins", NewAuthError("", NewNetworkError("", cause)), ExitCodeAuth},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ExitCode(tt.err))
+		})
+	}
+}
+
+func TestMessageAndDetail(t *testing.T) {
+	cause := errors.New("dial tcp: connection refused")
+
+	err := NewNetworkError("Could not reach DataRobot.", cause)
+
+	assert.Equal(t, "Could not reach DataRobot.", err.Error())
+	assert.Equal(t, "Could not reach DataRobot. Cause: dial tcp: connection refused", Detail(err))
+	assert.ErrorIs(t, err, cause)
+
+	bare := NewConfigError("", cause)
+
+	assert.Equal(t, cause.Error(), bare.Error())
+	assert.Equal(t, cause.Error(), Detail(bare))
+
+	assert.Equal(t, "Script exited with code 3.", NewScriptError(3, "").Error())
+	assert.Empty(t, Detail(nil))
+}
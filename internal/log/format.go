@@ -0,0 +1,86 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strings"
+
+	"github.com/charmbracelet/log"
+	"github.com/spf13/viper"
+)
+
+// FormatKey is the viper key for the --log-format flag and
+// DATAROBOT_CLI_LOG_FORMAT
+const FormatKey = "log-format"
+
+// Log formats for stderr
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+// stderrJSON replaces the stderr logger when the log format is JSON
+var stderrJSON *slog.Logger
+
+// CheckFormat returns an error if the configured log format is unknown
+func CheckFormat() error {
+	switch format := strings.ToLower(viper.GetString(FormatKey)); format {
+	case "", FormatText, FormatJSON:
+		return nil
+	default:
+		return fmt.Errorf("Invalid log format %q; use %s or %s.", format, FormatText, FormatJSON)
+	}
+}
+
+// JSONFormat reports whether log lines on stderr are JSON objects
+func JSONFormat() bool {
+	return strings.EqualFold(viper.GetString(FormatKey), FormatJSON)
+}
+
+// newJSONLogger returns a logger writing one JSON object per line to w,
+// with the time, level, message, and fields of each entry
+func newJSONLogger(w io.Writer, lvl log.Level) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(w, &slog.HandlerOptions{
+		Level:       slog.Level(lvl),
+		ReplaceAttr: jsonLevelName,
+	}))
+}
+
+// jsonLevelName names the levels slog doesn't know: trace, fatal, and the
+// level of Print, which is always shown
+func jsonLevelName(_ []string, attr slog.Attr) slog.Attr {
+	if attr.Key != slog.LevelKey {
+		return attr
+	}
+
+	switch log.Level(attr.Value.Any().(slog.Level)) {
+	case TraceLevel:
+		return slog.String(slog.LevelKey, "TRACE")
+	case FatalLevel:
+		return slog.String(slog.LevelKey, "FATAL")
+	case noLevel:
+		return slog.String(slog.LevelKey, "INFO")
+	default:
+		return attr
+	}
+}
+
+func logJSON(lvl log.Level, msg interface{}, keyvals ...interface{}) {
+	stderrJSON.Log(context.Background(), slog.Level(lvl), fmt.Sprint(msg), keyvals...)
+}
@@ -0,0 +1,88 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckFormat(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	require.NoError(t, CheckFormat())
+	assert.False(t, JSONFormat())
+
+	viper.Set(FormatKey, "JSON")
+	require.NoError(t, CheckFormat())
+	assert.True(t, JSONFormat())
+
+	viper.Set(FormatKey, "xml")
+	assert.ErrorContains(t, CheckFormat(), `Invalid log format "xml"`)
+}
+
+func TestJSONLogger(t *testing.T) {
+	var buf bytes.Buffer
+
+	saved := stderrJSON
+	stderrJSON = newJSONLogger(&buf, TraceLevel)
+
+	t.Cleanup(func() { stderrJSON = saved })
+
+	logJSON(WarnLevel, "Fetch failed", "url", "https://example.com", "error", errors.New("timed out"))
+	logJSON(TraceLevel, "HTTP request", "status", 200)
+	logJSON(noLevel, "Printed")
+
+	lines := bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n"))
+	require.Len(t, lines, 3)
+
+	var entry map[string]any
+
+	require.NoError(t, json.Unmarshal(lines[0], &entry))
+	assert.Equal(t, "WARN", entry["level"])
+	assert.Equal(t, "Fetch failed", entry["msg"])
+	assert.Equal(t, "https://example.com", entry["url"])
+	assert.Equal(t, "timed out", entry["error"])
+	assert.Contains(t, entry, "time")
+
+	require.NoError(t, json.Unmarshal(lines[1], &entry))
+	assert.Equal(t, "TRACE", entry["level"])
+	assert.InDelta(t, 200, entry["status"], 0)
+
+	require.NoError(t, json.Unmarshal(lines[2], &entry))
+	assert.Equal(t, "INFO", entry["level"])
+}
+
+func TestJSONLoggerHonorsLevel(t *testing.T) {
+	var buf bytes.Buffer
+
+	saved := stderrJSON
+	stderrJSON = newJSONLogger(&buf, WarnLevel)
+
+	t.Cleanup(func() { stderrJSON = saved })
+
+	logJSON(InfoLevel, "Hidden")
+	logJSON(noLevel, "Always shown")
+
+	assert.NotContains(t, buf.String(), "Hidden")
+	assert.Contains(t, buf.String(), "Always shown")
+}
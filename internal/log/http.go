@@ -0,0 +1,56 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"net/http"
+	"time"
+)
+
+// transport logs a one-line summary of every HTTP request at trace level
+type transport struct {
+	base http.RoundTripper
+}
+
+// HTTPTransport wraps base, or http.DefaultTransport if nil, so requests
+// and responses are summarized when -vv is set
+func HTTPTransport(base http.RoundTripper) http.RoundTripper {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	return transport{base: base}
+}
+
+func (t transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if level > TraceLevel {
+		return t.base.RoundTrip(req)
+	}
+
+	start := time.Now()
+
+	resp, err := t.base.RoundTrip(req)
+	if err != nil {
+		Trace("HTTP request failed", "method", req.Method, "url", req.URL.Redacted(),
+			"duration", time.Since(start).Round(time.Millisecond), "error", err)
+
+		return nil, err
+	}
+
+	Trace("HTTP request", "method", req.Method, "url", req.URL.Redacted(), "status", resp.StatusCode,
+		"duration", time.Since(start).Round(time.Millisecond), "bytes", resp.ContentLength)
+
+	return resp, nil
+}
@@ -17,10 +17,13 @@ package log
 import (
 	"fmt"
 	"io"
+	"log/slog"
 	"os"
 	"path/filepath"
+	"strings"
 
 	"github.com/charmbracelet/log"
+	"github.com/muesli/termenv"
 	"github.com/spf13/viper"
 )
 
@@ -34,6 +37,8 @@ var logStyles *log.Styles
 
 func init() {
 	logStyles = log.DefaultStyles()
+	logStyles.Levels[TraceLevel] = logStyles.Levels[log.DebugLevel].SetString("TRACE")
+
 	for l, style := range logStyles.Levels {
 		logStyles.Levels[l] = style.MaxWidth(logLevelWidth).PaddingRight(1)
 	}
@@ -46,37 +51,94 @@ var (
 	fileLogger   *log.Logger
 )
 
-// Start sets up and starts both stderr and file loggers
+// LevelKey is the viper key for the DATAROBOT_CLI_LOG_LEVEL setting
+const LevelKey = "log-level"
+
+// Start sets up and starts both stderr and file loggers, and routes the
+// default slog logger through them
 func Start() {
-	// Debug takes precedence
-	if viper.GetBool("debug") {
-		level = log.DebugLevel
-	} else if viper.GetBool("verbose") {
-		level = log.InfoLevel
-	} else {
-		level = log.Default().GetLevel()
-	}
+	level = resolveLevel()
 
 	StartStderr()
 	StartFile()
+
+	slog.SetDefault(slog.New(handler{}))
+}
+
+// resolveLevel maps the logging flags to a level. Flags take precedence
+// over DATAROBOT_CLI_LOG_LEVEL: --quiet logs errors only, -v logs debug
+// and -vv adds trace output with HTTP request summaries.
+func resolveLevel() log.Level {
+	verbosity := viper.GetInt("verbose")
+
+	switch {
+	case viper.GetBool("quiet"):
+		return ErrorLevel
+	case verbosity >= 2:
+		return TraceLevel
+	case verbosity == 1, viper.GetBool("debug"):
+		return DebugLevel
+	}
+
+	if name := viper.GetString(LevelKey); name != "" {
+		parsed, err := ParseLevel(name)
+		if err == nil {
+			return parsed
+		}
+
+		fmt.Fprintf(os.Stderr, "Ignoring invalid log level %q.\n", name)
+	}
+
+	return InfoLevel
+}
+
+// ParseLevel converts a level name such as "warn" or "trace" to a level
+func ParseLevel(name string) (log.Level, error) {
+	if strings.EqualFold(name, "trace") {
+		return TraceLevel, nil
+	}
+
+	return log.ParseLevel(strings.ToLower(name))
 }
 
-// Stop stops both stderr and file loggers
+// IsQuiet reports whether only errors are being logged, in which case
+// progress output should be suppressed as well
+func IsQuiet() bool {
+	return level >= ErrorLevel
+}
+
+// Stop stops the stderr and file loggers and closes the transcript
 func Stop() {
+	StopTranscript()
 	StopFile()
 	StopStderr()
 }
 
 // StartStderr starts stderr logger. Useful when running bubbletea TUI models.
+// With the JSON log format, entries are written by a slog JSON handler.
 func StartStderr() {
+	if JSONFormat() {
+		stderrLogger = nil
+		stderrJSON = newJSONLogger(os.Stderr, level)
+
+		return
+	}
+
+	stderrJSON = nil
 	stderrLogger = log.New(os.Stderr)
 	stderrLogger.SetStyles(logStyles)
 	stderrLogger.SetLevel(level)
+
+	// NO_COLOR and a non-terminal stderr are detected by the logger itself
+	if viper.GetBool("no-color") {
+		stderrLogger.SetColorProfile(termenv.Ascii)
+	}
 }
 
 // StopStderr stops stderr logger. Useful when running bubbletea TUI models.
 func StopStderr() {
 	stderrLogger = nil
+	stderrJSON = nil
 }
 
 // StartFile starts file logger.
@@ -84,7 +146,7 @@ func StartFile() {
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
 		homeDir = os.TempDir()
-		fmt.Println("Cannot get home directory, creating log file in", homeDir, "instead.")
+		fmt.Fprintln(os.Stderr, "Cannot get home directory, creating log file in", homeDir, "instead.")
 	}
 
 	logFile := filepath.Join(homeDir, logFileName)
@@ -112,9 +174,17 @@ func Log(level log.Level, msg interface{}, keyvals ...interface{}) {
 		stderrLogger.Log(level, msg, keyvals...)
 	}
 
+	if stderrJSON != nil {
+		logJSON(level, msg, keyvals...)
+	}
+
 	if fileLogger != nil {
 		fileLogger.Log(level, msg, keyvals...)
 	}
+
+	if transcriptLogger != nil {
+		transcriptLogger.Log(level, msg, keyvals...)
+	}
 }
 
 func Logf(level log.Level, format string, args ...interface{}) {
@@ -122,7 +192,15 @@ func Logf(level log.Level, format string, args ...interface{}) {
 		stderrLogger.Logf(level, format, args...)
 	}
 
+	if stderrJSON != nil {
+		logJSON(level, fmt.Sprintf(format, args...))
+	}
+
 	if fileLogger != nil {
 		fileLogger.Logf(level, format, args...)
 	}
+
+	if transcriptLogger != nil {
+		transcriptLogger.Logf(level, format, args...)
+	}
 }
@@ -0,0 +1,54 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/log"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResolveLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		settings map[string]any
+		want     log.Level
+	}{
+		{name: "default", settings: nil, want: InfoLevel},
+		{name: "quiet", settings: map[string]any{"quiet": true}, want: ErrorLevel},
+		{name: "verbose", settings: map[string]any{"verbose": 1}, want: DebugLevel},
+		{name: "very verbose", settings: map[string]any{"verbose": 2}, want: TraceLevel},
+		{name: "debug", settings: map[string]any{"debug": true}, want: DebugLevel},
+		{name: "env level", settings: map[string]any{LevelKey: "warn"}, want: WarnLevel},
+		{name: "env trace", settings: map[string]any{LevelKey: "TRACE"}, want: TraceLevel},
+		{name: "flag wins over env", settings: map[string]any{LevelKey: "debug", "quiet": true}, want: ErrorLevel},
+		{name: "invalid env", settings: map[string]any{LevelKey: "loud"}, want: InfoLevel},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			viper.Reset()
+			t.Cleanup(viper.Reset)
+
+			for key, value := range tt.settings {
+				viper.Set(key, value)
+			}
+
+			assert.Equal(t, tt.want, resolveLevel())
+		})
+	}
+}
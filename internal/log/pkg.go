@@ -23,6 +23,9 @@ import (
 )
 
 const (
+	// TraceLevel is below debug and adds HTTP request/response summaries.
+	// It matches the numeric spacing of the slog levels.
+	TraceLevel = log.DebugLevel - 4
 	DebugLevel = log.DebugLevel
 	InfoLevel  = log.InfoLevel
 	WarnLevel  = log.WarnLevel
@@ -35,6 +38,10 @@ func GetLevel() log.Level {
 	return level
 }
 
+func Trace(msg interface{}, keyvals ...interface{}) {
+	Log(TraceLevel, msg, keyvals...)
+}
+
 func Debug(msg interface{}, keyvals ...interface{}) {
 	Log(DebugLevel, msg, keyvals...)
 }
@@ -0,0 +1,130 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// RedactPatternsKey lists regular expressions for secrets to mask in
+	// the transcript and in script output, on top of the built-in ones
+	RedactPatternsKey = "redact.patterns"
+	// RedactBuiltinPatternsKey turns the built-in patterns off when false
+	RedactBuiltinPatternsKey = "redact.builtin-patterns"
+)
+
+// builtinRedactPatterns match common secret formats. Where a pattern has a
+// group, only the group is masked, so the text around it still shows what
+// was redacted.
+var builtinRedactPatterns = []string{
+	// Authorization headers
+	`(?i)\bbearer\s+([A-Za-z0-9._~+/-]{8,}=*)`,
+	// Assignments such as api_key=..., "token": "...", or PASSWORD: ...
+	`(?i)\b(?:api[_-]?key|api[_-]?token|access[_-]?token|token|secret|password)["']?\s*[:=]\s*["']?([^\s"',]{8,})`,
+	// JSON web tokens
+	`\beyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+`,
+	// AWS access key IDs, and GitHub and OpenAI style keys
+	`\bAKIA[0-9A-Z]{16}\b`,
+	`\bgh[pousr]_[A-Za-z0-9]{36,}\b`,
+	`\bsk-[A-Za-z0-9_-]{20,}\b`,
+}
+
+var redactPatterns = mustCompilePatterns(builtinRedactPatterns)
+
+func mustCompilePatterns(patterns []string) []*regexp.Regexp {
+	compiled, err := compilePatterns(patterns)
+	if err != nil {
+		panic(err)
+	}
+
+	return compiled
+}
+
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid redact pattern %q: %w", pattern, err)
+		}
+
+		compiled = append(compiled, re)
+	}
+
+	return compiled, nil
+}
+
+// SetRedactPatterns replaces the patterns Redact masks with the configured
+// ones, added to the built-in patterns unless builtin is false
+func SetRedactPatterns(patterns []string, builtin bool) error {
+	if builtin {
+		patterns = append(append([]string{}, builtinRedactPatterns...), patterns...)
+	}
+
+	compiled, err := compilePatterns(patterns)
+	if err != nil {
+		return err
+	}
+
+	secretsMu.Lock()
+	defer secretsMu.Unlock()
+
+	redactPatterns = compiled
+
+	return nil
+}
+
+// redactMatches masks every match of the patterns in s. The caller holds
+// secretsMu.
+func redactMatches(s string) string {
+	for _, re := range redactPatterns {
+		s = redactMatch(re, s)
+	}
+
+	return s
+}
+
+// redactMatch masks each match of re in s, or only its first group when
+// the pattern has one
+func redactMatch(re *regexp.Regexp, s string) string {
+	matches := re.FindAllStringSubmatchIndex(s, -1)
+	if matches == nil {
+		return s
+	}
+
+	var sb strings.Builder
+
+	last := 0
+
+	for _, m := range matches {
+		start, end := m[0], m[1]
+		if len(m) >= 4 && m[2] >= 0 {
+			start, end = m[2], m[3]
+		}
+
+		sb.WriteString(s[last:start])
+		sb.WriteString(redactedValue)
+
+		last = end
+	}
+
+	sb.WriteString(s[last:])
+
+	return sb.String()
+}
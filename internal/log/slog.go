@@ -0,0 +1,62 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/charmbracelet/log"
+)
+
+// handler is a slog.Handler that forwards records to the stderr and file
+// loggers and the transcript, so code using log/slog honors --quiet and --verbose. The slog
+// and charmbracelet levels share the same numeric values.
+type handler struct {
+	attrs []slog.Attr
+}
+
+var _ slog.Handler = handler{}
+
+func (h handler) Enabled(_ context.Context, l slog.Level) bool {
+	return log.Level(l) >= enabledLevel()
+}
+
+func (h handler) Handle(_ context.Context, r slog.Record) error {
+	keyvals := make([]interface{}, 0, 2*(len(h.attrs)+r.NumAttrs()))
+
+	for _, attr := range h.attrs {
+		keyvals = append(keyvals, attr.Key, attr.Value.Any())
+	}
+
+	r.Attrs(func(attr slog.Attr) bool {
+		keyvals = append(keyvals, attr.Key, attr.Value.Any())
+
+		return true
+	})
+
+	Log(log.Level(r.Level), r.Message, keyvals...)
+
+	return nil
+}
+
+func (h handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return handler{attrs: append(append([]slog.Attr{}, h.attrs...), attrs...)}
+}
+
+// WithGroup is not supported; attributes are logged without a prefix
+func (h handler) WithGroup(_ string) slog.Handler {
+	return h
+}
@@ -0,0 +1,302 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/charmbracelet/x/ansi"
+)
+
+const (
+	// FileKey is the viper key for --log-file, a transcript of the session
+	FileKey = "log-file"
+	// FileAppendKey is the viper key for --log-file-append
+	FileAppendKey = "log-file-append"
+
+	// redactedValue replaces secrets in the transcript
+	redactedValue = "****"
+	// minSecretLength keeps short values from redacting unrelated text
+	minSecretLength = 8
+	// partialLineDelay is how long RedactLines holds back a line without
+	// a newline, such as a prompt, before passing it on
+	partialLineDelay = 100 * time.Millisecond
+)
+
+var (
+	transcript       *redactingWriter
+	transcriptLogger *log.Logger
+
+	secretsMu sync.RWMutex
+	secrets   []string
+)
+
+// redactingWriter replaces registered secrets before writing to w. Each
+// Write is expected to hold whole log entries, so a secret is never split
+// across calls.
+type redactingWriter struct {
+	mu sync.Mutex
+	w  io.WriteCloser
+}
+
+func (r *redactingWriter) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, err := io.WriteString(r.w, Redact(string(p))); err != nil {
+		return 0, err
+	}
+
+	return len(p), nil
+}
+
+// StartTranscript writes a transcript of the session to path: log output
+// at debug level or below, and any output registered with OutputWriter,
+// each line with a timestamp. The file is truncated unless appendTo is
+// set. An empty path disables the transcript.
+func StartTranscript(path string, appendTo bool) error {
+	if path == "" {
+		return nil
+	}
+
+	flags := os.O_WRONLY | os.O_CREATE | os.O_TRUNC
+	if appendTo {
+		flags = os.O_WRONLY | os.O_CREATE | os.O_APPEND
+	}
+
+	file, err := os.OpenFile(path, flags, 0o600)
+	if err != nil {
+		return fmt.Errorf("Failed to open log file: %w", err)
+	}
+
+	transcript = &redactingWriter{w: file}
+
+	transcriptLogger = log.NewWithOptions(transcript, log.Options{ReportTimestamp: true, TimeFormat: time.RFC3339})
+	transcriptLogger.SetStyles(logStyles)
+	transcriptLogger.SetLevel(transcriptLevel())
+
+	transcriptLogger.Info("Session started", "args", strings.Join(os.Args, " "))
+
+	return nil
+}
+
+// StopTranscript closes the transcript, if one was started
+func StopTranscript() {
+	if transcript == nil {
+		return
+	}
+
+	transcriptLogger.Info("Session ended")
+
+	_ = transcript.w.Close()
+
+	transcript = nil
+	transcriptLogger = nil
+}
+
+// transcriptLevel is debug, or trace when that was requested, so the
+// transcript is complete regardless of what is shown on screen
+func transcriptLevel() log.Level {
+	return min(level, DebugLevel)
+}
+
+// enabledLevel is the lowest level any logger records
+func enabledLevel() log.Level {
+	if transcriptLogger != nil {
+		return min(level, transcriptLevel())
+	}
+
+	return level
+}
+
+// AddSecret registers a value, such as an API token, that must not appear
+// in the transcript
+func AddSecret(secret string) {
+	if len(secret) < minSecretLength {
+		return
+	}
+
+	secretsMu.Lock()
+	defer secretsMu.Unlock()
+
+	for _, existing := range secrets {
+		if existing == secret {
+			return
+		}
+	}
+
+	secrets = append(secrets, secret)
+}
+
+// Redact replaces every registered secret in s, and anything matching the
+// redact patterns
+func Redact(s string) string {
+	secretsMu.RLock()
+	defer secretsMu.RUnlock()
+
+	for _, secret := range secrets {
+		s = strings.ReplaceAll(s, secret, redactedValue)
+	}
+
+	return redactMatches(s)
+}
+
+// lineRedactor passes what is written to it on to w one line at a time,
+// with secrets masked
+type lineRedactor struct {
+	mu      sync.Mutex
+	w       io.Writer
+	partial []byte
+	flush   *time.Timer
+}
+
+// RedactLines returns a writer that masks secrets with Redact in the
+// output of a child process before passing it on to w. Output is passed on
+// in whole lines, so a secret split across writes is still caught. A line
+// without a newline, such as a prompt waiting for input, is passed on once
+// it has been held for partialLineDelay. Close the writer once the process
+// exits to pass on what is left.
+func RedactLines(w io.Writer) io.WriteCloser {
+	return &lineRedactor{w: w}
+}
+
+func (r *lineRedactor) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.partial = append(r.partial, p...)
+
+	for {
+		i := bytes.IndexByte(r.partial, '\n')
+		if i < 0 {
+			break
+		}
+
+		line := Redact(string(r.partial[:i+1]))
+		r.partial = r.partial[i+1:]
+
+		if _, err := io.WriteString(r.w, line); err != nil {
+			return 0, err
+		}
+	}
+
+	// The delay counts from the first byte held back, so output that
+	// keeps redrawing a line, like a progress bar, is still shown
+	if len(r.partial) > 0 && r.flush == nil {
+		r.flush = time.AfterFunc(partialLineDelay, func() {
+			r.mu.Lock()
+			defer r.mu.Unlock()
+
+			r.flush = nil
+			_ = r.writePartial()
+		})
+	}
+
+	return len(p), nil
+}
+
+func (r *lineRedactor) Close() error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.flush != nil {
+		r.flush.Stop()
+		r.flush = nil
+	}
+
+	return r.writePartial()
+}
+
+func (r *lineRedactor) writePartial() error {
+	if len(r.partial) == 0 {
+		return nil
+	}
+
+	_, err := io.WriteString(r.w, Redact(string(r.partial)))
+	r.partial = nil
+
+	return err
+}
+
+// outputWriter records each line written to it in the transcript
+type outputWriter struct {
+	source  string
+	partial []byte
+}
+
+// OutputWriter returns a writer that records each line of a child
+// process's output in the transcript, or io.Discard when there is no
+// transcript. Close it once the process exits to record a final line
+// without a newline.
+func OutputWriter(source string) io.WriteCloser {
+	if transcript == nil {
+		return nopCloser{io.Discard}
+	}
+
+	return &outputWriter{source: source}
+}
+
+func (o *outputWriter) Write(p []byte) (int, error) {
+	o.partial = append(o.partial, p...)
+
+	for {
+		i := bytes.IndexByte(o.partial, '\n')
+		if i < 0 {
+			break
+		}
+
+		o.writeLine(o.partial[:i])
+		o.partial = o.partial[i+1:]
+	}
+
+	return len(p), nil
+}
+
+func (o *outputWriter) Close() error {
+	if len(o.partial) > 0 {
+		o.writeLine(o.partial)
+		o.partial = nil
+	}
+
+	return nil
+}
+
+func (o *outputWriter) writeLine(line []byte) {
+	w := transcript
+	if w == nil {
+		return
+	}
+
+	// Like a terminal, keep only what the last carriage return left visible
+	text := strings.TrimRight(string(line), "\r")
+	if i := strings.LastIndexByte(text, '\r'); i >= 0 {
+		text = text[i+1:]
+	}
+
+	text = ansi.Strip(text)
+
+	fmt.Fprintf(w, "%s %s | %s\n", time.Now().Format(time.RFC3339), o.source, text)
+}
+
+type nopCloser struct{ io.Writer }
+
+func (nopCloser) Close() error { return nil }
@@ -0,0 +1,168 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func startTestTranscript(t *testing.T, path string, appendTo bool) {
+	t.Helper()
+
+	previous := level
+	level = InfoLevel
+
+	t.Cleanup(func() {
+		StopTranscript()
+
+		level = previous
+		secrets = nil
+	})
+
+	require.NoError(t, StartTranscript(path, appendTo))
+}
+
+func readTranscript(t *testing.T, path string) string {
+	t.Helper()
+
+	StopTranscript()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	return string(data)
+}
+
+func TestTranscriptRecordsLogsAndOutput(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.log")
+	startTestTranscript(t, path, false)
+
+	AddSecret("secret-token-value")
+
+	Debug("start: execute step", "idx", 0)
+	Info("using token", "token", "secret-token-value")
+
+	output := OutputWriter("script")
+	fmt.Fprint(output, "\x1b[32minstalling\x1b[0m\nprogress 10%\rprogress 100%\r\nprinted secret-token-value\nno newline")
+	require.NoError(t, output.Close())
+
+	transcript := readTranscript(t, path)
+
+	assert.Contains(t, transcript, "Session started")
+	assert.Contains(t, transcript, "start: execute step idx=0")
+	assert.Regexp(t, `\d{4}-\d{2}-\d{2}T\d{2}:\d{2}:\d{2}\S* script \| installing\n`, transcript)
+	assert.Contains(t, transcript, "script | progress 100%\n")
+	assert.NotContains(t, transcript, "progress 10%")
+	assert.Contains(t, transcript, "script | printed ****\n")
+	assert.Contains(t, transcript, "script | no newline\n")
+	assert.Contains(t, transcript, "token=****")
+	assert.NotContains(t, transcript, "secret-token-value")
+	assert.Contains(t, transcript, "Session ended")
+}
+
+func TestTranscriptTruncatesUnlessAppending(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "session.log")
+	require.NoError(t, os.WriteFile(path, []byte("previous session\n"), 0o600))
+
+	startTestTranscript(t, path, true)
+	assert.Contains(t, readTranscript(t, path), "previous session")
+
+	startTestTranscript(t, path, false)
+	assert.NotContains(t, readTranscript(t, path), "previous session")
+}
+
+func TestOutputWriterWithoutTranscript(t *testing.T) {
+	output := OutputWriter("script")
+
+	n, err := output.Write([]byte("ignored\n"))
+	require.NoError(t, err)
+	assert.Equal(t, 8, n)
+	require.NoError(t, output.Close())
+}
+
+func TestAddSecretIgnoresShortValues(t *testing.T) {
+	t.Cleanup(func() { secrets = nil })
+
+	AddSecret("")
+	AddSecret("abc")
+
+	assert.Equal(t, "abc def", Redact("abc def"))
+}
+
+// syncBuffer is a bytes.Buffer that RedactLines may write to from its
+// flush timer while the test reads it
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.buf.String()
+}
+
+func TestRedactLines(t *testing.T) {
+	t.Cleanup(func() { secrets = nil })
+
+	AddSecret("secret-token-value")
+
+	var out syncBuffer
+
+	w := RedactLines(&out)
+	fmt.Fprint(w, "token is secret-")
+	fmt.Fprint(w, "token-value\nprogress 10%\rprogress 100%\r\nno newline secret-token-value")
+
+	assert.Equal(t, "token is ****\nprogress 10%\rprogress 100%\r\n", out.String(), "an unterminated line is held back")
+
+	require.NoError(t, w.Close())
+	assert.Equal(t, "token is ****\nprogress 10%\rprogress 100%\r\nno newline ****", out.String())
+}
+
+func TestRedactLinesPassesOnPrompt(t *testing.T) {
+	t.Cleanup(func() { secrets = nil })
+
+	AddSecret("secret-token-value")
+
+	var out syncBuffer
+
+	w := RedactLines(&out)
+	defer w.Close()
+
+	fmt.Fprint(w, "token secret-token-value? ")
+
+	assert.Eventually(t, func() bool { return out.String() == "token ****? " }, time.Second, 10*time.Millisecond)
+
+	fmt.Fprint(w, "yes\n")
+
+	assert.Equal(t, "token ****? yes\n", out.String())
+}
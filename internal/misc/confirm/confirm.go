@@ -0,0 +1,85 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confirm
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/datarobot/cli/internal/misc/reader"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+)
+
+// AssumeYesKey answers every confirmation prompt with yes. It is set by the
+// global --assume-yes (-y) flag or DATAROBOT_CLI_ASSUME_YES.
+const AssumeYesKey = "assume-yes"
+
+// isInteractive reports whether there is a terminal to answer prompts on
+var isInteractive = func() bool {
+	return term.IsTerminal(int(os.Stdin.Fd()))
+}
+
+// AssumeYes reports whether prompts are answered with yes without asking
+func AssumeYes() bool {
+	return viper.GetBool(AssumeYesKey)
+}
+
+// Interactive reports whether prompts can be answered: stdin is a terminal
+func Interactive() bool {
+	return isInteractive()
+}
+
+// Check returns an error if question would have to be asked but can't be,
+// because --assume-yes isn't set and there is no terminal to answer on.
+// Commands that show prompts in a TUI use it before waiting for an answer.
+func Check(question string) error {
+	if AssumeYes() || Interactive() {
+		return nil
+	}
+
+	return NotInteractiveError(question)
+}
+
+// NotInteractiveError explains that question could not be answered
+func NotInteractiveError(question string) error {
+	return fmt.Errorf("Cannot ask %q without a terminal. Rerun with --assume-yes (-y) to confirm.", strings.TrimSpace(question))
+}
+
+// Ask asks question on the terminal and reports whether the answer was yes.
+// With --assume-yes the answer is yes without asking; without a terminal
+// it fails rather than waiting for input that will never come.
+func Ask(question string) (bool, error) {
+	if AssumeYes() {
+		return true, nil
+	}
+
+	if !Interactive() {
+		return false, NotInteractiveError(question)
+	}
+
+	fmt.Fprintf(printer.Diagnostics, "%s [y/N]: ", question)
+
+	response, err := reader.ReadString()
+	if err != nil {
+		return false, fmt.Errorf("Failed to read input: %w", err)
+	}
+
+	response = strings.ToLower(strings.TrimSpace(response))
+
+	return response == "y" || response == "yes", nil
+}
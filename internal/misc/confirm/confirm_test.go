@@ -0,0 +1,67 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package confirm
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setInteractive(t *testing.T, interactive bool) {
+	t.Helper()
+
+	saved := isInteractive
+	isInteractive = func() bool { return interactive }
+
+	t.Cleanup(func() { isInteractive = saved })
+}
+
+func TestAskAssumeYes(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	setInteractive(t, false)
+
+	viper.Set(AssumeYesKey, true)
+
+	confirmed, err := Ask("Replace everything?")
+	require.NoError(t, err)
+	assert.True(t, confirmed)
+	assert.NoError(t, Check("Replace everything?"))
+}
+
+func TestAskWithoutTerminal(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	setInteractive(t, false)
+
+	confirmed, err := Ask("Replace everything?")
+	require.Error(t, err)
+	assert.False(t, confirmed)
+	assert.Contains(t, err.Error(), `"Replace everything?"`)
+	assert.Contains(t, err.Error(), "--assume-yes")
+
+	assert.Error(t, Check("Replace everything?"))
+}
+
+func TestCheckWithTerminal(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+	setInteractive(t, true)
+
+	assert.NoError(t, Check("Replace everything?"))
+}
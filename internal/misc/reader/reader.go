@@ -50,7 +50,7 @@ func ReadString() (string, error) {
 
 	str, err := reader.ReadString('\n')
 	if err != nil {
-		fmt.Println()
+		fmt.Fprintln(os.Stderr)
 	}
 
 	return str, err
@@ -0,0 +1,125 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package since parses the --since flag of list commands: a point in time
+// given as an RFC3339 timestamp, or as a duration back from now.
+package since
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	day  = 24 * time.Hour
+	week = 7 * day
+
+	maxDuration = time.Duration(1<<63 - 1)
+)
+
+// units are the duration units ParseDuration accepts, longest suffix
+// first within each letter so "ms" is not read as "m"
+var units = []struct {
+	suffix string
+	size   time.Duration
+}{
+	{"w", week},
+	{"d", day},
+	{"h", time.Hour},
+	{"ms", time.Millisecond},
+	{"m", time.Minute},
+	{"s", time.Second},
+}
+
+// AddFlag adds --since to cmd, for a list of things such as "projects"
+func AddFlag(cmd *cobra.Command, value *string, things string) {
+	cmd.Flags().StringVar(value, "since", "",
+		"Only show "+things+" created at or after this time: an RFC3339 timestamp, or a duration back from now such as 7d, 2w, 24h, or 30m")
+}
+
+// Parse returns the time value stands for: an RFC3339 timestamp such as
+// 2025-06-01T00:00:00Z, or a duration such as 7d before now. An empty
+// value, for no --since, is the zero time.
+func Parse(value string, now time.Time) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	d, err := ParseDuration(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Invalid --since %q. %s Use an RFC3339 time such as 2025-06-01T00:00:00Z, or a duration such as 7d, 24h, or 30m.", value, err)
+	}
+
+	return now.Add(-d), nil
+}
+
+// ParseDuration is time.ParseDuration with units for days (d) and weeks
+// (w), such as 2w, 1d12h, or 90m. Days are always 24 hours. Negative and
+// fractional amounts are not accepted.
+func ParseDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, errors.New("The duration is empty.")
+	}
+
+	var total time.Duration
+
+	for rest := s; rest != ""; {
+		digits := len(rest) - len(strings.TrimLeft(rest, "0123456789"))
+		if digits == 0 {
+			return 0, fmt.Errorf("Expected a number at %q.", rest)
+		}
+
+		n, err := strconv.ParseInt(rest[:digits], 10, 64)
+		if err != nil {
+			return 0, fmt.Errorf("The number %s is too large.", rest[:digits])
+		}
+
+		rest = rest[digits:]
+
+		size, suffix, ok := unit(rest)
+		if !ok {
+			return 0, fmt.Errorf("%q needs a unit (w, d, h, m, s, or ms).", s[:len(s)-len(rest)])
+		}
+
+		if n > int64(maxDuration/size) || total > maxDuration-time.Duration(n)*size {
+			return 0, errors.New("The duration is too long.")
+		}
+
+		total += time.Duration(n) * size
+		rest = rest[len(suffix):]
+	}
+
+	return total, nil
+}
+
+// unit returns the unit s starts with
+func unit(s string) (time.Duration, string, bool) {
+	for _, u := range units {
+		if strings.HasPrefix(s, u.suffix) {
+			return u.size, u.suffix, true
+		}
+	}
+
+	return 0, "", false
+}
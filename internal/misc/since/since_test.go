@@ -0,0 +1,94 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package since
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		in   string
+		want time.Duration
+	}{
+		{"30m", 30 * time.Minute},
+		{"24h", 24 * time.Hour},
+		{"7d", 7 * 24 * time.Hour},
+		{"2w", 14 * 24 * time.Hour},
+		{"1d12h", 36 * time.Hour},
+		{"1h30m15s", time.Hour + 30*time.Minute + 15*time.Second},
+		{"500ms", 500 * time.Millisecond},
+		{"0d", 0},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseDuration(tt.in)
+		require.NoError(t, err, tt.in)
+		assert.Equal(t, tt.want, got, tt.in)
+	}
+}
+
+func TestParseDurationErrors(t *testing.T) {
+	tests := []struct {
+		in      string
+		wantErr string
+	}{
+		{"", "The duration is empty."},
+		{"7", `"7" needs a unit (w, d, h, m, s, or ms).`},
+		{"7y", `"7" needs a unit (w, d, h, m, s, or ms).`},
+		{"1d2", `"1d2" needs a unit (w, d, h, m, s, or ms).`},
+		{"d", `Expected a number at "d".`},
+		{"-7d", `Expected a number at "-7d".`},
+		{"1.5d", `"1" needs a unit (w, d, h, m, s, or ms).`},
+		{"99999999999999999999d", "The number 99999999999999999999 is too large."},
+		{"20000w", "The duration is too long."},
+	}
+
+	for _, tt := range tests {
+		_, err := ParseDuration(tt.in)
+		assert.EqualError(t, err, tt.wantErr, tt.in)
+	}
+}
+
+func TestParse(t *testing.T) {
+	now := time.Date(2025, 6, 8, 12, 0, 0, 0, time.UTC)
+
+	got, err := Parse("7d", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC), got)
+
+	got, err = Parse(" 30m ", now)
+	require.NoError(t, err)
+	assert.Equal(t, now.Add(-30*time.Minute), got)
+
+	got, err = Parse("", now)
+	require.NoError(t, err)
+	assert.True(t, got.IsZero())
+
+	got, err = Parse("2025-06-01T08:00:00+02:00", now)
+	require.NoError(t, err)
+	assert.True(t, got.Equal(time.Date(2025, 6, 1, 6, 0, 0, 0, time.UTC)))
+}
+
+func TestParseInvalid(t *testing.T) {
+	_, err := Parse("2025-06-01", time.Now())
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `Invalid --since "2025-06-01".`)
+	assert.Contains(t, err.Error(), "Use an RFC3339 time such as 2025-06-01T00:00:00Z, or a duration such as 7d, 24h, or 30m.")
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -92,6 +93,39 @@ func discoverPlugins() ([]DiscoveredPlugin, error) {
 	return plugins, nil
 }
 
+// Lookup finds the plugin for `dr <name>` without running full discovery,
+// searching the same places in the same order: the managed plugins
+// directory, the project-local directory, then dr-<name> on PATH. It is
+// used when name isn't a registered command, for example because discovery
+// timed out, and returns nil when there is no such plugin.
+func Lookup(name string) (*DiscoveredPlugin, error) {
+	if name == "" || strings.HasPrefix(name, "-") || strings.ContainsAny(name, `/\`) {
+		return nil, nil
+	}
+
+	if managedDir, err := ManagedPluginsDir(); err == nil {
+		plugin, err := loadManagedPlugin(managedDir, name, make(map[string]bool))
+		if err != nil {
+			log.Debug("Managed plugin failed to load", "name", name, "error", err)
+		}
+
+		if plugin != nil {
+			return plugin, nil
+		}
+	}
+
+	executable, err := exec.LookPath(filepath.Join(repo.LocalPluginDir, PluginPrefix+name))
+	if err != nil {
+		executable, err = exec.LookPath(PluginPrefix + name)
+	}
+
+	if err != nil {
+		return nil, nil
+	}
+
+	return loadExecutablePlugin(executable)
+}
+
 // discoverManagedPlugins discovers plugins installed via `dr plugin install`
 // These are in subdirectories with a manifest.json and platform-specific scripts
 func discoverManagedPlugins(dir string, seen map[string]bool) ([]DiscoveredPlugin, []error) {
@@ -208,7 +242,7 @@ func errMissingManifestField(field string) error {
 func discoverInDir(dir string, seen map[string]bool) ([]DiscoveredPlugin, []error) {
 	plugins := make([]DiscoveredPlugin, 0)
 
-	var errors []error
+	var errs []error
 
 	// Check if directory exists
 	info, err := os.Stat(dir)
@@ -238,34 +272,71 @@ func discoverInDir(dir string, seen map[string]bool) ([]DiscoveredPlugin, []erro
 			continue
 		}
 
-		// Try to get manifest
-		manifest, err := getManifest(fullPath)
+		plugin, err := loadExecutablePlugin(fullPath)
 		if err != nil {
-			errors = append(errors, err)
+			errs = append(errs, err)
 
 			continue
 		}
 
 		// Deduplicate on manifest.Name (the actual command name)
-		if seen[manifest.Name] {
+		if seen[plugin.Manifest.Name] {
 			log.Warn("Plugin name already registered, skipping",
-				"name", manifest.Name,
+				"name", plugin.Manifest.Name,
 				"path", fullPath)
 
 			continue
 		}
 
-		seen[manifest.Name] = true
+		seen[plugin.Manifest.Name] = true
 
-		plugins = append(plugins, DiscoveredPlugin{
-			Manifest:   *manifest,
-			Executable: fullPath,
-		})
+		plugins = append(plugins, *plugin)
 	}
 
-	return plugins, errors
+	return plugins, errs
+}
+
+// loadExecutablePlugin asks a dr-* executable for its manifest. One that
+// doesn't answer --dr-plugin-manifest is still a plugin, named after its
+// file, the way git and kubectl treat git-* and kubectl-* executables.
+func loadExecutablePlugin(executable string) (*DiscoveredPlugin, error) {
+	manifest, err := getManifest(executable)
+	if errors.Is(err, errNoManifest) {
+		log.Debug("Plugin has no manifest", "path", executable, "error", err)
+
+		return &DiscoveredPlugin{
+			Manifest:   PluginManifest{BasicPluginManifest: BasicPluginManifest{Name: barePluginName(executable)}},
+			Executable: executable,
+			Bare:       true,
+		}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &DiscoveredPlugin{
+		Manifest:   *manifest,
+		Executable: executable,
+	}, nil
+}
+
+// barePluginName is the command name for a plugin without a manifest: its
+// file name without the dr- prefix, and on Windows without its extension
+func barePluginName(executable string) string {
+	name := strings.TrimPrefix(filepath.Base(executable), PluginPrefix)
+
+	if runtime.GOOS == "windows" {
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+
+	return name
 }
 
+// errNoManifest is returned by getManifest when the executable fails when
+// asked for its manifest, rather than returning an unusable one
+var errNoManifest = errors.New("plugin did not report a manifest")
+
 func getManifest(executable string) (*PluginManifest, error) {
 	// Default timeout if not configured
 	timeout := 500 * time.Millisecond
@@ -280,8 +351,7 @@ func getManifest(executable string) (*PluginManifest, error) {
 
 	output, err := cmd.Output()
 	if err != nil {
-		// TODO: Wrap error with executable path for better debugging context
-		return nil, err
+		return nil, fmt.Errorf("%w: %s: %w", errNoManifest, executable, err)
 	}
 
 	var manifest PluginManifest
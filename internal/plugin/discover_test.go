@@ -18,9 +18,12 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"testing"
 
+	"github.com/datarobot/cli/internal/testutil"
 	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -50,6 +53,25 @@ fi
 	return path
 }
 
+// createBarePlugin creates a shell script that doesn't implement
+// --dr-plugin-manifest, like an ordinary executable
+func createBarePlugin(t *testing.T, dir, name string) string {
+	t.Helper()
+
+	script := `#!/bin/sh
+if [ "$1" = "--dr-plugin-manifest" ]; then
+  echo "unknown flag: $1" >&2
+  exit 2
+fi
+`
+
+	path := filepath.Join(dir, name)
+	err := os.WriteFile(path, []byte(script), 0o755)
+	require.NoError(t, err)
+
+	return path
+}
+
 // DiscoverTestSuite tests discovery functions with filesystem fixtures
 type DiscoverTestSuite struct {
 	suite.Suite
@@ -169,6 +191,19 @@ func (s *DiscoverTestSuite) TestDiscoverInDirInvalidManifest() {
 	s.Len(errs, 1) // JSON parse error logged
 }
 
+func (s *DiscoverTestSuite) TestDiscoverInDirBarePlugin() {
+	createBarePlugin(s.T(), s.tempDir, "dr-bare")
+
+	seen := make(map[string]bool)
+	plugins, errs := discoverInDir(s.tempDir, seen)
+
+	s.Empty(errs)
+	s.Require().Len(plugins, 1)
+	s.Equal("bare", plugins[0].Manifest.Name)
+	s.True(plugins[0].Bare)
+	s.True(seen["bare"])
+}
+
 func (s *DiscoverTestSuite) TestDiscoverInDirMultipleValidPlugins() {
 	manifest1 := `{"name":"plugin-one","version":"1.0.0","description":"First plugin"}`
 	manifest2 := `{"name":"plugin-two","version":"2.0.0","description":"Second plugin"}`
@@ -268,3 +303,41 @@ exit 1
 	s.Require().Error(err)
 	s.Nil(manifest)
 }
+
+func TestLookup(t *testing.T) {
+	testutil.SetTestHomeDir(t, t.TempDir())
+
+	binDir := t.TempDir()
+	t.Setenv("PATH", binDir)
+
+	createBarePlugin(t, binDir, "dr-bare")
+	createMockPlugin(t, binDir, "dr-described", validManifest)
+
+	t.Run("finds a plugin without a manifest on PATH", func(t *testing.T) {
+		p, err := Lookup("bare")
+
+		require.NoError(t, err)
+		require.NotNil(t, p)
+		assert.True(t, p.Bare)
+		assert.Equal(t, "bare", p.Manifest.Name)
+		assert.Equal(t, filepath.Join(binDir, "dr-bare"), p.Executable)
+	})
+
+	t.Run("reads the manifest when there is one", func(t *testing.T) {
+		p, err := Lookup("described")
+
+		require.NoError(t, err)
+		require.NotNil(t, p)
+		assert.False(t, p.Bare)
+		assert.Equal(t, "Test plugin", p.Manifest.Description)
+	})
+
+	for _, name := range []string{"missing", "", "--flag", "../bare"} {
+		t.Run("returns nil for "+strconv.Quote(name), func(t *testing.T) {
+			p, err := Lookup(name)
+
+			require.NoError(t, err)
+			assert.Nil(t, p)
+		})
+	}
+}
@@ -25,7 +25,8 @@ import (
 	"syscall"
 
 	"github.com/datarobot/cli/internal/auth"
-	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/credentials"
+	"github.com/datarobot/cli/internal/log"
 	"github.com/spf13/viper"
 )
 
@@ -40,9 +41,17 @@ func ExecutePlugin(manifest PluginManifest, executable string, args []string) in
 	return executePluginCommand(executable, args, manifest.Authentication)
 }
 
+// ExecuteBarePlugin runs a plugin that has no manifest and returns its exit
+// code. Such a plugin can't declare that it needs authentication, so the
+// user is never prompted; the credentials are passed along when the CLI
+// already has them.
+func ExecuteBarePlugin(executable string, args []string) int {
+	return executePluginCommand(executable, args, true)
+}
+
 // executePluginCommand runs the actual plugin command
-func executePluginCommand(executable string, args []string, requireAuth bool) int {
-	cmd := buildPluginCommand(executable, args, requireAuth)
+func executePluginCommand(executable string, args []string, passCredentials bool) int {
+	cmd := buildPluginCommand(executable, args, passCredentials)
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
@@ -85,7 +94,7 @@ func executePluginCommand(executable string, args []string, requireAuth bool) in
 
 // buildPluginCommand creates the appropriate exec.Cmd for the given executable
 // On Windows, .ps1 files are executed via PowerShell
-func buildPluginCommand(executable string, args []string, requireAuth bool) *exec.Cmd {
+func buildPluginCommand(executable string, args []string, passCredentials bool) *exec.Cmd {
 	ext := filepath.Ext(executable)
 
 	// On Windows, execute .ps1 files through PowerShell
@@ -93,24 +102,28 @@ func buildPluginCommand(executable string, args []string, requireAuth bool) *exe
 		psArgs := append([]string{"-ExecutionPolicy", "Bypass", "-File", executable}, args...)
 
 		cmd := exec.Command("powershell.exe", psArgs...)
-		cmd.Env = buildPluginEnv(requireAuth)
+		cmd.Env = buildPluginEnv(passCredentials)
 
 		return cmd
 	}
 
 	cmd := exec.Command(executable, args...)
-	cmd.Env = buildPluginEnv(requireAuth)
+	cmd.Env = buildPluginEnv(passCredentials)
 
 	return cmd
 }
 
-func buildPluginEnv(requireAuth bool) []string {
+// buildPluginEnv returns the plugin's environment. With passCredentials,
+// the endpoint and token the CLI resolved, following the usual token
+// precedence, are added so the plugin and the DataRobot SDK use the same
+// account as dr itself.
+func buildPluginEnv(passCredentials bool) []string {
 	env := os.Environ()
 
 	// Always set plugin mode flag so plugins can detect they were invoked by dr CLI
 	env = append(env, "DR_PLUGIN_MODE=1")
 
-	if !requireAuth {
+	if !passCredentials {
 		return env
 	}
 
@@ -118,12 +131,17 @@ func buildPluginEnv(requireAuth bool) []string {
 		env = append(env, "DATAROBOT_CONFIG="+configPath)
 	}
 
-	if endpoint := viper.GetString(config.DataRobotURL); endpoint != "" {
-		env = append(env, "DATAROBOT_ENDPOINT="+endpoint)
+	creds, err := credentials.Resolve()
+	if err != nil {
+		log.Debug("Not passing a token to the plugin", "error", err)
+	}
+
+	if creds.Endpoint != "" {
+		env = append(env, "DATAROBOT_ENDPOINT="+creds.Endpoint)
 	}
 
-	if token := viper.GetString(config.DataRobotAPIKey); token != "" {
-		env = append(env, "DATAROBOT_API_TOKEN="+token)
+	if creds.Token != "" {
+		env = append(env, "DATAROBOT_API_TOKEN="+creds.Token)
 	}
 
 	return env
@@ -19,24 +19,18 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func TestManagedPluginsDir(t *testing.T) {
 	t.Run("respects XDG_CONFIG_HOME", func(t *testing.T) {
-		originalXDG := os.Getenv("XDG_CONFIG_HOME")
+		testutil.SetTestHomeDir(t, t.TempDir())
 
-		defer func() {
-			if originalXDG != "" {
-				os.Setenv("XDG_CONFIG_HOME", originalXDG)
-			} else {
-				os.Unsetenv("XDG_CONFIG_HOME")
-			}
-		}()
-
-		testConfigHome := "/custom/config"
-		os.Setenv("XDG_CONFIG_HOME", testConfigHome)
+		testConfigHome := t.TempDir()
+		t.Setenv("XDG_CONFIG_HOME", testConfigHome)
 
 		dir, err := ManagedPluginsDir()
 
@@ -44,25 +38,31 @@ func TestManagedPluginsDir(t *testing.T) {
 		assert.Equal(t, filepath.Join(testConfigHome, "datarobot", "plugins"), dir)
 	})
 
-	t.Run("falls back to ~/.config when XDG_CONFIG_HOME not set", func(t *testing.T) {
-		originalXDG := os.Getenv("XDG_CONFIG_HOME")
-
-		defer func() {
-			if originalXDG != "" {
-				os.Setenv("XDG_CONFIG_HOME", originalXDG)
-			} else {
-				os.Unsetenv("XDG_CONFIG_HOME")
-			}
-		}()
+	t.Run("falls back to ~/.config when it holds an existing setup", func(t *testing.T) {
+		homeDir := t.TempDir()
+		testutil.SetTestHomeDir(t, homeDir)
 
-		os.Unsetenv("XDG_CONFIG_HOME")
+		legacy := filepath.Join(homeDir, ".config", "datarobot")
+		require.NoError(t, os.MkdirAll(legacy, 0o755))
 
 		dir, err := ManagedPluginsDir()
 
 		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(legacy, "plugins"), dir)
+	})
+
+	t.Run("uses the config-dir override", func(t *testing.T) {
+		testutil.SetTestHomeDir(t, t.TempDir())
+
+		viper.Reset()
+		t.Cleanup(viper.Reset)
 
-		homeDir, _ := os.UserHomeDir()
-		expected := filepath.Join(homeDir, ".config", "datarobot", "plugins")
-		assert.Equal(t, expected, dir)
+		override := t.TempDir()
+		viper.Set("config-dir", override)
+
+		dir, err := ManagedPluginsDir()
+
+		require.NoError(t, err)
+		assert.Equal(t, filepath.Join(override, "plugins"), dir)
 	})
 }
@@ -22,7 +22,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"net"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -32,6 +31,7 @@ import (
 	"github.com/Masterminds/semver/v3"
 
 	"github.com/codeclysm/extract/v4"
+	"github.com/datarobot/cli/internal/apiclient"
 	"github.com/datarobot/cli/internal/config"
 	"github.com/datarobot/cli/internal/log"
 	"github.com/ulikunitz/xz"
@@ -39,8 +39,7 @@ import (
 
 const (
 	registryFetchTimeout  = 30 * time.Second
-	pluginDownloadTimeout = 5 * time.Minute  // Future note: this might need to be configurable for very large plugins
-	httpDialTimeout       = 30 * time.Second // Connection timeout - fail fast if no internet
+	pluginDownloadTimeout = 5 * time.Minute // Future note: this might need to be configurable for very large plugins
 )
 
 // FetchRegistry downloads and parses the plugin registry from the remote URL.
@@ -49,7 +48,7 @@ func FetchRegistry(registryURL string) (*PluginRegistry, string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), registryFetchTimeout)
 	defer cancel()
 
-	client := &http.Client{}
+	client := apiclient.New(registryFetchTimeout)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, registryURL, nil)
 	if err != nil {
@@ -369,14 +368,7 @@ func downloadHTTP(finalURL string) (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), pluginDownloadTimeout)
 	defer cancel()
 
-	// Custom transport with connection timeout to fail fast if no internet
-	transport := &http.Transport{
-		DialContext: (&net.Dialer{
-			Timeout: httpDialTimeout,
-		}).DialContext,
-	}
-
-	client := &http.Client{Transport: transport}
+	client := apiclient.New(pluginDownloadTimeout)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, finalURL, nil)
 	if err != nil {
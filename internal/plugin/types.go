@@ -78,6 +78,7 @@ type InstalledPlugin struct {
 type DiscoveredPlugin struct {
 	Manifest   PluginManifest
 	Executable string // Full path to executable
+	Bare       bool   // Executable didn't report a manifest
 }
 
 // DiscoveredPluginsRegistry holds discovered plugins with lazy initialization
@@ -0,0 +1,334 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printer
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Fields selects which fields of a record appear in the output, by their
+// JSON name, in the order given. No fields means all of them.
+type Fields []string
+
+// FieldNames returns the JSON names of the fields of a struct, such as a
+// list command's record type
+func FieldNames(example any) []string {
+	t := reflect.TypeOf(example)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var names []string
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+
+		switch name {
+		case "-":
+			continue
+		case "":
+			name = field.Name
+		}
+
+		names = append(names, name)
+	}
+
+	return names
+}
+
+// ParseFields checks names, as given to --fields, against the fields of
+// example. Names match regardless of case and are returned as spelled in
+// the JSON output.
+func ParseFields(names []string, example any) (Fields, error) {
+	valid := FieldNames(example)
+
+	var fields Fields
+
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		i := fieldIndex(valid, name)
+		if i < 0 {
+			return nil, fmt.Errorf("Unknown field %q (must be one of: %s).", name, strings.Join(valid, ", "))
+		}
+
+		fields = append(fields, valid[i])
+	}
+
+	return fields, nil
+}
+
+func fieldIndex(names []string, name string) int {
+	for i, candidate := range names {
+		if strings.EqualFold(candidate, name) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Select returns v reduced to the selected fields: a record for a struct,
+// or a list of records for a slice. Without fields, v is returned as is.
+func (f Fields) Select(v any) (any, error) {
+	if len(f) == 0 {
+		return v, nil
+	}
+
+	value := reflect.ValueOf(v)
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return f.record(v)
+	}
+
+	records := make([]Record, 0, value.Len())
+
+	for i := range value.Len() {
+		record, err := f.record(value.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+func (f Fields) record(v any) (Record, error) {
+	values, err := jsonFields(v)
+	if err != nil {
+		return Record{}, err
+	}
+
+	record := Record{keys: f, values: make(map[string]json.RawMessage, len(f))}
+
+	for _, field := range f {
+		record.values[field] = values[field]
+	}
+
+	return record, nil
+}
+
+func jsonFields(v any) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+
+	var values map[string]json.RawMessage
+
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// Record is a record reduced to some of its fields. It encodes as a JSON
+// or YAML object with the fields in the selected order.
+type Record struct {
+	keys   []string
+	values map[string]json.RawMessage
+}
+
+func (r Record) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteByte('{')
+
+	for i, key := range r.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+
+		name, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(name)
+		buf.WriteByte(':')
+		buf.Write(rawOrNull(r.values[key]))
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+func (r Record) MarshalYAML() (any, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+
+	for _, key := range r.keys {
+		var value any
+
+		if err := json.Unmarshal(rawOrNull(r.values[key]), &value); err != nil {
+			return nil, err
+		}
+
+		var keyNode, valueNode yaml.Node
+
+		if err := keyNode.Encode(key); err != nil {
+			return nil, err
+		}
+
+		if err := valueNode.Encode(value); err != nil {
+			return nil, err
+		}
+
+		node.Content = append(node.Content, &keyNode, &valueNode)
+	}
+
+	return node, nil
+}
+
+func rawOrNull(raw json.RawMessage) json.RawMessage {
+	if len(raw) == 0 {
+		return json.RawMessage("null")
+	}
+
+	return raw
+}
+
+// Column is one column of a text table of T records. Field is the JSON
+// name of the field it shows, which --fields selects it by.
+type Column[T any] struct {
+	Field  string
+	Header string
+	Value  func(T) string
+}
+
+// SelectColumns returns the table columns for fields, in their order.
+// Fields without a predefined column get one showing the field's JSON
+// value. Without fields, columns is returned as is.
+func SelectColumns[T any](columns []Column[T], fields Fields) []Column[T] {
+	if len(fields) == 0 {
+		return columns
+	}
+
+	selected := make([]Column[T], 0, len(fields))
+
+	for _, field := range fields {
+		i := -1
+
+		for j, column := range columns {
+			if strings.EqualFold(column.Field, field) {
+				i = j
+
+				break
+			}
+		}
+
+		if i >= 0 {
+			selected = append(selected, columns[i])
+
+			continue
+		}
+
+		selected = append(selected, Column[T]{
+			Field:  field,
+			Header: fieldHeader(field),
+			Value: func(record T) string {
+				return fieldText(record, field)
+			},
+		})
+	}
+
+	return selected
+}
+
+// fieldHeader turns a JSON name such as predictionEnvironment into a
+// table header such as PREDICTION ENVIRONMENT
+func fieldHeader(field string) string {
+	var header strings.Builder
+
+	for i, r := range field {
+		if i > 0 && unicode.IsUpper(r) {
+			header.WriteByte(' ')
+		}
+
+		header.WriteRune(unicode.ToUpper(r))
+	}
+
+	return header.String()
+}
+
+// fieldText renders one field of a record for a table cell: strings as
+// they are, missing and empty values as "-", anything else as JSON
+func fieldText(record any, field string) string {
+	values, err := jsonFields(record)
+	if err != nil {
+		return "-"
+	}
+
+	raw := values[field]
+
+	switch string(raw) {
+	case "", "null", `""`, "[]", "{}":
+		return "-"
+	}
+
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return text
+	}
+
+	return string(raw)
+}
+
+// WriteTable writes records as a Table with the given columns
+func WriteTable[T any](w io.Writer, columns []Column[T], records []T) error {
+	return NewTable(columns, records).Write(w)
+}
+
+// NewTable returns records as a Table with the given columns
+func NewTable[T any](columns []Column[T], records []T) Table {
+	table := Table{Headers: make([]string, len(columns))}
+
+	for i, column := range columns {
+		table.Headers[i] = column.Header
+	}
+
+	for _, record := range records {
+		row := make([]string, len(columns))
+		for i, column := range columns {
+			row[i] = column.Value(record)
+		}
+
+		table.Rows = append(table.Rows, row)
+	}
+
+	return table
+}
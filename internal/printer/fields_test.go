@@ -0,0 +1,102 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printer
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type item struct {
+	ID        string   `json:"id"`
+	ItemLabel string   `json:"itemLabel"`
+	Tags      []string `json:"tags,omitempty"`
+	Secret    string   `json:"-"`
+}
+
+var itemColumns = []Column[item]{
+	{Field: "id", Header: "ID", Value: func(i item) string { return i.ID }},
+	{Field: "itemLabel", Header: "LABEL", Value: func(i item) string { return i.ItemLabel }},
+}
+
+func TestParseFields(t *testing.T) {
+	assert.Equal(t, []string{"id", "itemLabel", "tags"}, FieldNames(item{}))
+
+	fields, err := ParseFields([]string{"ITEMLABEL", " id", ""}, item{})
+	require.NoError(t, err)
+	assert.Equal(t, Fields{"itemLabel", "id"}, fields)
+
+	_, err = ParseFields([]string{"secret"}, item{})
+	require.EqualError(t, err, `Unknown field "secret" (must be one of: id, itemLabel, tags).`)
+}
+
+func TestFieldsSelectJSON(t *testing.T) {
+	withFormat(t, "json")
+
+	items := []item{{ID: "a", ItemLabel: "First"}, {ID: "b", ItemLabel: "Second", Tags: []string{"x"}}}
+
+	selected, err := Fields{"tags", "id"}.Select(items)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	require.NoError(t, Print(&buf, selected, nil))
+	assert.Equal(t, `[
+  {
+    "tags": null,
+    "id": "a"
+  },
+  {
+    "tags": [
+      "x"
+    ],
+    "id": "b"
+  }
+]
+`, buf.String())
+
+	unchanged, err := Fields(nil).Select(items)
+	require.NoError(t, err)
+	assert.Equal(t, items, unchanged)
+}
+
+func TestFieldsSelectYAML(t *testing.T) {
+	withFormat(t, "yaml")
+
+	selected, err := Fields{"itemLabel", "id"}.Select(item{ID: "a", ItemLabel: "First"})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+
+	require.NoError(t, Print(&buf, selected, nil))
+	assert.Equal(t, "itemLabel: First\nid: a\n", buf.String())
+}
+
+func TestSelectColumns(t *testing.T) {
+	items := []item{{ID: "a", ItemLabel: "First", Tags: []string{"x", "y"}}, {ID: "b", ItemLabel: "Second"}}
+
+	var buf bytes.Buffer
+
+	require.NoError(t, WriteTable(&buf, SelectColumns(itemColumns, nil), items))
+	assert.Equal(t, "ID\tLABEL\na\tFirst\nb\tSecond\n", buf.String())
+
+	buf.Reset()
+
+	require.NoError(t, WriteTable(&buf, SelectColumns(itemColumns, Fields{"tags", "itemLabel"}), items))
+	assert.Equal(t, "TAGS\tLABEL\n[\"x\",\"y\"]\tFirst\n-\tSecond\n", buf.String())
+}
@@ -0,0 +1,209 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package printer renders command results in the output format selected with
+// the global --output flag. Commands hand their result to Print along with a
+// function that renders it for humans; in json or yaml mode the result is
+// encoded instead, so scripts get stable, parseable output on stdout.
+//
+// Only results go to stdout. Progress, prompts, and other diagnostics are
+// written to Diagnostics, which is stderr, so a command piped into a tool
+// such as jq never sees a stray line.
+package printer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"reflect"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// Diagnostics is where output that isn't part of a command's result, such
+// as progress and prompts, is written. Tests may replace it.
+var Diagnostics io.Writer = os.Stderr
+
+// OutputKey is the viper key holding the selected output format
+const OutputKey = "output"
+
+type Format string
+
+var _ pflag.Value = (*Format)(nil)
+
+const (
+	FormatText Format = "text"
+	FormatJSON Format = "json"
+	FormatYAML Format = "yaml"
+	// FormatJSONL writes one compact JSON value per line; lists are
+	// written one element per line
+	FormatJSONL Format = "jsonl"
+)
+
+// Formats lists the supported output formats, for help and completion
+var Formats = []string{string(FormatText), string(FormatJSON), string(FormatYAML), string(FormatJSONL)}
+
+func (f *Format) String() string {
+	if f == nil {
+		return ""
+	}
+
+	return string(*f)
+}
+
+func (f *Format) Set(s string) error {
+	format, err := ParseFormat(s)
+	if err != nil {
+		return err
+	}
+
+	*f = format
+
+	return nil
+}
+
+// Type is used by the shell completion generator
+func (f *Format) Type() string {
+	return "printer.Format"
+}
+
+// ParseFormat validates an output format name. An empty name is text.
+func ParseFormat(s string) (Format, error) {
+	switch s {
+	case "", string(FormatText):
+		return FormatText, nil
+	case string(FormatJSON), string(FormatYAML), string(FormatJSONL):
+		return Format(s), nil
+	}
+
+	return "", fmt.Errorf("Invalid output format %q (must be %q, %q, %q or %q).",
+		s, FormatText, FormatJSON, FormatYAML, FormatJSONL)
+}
+
+// CurrentFormat returns the output format selected via --output or
+// DATAROBOT_CLI_OUTPUT, defaulting to text
+func CurrentFormat() Format {
+	format, err := ParseFormat(viper.GetString(OutputKey))
+	if err != nil {
+		return FormatText
+	}
+
+	return format
+}
+
+// IsStructured reports whether machine-readable output was requested, in
+// which case interactive UIs should be skipped
+func IsStructured() bool {
+	return CurrentFormat() != FormatText
+}
+
+// IsStreaming reports whether list commands should write each record as
+// soon as it is fetched, with WriteRecord, instead of printing the whole
+// list at the end
+func IsStreaming() bool {
+	return CurrentFormat() == FormatJSONL
+}
+
+// WriteRecord writes v to w as a single line of JSON
+func WriteRecord(w io.Writer, v any) error {
+	return json.NewEncoder(w).Encode(v)
+}
+
+// Print writes v to w in the current output format. In text mode, text
+// renders v for humans; if text is nil, v is printed with fmt.Fprintln.
+func Print(w io.Writer, v any, text func(io.Writer) error) error {
+	format := CurrentFormat()
+
+	if format == FormatText {
+		if text != nil {
+			return text(w)
+		}
+
+		_, err := fmt.Fprintln(w, v)
+
+		return err
+	}
+
+	return Encode(w, format, v)
+}
+
+// Encode writes v to w as JSON, YAML or JSON Lines
+func Encode(w io.Writer, format Format, v any) error {
+	switch format {
+	case FormatJSONL:
+		return encodeLines(w, v)
+	case FormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(v)
+	case FormatYAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+
+		if err := encoder.Encode(v); err != nil {
+			return err
+		}
+
+		return encoder.Close()
+	case FormatText:
+		_, err := fmt.Fprintln(w, v)
+
+		return err
+	}
+
+	return fmt.Errorf("Unsupported output format %q.", format)
+}
+
+// encodeLines writes each element of a slice or array on its own line, or
+// any other value as a single line
+func encodeLines(w io.Writer, v any) error {
+	value := reflect.ValueOf(v)
+
+	if value.Kind() != reflect.Slice && value.Kind() != reflect.Array {
+		return WriteRecord(w, v)
+	}
+
+	for i := range value.Len() {
+		if err := WriteRecord(w, value.Index(i).Interface()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ErrorResult is the structured form of a command error
+type ErrorResult struct {
+	Error string `json:"error" yaml:"error"`
+}
+
+// PrintError writes err to w as an {"error": "..."} object in the current
+// structured format. In text mode it writes the message like cobra does.
+func PrintError(w io.Writer, err error) {
+	format := CurrentFormat()
+
+	if format == FormatText {
+		fmt.Fprintln(w, "Error:", err.Error())
+
+		return
+	}
+
+	if encodeErr := Encode(w, format, ErrorResult{Error: err.Error()}); encodeErr != nil {
+		fmt.Fprintln(w, "Error:", err.Error())
+	}
+}
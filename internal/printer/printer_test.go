@@ -0,0 +1,103 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printer
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type result struct {
+	Name  string `json:"name" yaml:"name"`
+	Count int    `json:"count" yaml:"count"`
+}
+
+func withFormat(t *testing.T, format string) {
+	t.Helper()
+
+	viper.Set(OutputKey, format)
+	t.Cleanup(viper.Reset)
+}
+
+func TestPrintText(t *testing.T) {
+	withFormat(t, "text")
+
+	var buf bytes.Buffer
+
+	err := Print(&buf, result{Name: "a", Count: 1}, func(w io.Writer) error {
+		_, err := io.WriteString(w, "a: 1\n")
+
+		return err
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "a: 1\n", buf.String())
+}
+
+func TestPrintJSON(t *testing.T) {
+	withFormat(t, "json")
+
+	var buf bytes.Buffer
+
+	require.NoError(t, Print(&buf, result{Name: "a", Count: 1}, nil))
+	assert.JSONEq(t, `{"name":"a","count":1}`, buf.String())
+}
+
+func TestPrintYAML(t *testing.T) {
+	withFormat(t, "yaml")
+
+	var buf bytes.Buffer
+
+	require.NoError(t, Print(&buf, result{Name: "a", Count: 1}, nil))
+	assert.Equal(t, "name: a\ncount: 1\n", buf.String())
+}
+
+func TestPrintJSONL(t *testing.T) {
+	withFormat(t, "jsonl")
+
+	var buf bytes.Buffer
+
+	require.NoError(t, Print(&buf, []result{{Name: "a", Count: 1}, {Name: "b", Count: 2}}, nil))
+	assert.Equal(t, "{\"name\":\"a\",\"count\":1}\n{\"name\":\"b\",\"count\":2}\n", buf.String())
+
+	buf.Reset()
+
+	require.NoError(t, Print(&buf, result{Name: "a", Count: 1}, nil))
+	assert.Equal(t, "{\"name\":\"a\",\"count\":1}\n", buf.String())
+	assert.True(t, IsStreaming())
+}
+
+func TestPrintErrorJSON(t *testing.T) {
+	withFormat(t, "json")
+
+	var buf bytes.Buffer
+
+	PrintError(&buf, errors.New("Something failed."))
+	assert.JSONEq(t, `{"error":"Something failed."}`, buf.String())
+}
+
+func TestParseFormat(t *testing.T) {
+	format, err := ParseFormat("")
+	require.NoError(t, err)
+	assert.Equal(t, FormatText, format)
+
+	_, err = ParseFormat("xml")
+	require.Error(t, err)
+}
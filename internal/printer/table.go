@@ -0,0 +1,244 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printer
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/datarobot/cli/tui"
+	"golang.org/x/term"
+)
+
+const (
+	// columnGap is the space between table columns
+	columnGap = 2
+	// minColumnWidth is how narrow a column may be truncated to when the
+	// table is wider than the terminal
+	minColumnWidth = 6
+)
+
+var (
+	headerStyle    = lipgloss.NewStyle().Bold(true)
+	highlightStyle = lipgloss.NewStyle().Reverse(true)
+)
+
+// Table is a text table. On a terminal with color, columns are aligned,
+// numeric columns are right-aligned, the header is bold, and cells are
+// truncated with an ellipsis to fit the terminal's width. Otherwise, such
+// as when piped or with --no-color, it is written as tab-separated values
+// for tools like cut and awk.
+type Table struct {
+	Headers []string
+	Rows    [][]string
+	// Highlight marks the rows to draw highlighted when the table is
+	// aligned, such as the rows that changed since a watch last polled
+	Highlight []bool
+}
+
+// Write writes the table to w in the form that suits w
+func (t Table) Write(w io.Writer) error {
+	width, ok := terminalWidth(w)
+	if !ok || !tui.ColorEnabled() {
+		return t.writeSeparated(w)
+	}
+
+	return t.writeAligned(w, width)
+}
+
+// Render returns the table aligned to fit in width, for views that draw
+// the terminal themselves. A width of 0 means no limit.
+func (t Table) Render(width int) string {
+	var b strings.Builder
+
+	_ = t.writeAligned(&b, width)
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// terminalWidth returns the width of w if it is a terminal. The width is 0
+// when it cannot be determined.
+func terminalWidth(w io.Writer) (int, bool) {
+	f, ok := w.(*os.File)
+	if !ok || !term.IsTerminal(int(f.Fd())) {
+		return 0, false
+	}
+
+	width, _, err := term.GetSize(int(f.Fd()))
+	if err != nil {
+		return 0, true
+	}
+
+	return width, true
+}
+
+func (t Table) writeSeparated(w io.Writer) error {
+	cleaner := strings.NewReplacer("\t", " ", "\n", " ", "\r", " ")
+
+	writeRow := func(cells []string) error {
+		clean := make([]string, len(cells))
+		for i, cell := range cells {
+			clean[i] = cleaner.Replace(ansi.Strip(cell))
+		}
+
+		_, err := fmt.Fprintln(w, strings.Join(clean, "\t"))
+
+		return err
+	}
+
+	if err := writeRow(t.Headers); err != nil {
+		return err
+	}
+
+	for _, row := range t.Rows {
+		if err := writeRow(row); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeAligned writes the table with padded columns no wider than width
+// in total. A width of 0 means no limit.
+func (t Table) writeAligned(w io.Writer, width int) error {
+	widths := t.columnWidths()
+	fitWidths(widths, width)
+
+	numeric := make([]bool, len(t.Headers))
+	for i := range numeric {
+		numeric[i] = t.isNumeric(i)
+	}
+
+	line := func(cells []string, header bool) string {
+		var b strings.Builder
+
+		for i := range t.Headers {
+			cell := ""
+			if i < len(cells) {
+				cell = ansi.Truncate(cells[i], widths[i], "…")
+			}
+
+			padding := strings.Repeat(" ", widths[i]-ansi.StringWidth(cell))
+
+			switch {
+			case numeric[i]:
+				cell = padding + cell
+			case i < len(t.Headers)-1:
+				cell += padding
+			}
+
+			if header {
+				cell = headerStyle.Render(cell)
+			}
+
+			if i > 0 {
+				b.WriteString(strings.Repeat(" ", columnGap))
+			}
+
+			b.WriteString(cell)
+		}
+
+		return strings.TrimRight(b.String(), " ")
+	}
+
+	if _, err := fmt.Fprintln(w, line(t.Headers, true)); err != nil {
+		return err
+	}
+
+	for i, row := range t.Rows {
+		text := line(row, false)
+		if i < len(t.Highlight) && t.Highlight[i] {
+			text = highlightStyle.Render(text)
+		}
+
+		if _, err := fmt.Fprintln(w, text); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (t Table) columnWidths() []int {
+	widths := make([]int, len(t.Headers))
+
+	for i, header := range t.Headers {
+		widths[i] = ansi.StringWidth(header)
+	}
+
+	for _, row := range t.Rows {
+		for i := range min(len(row), len(widths)) {
+			widths[i] = max(widths[i], ansi.StringWidth(row[i]))
+		}
+	}
+
+	return widths
+}
+
+// fitWidths narrows the widest columns, one cell at a time, until the
+// table fits in width or no column can shrink further
+func fitWidths(widths []int, width int) {
+	if width <= 0 || len(widths) == 0 {
+		return
+	}
+
+	total := columnGap * (len(widths) - 1)
+	for _, w := range widths {
+		total += w
+	}
+
+	for total > width {
+		widest := -1
+
+		for i, w := range widths {
+			if w > minColumnWidth && (widest < 0 || w > widths[widest]) {
+				widest = i
+			}
+		}
+
+		if widest < 0 {
+			return
+		}
+
+		widths[widest]--
+		total--
+	}
+}
+
+// isNumeric reports whether every value in column i is a number, treating
+// "-" and empty cells as missing values
+func (t Table) isNumeric(i int) bool {
+	found := false
+
+	for _, row := range t.Rows {
+		if i >= len(row) || row[i] == "" || row[i] == "-" {
+			continue
+		}
+
+		if _, err := strconv.ParseFloat(strings.ReplaceAll(row[i], ",", ""), 64); err != nil {
+			return false
+		}
+
+		found = true
+	}
+
+	return found
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package printer
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var sizes = Table{
+	Headers: []string{"NAME", "SIZE", "DESCRIPTION"},
+	Rows: [][]string{
+		{"alpha", "7", "The first one"},
+		{"beta", "1,024", "-"},
+		{"gamma", "-", "A much longer description than the others"},
+	},
+}
+
+func TestTableAligned(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, sizes.writeAligned(&buf, 0))
+	assert.Equal(t, ""+
+		"NAME    SIZE  DESCRIPTION\n"+
+		"alpha      7  The first one\n"+
+		"beta   1,024  -\n"+
+		"gamma      -  A much longer description than the others\n", buf.String())
+}
+
+func TestTableTruncatesToWidth(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, sizes.writeAligned(&buf, 30))
+
+	for _, line := range []string{
+		"NAME    SIZE  DESCRIPTION",
+		"alpha      7  The first one",
+		"beta   1,024  -",
+		"gamma      -  A much longer d…",
+	} {
+		assert.Contains(t, buf.String(), line+"\n")
+	}
+
+	for _, line := range bytes.Split(bytes.TrimSpace(buf.Bytes()), []byte("\n")) {
+		assert.LessOrEqual(t, len([]rune(string(line))), 30)
+	}
+}
+
+func TestTableRender(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, sizes.writeAligned(&buf, 30))
+	assert.Equal(t, strings.TrimSuffix(buf.String(), "\n"), sizes.Render(30))
+}
+
+func TestTableSeparatedWhenNotATerminal(t *testing.T) {
+	table := Table{
+		Headers: []string{"NAME", "NOTE"},
+		Rows:    [][]string{{"a", "two\twords\nand a line"}},
+	}
+
+	var buf bytes.Buffer
+
+	require.NoError(t, table.Write(&buf))
+	assert.Equal(t, "NAME\tNOTE\na\ttwo words and a line\n", buf.String())
+}
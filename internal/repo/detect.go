@@ -93,6 +93,11 @@ func detectTemplate(dir string) bool {
 	return false
 }
 
+// IsRepoRoot reports whether dir is the root of a DataRobot repository
+func IsRepoRoot(dir string) bool {
+	return detectTemplate(dir)
+}
+
 // IsInRepo checks if the current directory is inside a DataRobot repository
 // by looking for a .datarobot/answers folder in the current or parent directories.
 func IsInRepo() bool {
@@ -0,0 +1,338 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package telemetry records anonymous usage metrics for users who opt in.
+// Each event holds a command name, how long it took, and whether it
+// succeeded; arguments, flag values, tokens, and paths are never recorded.
+package telemetry
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"time"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/version"
+	"github.com/spf13/viper"
+)
+
+const (
+	// EnabledKey turns usage metrics on. They are off unless the user opts
+	// in with 'dr telemetry enable'.
+	EnabledKey = "telemetry"
+	// EndpointKey is the collector events are sent to. Without one, events
+	// are only written to the local log.
+	EndpointKey = "telemetry-endpoint"
+
+	dirName     = "telemetry"
+	logFile     = "events.jsonl"
+	pendingFile = "pending.jsonl"
+	noticeFile  = "notice-shown"
+
+	// maxEvents bounds both the local log and the unsent batch; the oldest
+	// events are dropped first
+	maxEvents = 1000
+	// batchSize is how many events are collected before they are sent
+	batchSize = 20
+	// sendTimeout keeps sending off the critical path of the command
+	sendTimeout = 2 * time.Second
+)
+
+// now is stubbed in tests to control timestamps and durations
+var now = time.Now
+
+// Event is a single command run
+type Event struct {
+	Command    string    `json:"command"`
+	DurationMs int64     `json:"duration_ms"`
+	Success    bool      `json:"success"`
+	Version    string    `json:"version"`
+	OS         string    `json:"os"`
+	Arch       string    `json:"arch"`
+	Time       time.Time `json:"time"`
+}
+
+// Status describes the telemetry settings and where events are kept
+type Status struct {
+	Enabled  bool   `json:"enabled"            yaml:"enabled"`
+	Endpoint string `json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	LogFile  string `json:"log_file"           yaml:"log_file"`
+	Pending  int    `json:"pending"            yaml:"pending"`
+}
+
+// Enabled reports whether the user opted in to telemetry
+func Enabled() bool {
+	return viper.GetBool(EnabledKey)
+}
+
+// GetStatus returns the current telemetry settings
+func GetStatus() (Status, error) {
+	status := Status{
+		Enabled:  Enabled(),
+		Endpoint: viper.GetString(EndpointKey),
+	}
+
+	dir, err := Dir()
+	if err != nil {
+		return status, err
+	}
+
+	status.LogFile = filepath.Join(dir, logFile)
+
+	pending, err := readEvents(filepath.Join(dir, pendingFile))
+	if err != nil {
+		return status, err
+	}
+
+	status.Pending = len(pending)
+
+	return status, nil
+}
+
+// Dir returns the directory holding the event log
+func Dir() (string, error) {
+	dir, err := config.Dir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, dirName), nil
+}
+
+// Record appends a command run to the local log when telemetry is
+// enabled. With a collector configured, the event is also queued, and
+// the queue is sent once it holds a full batch. Telemetry must never get
+// in the way of the command, so failures are only logged.
+func Record(ctx context.Context, command string, duration time.Duration, success bool) {
+	if !Enabled() {
+		return
+	}
+
+	event := Event{
+		Command:    command,
+		DurationMs: duration.Milliseconds(),
+		Success:    success,
+		Version:    version.Version,
+		OS:         runtime.GOOS,
+		Arch:       runtime.GOARCH,
+		Time:       now().UTC(),
+	}
+
+	if err := record(ctx, event); err != nil {
+		log.Debug("Failed to record telemetry", "error", err)
+	}
+}
+
+func record(ctx context.Context, event Event) error {
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	if err := appendEvent(filepath.Join(dir, logFile), event); err != nil {
+		return err
+	}
+
+	endpoint := viper.GetString(EndpointKey)
+	if endpoint == "" {
+		return nil
+	}
+
+	pendingPath := filepath.Join(dir, pendingFile)
+
+	if err := appendEvent(pendingPath, event); err != nil {
+		return err
+	}
+
+	// Offline, events stay queued until a later run can send them
+	pending, err := readEvents(pendingPath)
+	if err != nil || len(pending) < batchSize || apiclient.Offline() {
+		return err
+	}
+
+	if err := send(ctx, endpoint, pending); err != nil {
+		return err
+	}
+
+	return os.Remove(pendingPath)
+}
+
+// appendEvent adds event to the JSONL file at path, keeping only the
+// newest maxEvents events
+func appendEvent(path string, event Event) error {
+	events, err := readEvents(path)
+	if err != nil {
+		return err
+	}
+
+	events = append(events, event)
+	if len(events) > maxEvents {
+		events = events[len(events)-maxEvents:]
+	}
+
+	var buf bytes.Buffer
+
+	enc := json.NewEncoder(&buf)
+
+	for _, e := range events {
+		if err := enc.Encode(e); err != nil {
+			return err
+		}
+	}
+
+	return os.WriteFile(path, buf.Bytes(), 0o600)
+}
+
+// readEvents returns the events in the JSONL file at path. A missing file
+// has no events, and lines that don't parse are skipped.
+func readEvents(path string) ([]Event, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+	defer f.Close()
+
+	var events []Event
+
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		var event Event
+
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			continue
+		}
+
+		events = append(events, event)
+	}
+
+	return events, scanner.Err()
+}
+
+// send posts a batch of events to the collector
+func send(ctx context.Context, endpoint string, events []Event) error {
+	body, err := json.Marshal(map[string][]Event{"events": events})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", config.GetUserAgentHeader())
+
+	resp, err := apiclient.New(sendTimeout).Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("Telemetry collector responded with %s.", resp.Status)
+	}
+
+	return nil
+}
+
+// ShouldShowNotice reports whether the one-time notice about telemetry is
+// due: the user hasn't seen it yet and hasn't already chosen a setting
+func ShouldShowNotice() bool {
+	if viper.IsSet(EnabledKey) {
+		return false
+	}
+
+	dir, err := Dir()
+	if err != nil {
+		return false
+	}
+
+	_, err = os.Stat(filepath.Join(dir, noticeFile))
+
+	return errors.Is(err, os.ErrNotExist)
+}
+
+// MarkNoticeShown records that the notice was shown so it isn't repeated
+func MarkNoticeShown() {
+	dir, err := Dir()
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		log.Debug("Failed to create telemetry directory", "error", err)
+
+		return
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, noticeFile), nil, 0o600); err != nil {
+		log.Debug("Failed to record the telemetry notice", "error", err)
+	}
+}
+
+// discardPending drops events that were queued for the collector but not
+// yet sent
+func discardPending() error {
+	dir, err := Dir()
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(filepath.Join(dir, pendingFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+// SetEnabled records the user's choice at the top level of the config
+// file, so it applies to every profile. It takes effect for the current
+// run too: turning telemetry on records this run, and turning it off
+// records nothing more and drops the unsent events. Either way, the
+// notice is no longer needed.
+func SetEnabled(enabled bool) error {
+	if err := config.SetConfigFileValue([]string{EnabledKey}, enabled); err != nil {
+		return err
+	}
+
+	viper.Set(EnabledKey, enabled)
+	MarkNoticeShown()
+
+	if enabled {
+		return nil
+	}
+
+	return discardPending()
+}
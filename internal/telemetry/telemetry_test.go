@@ -0,0 +1,204 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package telemetry
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/testutil"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupTest(t *testing.T) string {
+	t.Helper()
+
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	testutil.SetTestHomeDir(t, t.TempDir())
+	viper.Set(apiclient.MaxRetriesKey, 0)
+
+	dir, err := Dir()
+	require.NoError(t, err)
+
+	return dir
+}
+
+func readLog(t *testing.T, path string) []Event {
+	t.Helper()
+
+	events, err := readEvents(path)
+	require.NoError(t, err)
+
+	return events
+}
+
+func TestRecordDisabled(t *testing.T) {
+	dir := setupTest(t)
+
+	Record(context.Background(), "dr templates list", time.Second, true)
+
+	_, err := os.Stat(dir)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestRecordEnabled(t *testing.T) {
+	dir := setupTest(t)
+	viper.Set(EnabledKey, true)
+
+	Record(context.Background(), "dr templates list", 1500*time.Millisecond, false)
+
+	events := readLog(t, filepath.Join(dir, logFile))
+	require.Len(t, events, 1)
+	assert.Equal(t, "dr templates list", events[0].Command)
+	assert.Equal(t, int64(1500), events[0].DurationMs)
+	assert.False(t, events[0].Success)
+
+	// Without a collector nothing is queued
+	_, err := os.Stat(filepath.Join(dir, pendingFile))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestRecordSendsFullBatches(t *testing.T) {
+	dir := setupTest(t)
+	viper.Set(EnabledKey, true)
+
+	var requests atomic.Int32
+
+	var received []Event
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+
+		var body struct {
+			Events []Event `json:"events"`
+		}
+
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+
+		received = body.Events
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	viper.Set(EndpointKey, server.URL)
+
+	for range batchSize - 1 {
+		Record(context.Background(), "dr start", time.Second, true)
+	}
+
+	assert.Equal(t, int32(0), requests.Load())
+	assert.Len(t, readLog(t, filepath.Join(dir, pendingFile)), batchSize-1)
+
+	Record(context.Background(), "dr start", time.Second, true)
+
+	assert.Equal(t, int32(1), requests.Load())
+	assert.Len(t, received, batchSize)
+	assert.Empty(t, readLog(t, filepath.Join(dir, pendingFile)))
+	assert.Len(t, readLog(t, filepath.Join(dir, logFile)), batchSize)
+}
+
+func TestRecordKeepsBatchWhenSendFails(t *testing.T) {
+	dir := setupTest(t)
+	viper.Set(EnabledKey, true)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	viper.Set(EndpointKey, server.URL)
+
+	for range batchSize {
+		Record(context.Background(), "dr start", time.Second, true)
+	}
+
+	assert.Len(t, readLog(t, filepath.Join(dir, pendingFile)), batchSize)
+}
+
+func TestAppendEventKeepsNewestEvents(t *testing.T) {
+	path := filepath.Join(t.TempDir(), logFile)
+
+	f, err := os.Create(path)
+	require.NoError(t, err)
+
+	enc := json.NewEncoder(f)
+	for i := range maxEvents {
+		require.NoError(t, enc.Encode(Event{Command: "dr", DurationMs: int64(i)}))
+	}
+
+	require.NoError(t, f.Close())
+
+	require.NoError(t, appendEvent(path, Event{Command: "dr", DurationMs: maxEvents}))
+
+	events := readLog(t, path)
+	require.Len(t, events, maxEvents)
+	assert.Equal(t, int64(1), events[0].DurationMs)
+	assert.Equal(t, int64(maxEvents), events[len(events)-1].DurationMs)
+}
+
+func TestNotice(t *testing.T) {
+	setupTest(t)
+
+	assert.True(t, ShouldShowNotice())
+
+	MarkNoticeShown()
+
+	assert.False(t, ShouldShowNotice())
+}
+
+func TestNoticeSkippedOnceChosen(t *testing.T) {
+	setupTest(t)
+	viper.Set(EnabledKey, false)
+
+	assert.False(t, ShouldShowNotice())
+}
+
+func TestSetEnabled(t *testing.T) {
+	dir := setupTest(t)
+
+	require.NoError(t, os.MkdirAll(dir, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, pendingFile), []byte(`{"command":"dr"}`+"\n"), 0o600))
+
+	require.NoError(t, SetEnabled(true))
+	assert.True(t, Enabled())
+	assert.False(t, ShouldShowNotice())
+
+	require.NoError(t, SetEnabled(false))
+	assert.False(t, Enabled())
+
+	_, err := os.Stat(filepath.Join(dir, pendingFile))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	path, err := config.ConfigFilePath()
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "telemetry: false")
+}
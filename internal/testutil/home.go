@@ -18,8 +18,13 @@ import "testing"
 
 // SetTestHomeDir sets the home directory for tests to work cross-platform.
 // Both HOME (Unix) and USERPROFILE (Windows) are set so os.UserHomeDir() works everywhere.
+// XDG_CONFIG_HOME and DATAROBOT_CLI_CONFIG_DIR are cleared so the CLI's
+// files land under dir rather than wherever the developer's environment
+// points.
 func SetTestHomeDir(t *testing.T, dir string) {
 	t.Helper()
 	t.Setenv("HOME", dir)
 	t.Setenv("USERPROFILE", dir)
+	t.Setenv("XDG_CONFIG_HOME", "")
+	t.Setenv("DATAROBOT_CLI_CONFIG_DIR", "")
 }
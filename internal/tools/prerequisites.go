@@ -41,6 +41,20 @@ var RequiredTools = []Prerequisite{
 	{Name: "pulumi", Command: "pulumi", URL: "https://www.pulumi.com/docs/get-started/download-install/"},
 }
 
+// Check reports whether the tool is installed and, if a minimum version
+// is set, recent enough
+func (p Prerequisite) Check() error {
+	if !isInstalled(p.Command) {
+		return fmt.Errorf("%s is not installed.", p.Name)
+	}
+
+	if _, ok := isVersionInstalled(p); !ok {
+		return fmt.Errorf("%s is older than the minimum version v%s.", p.Name, p.MinimumVersion)
+	}
+
+	return nil
+}
+
 func CheckPrerequisite(name string) error {
 	for _, tool := range RequiredTools {
 		if tool.Name == name {
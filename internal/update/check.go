@@ -0,0 +1,180 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package update
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/version"
+	"github.com/spf13/viper"
+)
+
+const (
+	// CheckIntervalKey sets how long the result of an update check is reused
+	// before the releases endpoint is queried again; 0 always queries it
+	CheckIntervalKey = "update-check-interval"
+	// DefaultCheckInterval keeps update checks clear of rate limits and off
+	// the critical path of commands that run them, such as 'dr start'
+	DefaultCheckInterval = 24 * time.Hour
+
+	checkCacheFile = "update-check.json"
+)
+
+// now is stubbed in tests to control cache expiry
+var now = time.Now
+
+// CheckResult reports whether a newer release than the running binary is
+// available
+type CheckResult struct {
+	CurrentVersion  string    `json:"current_version"  yaml:"current_version"`
+	LatestVersion   string    `json:"latest_version"   yaml:"latest_version"`
+	UpdateAvailable bool      `json:"update_available" yaml:"update_available"`
+	CheckedAt       time.Time `json:"checked_at"       yaml:"checked_at"`
+}
+
+type checkCache struct {
+	LatestVersion string    `json:"latest_version"`
+	CheckedAt     time.Time `json:"checked_at"`
+}
+
+// CheckInterval returns how long a cached update check stays fresh
+func CheckInterval() time.Duration {
+	if !viper.IsSet(CheckIntervalKey) {
+		return DefaultCheckInterval
+	}
+
+	return max(viper.GetDuration(CheckIntervalKey), 0)
+}
+
+// CheckForUpdate compares the running version with the latest release. The
+// latest version is cached for CheckInterval unless force is set; nothing
+// is downloaded. In offline mode the cached result is used however old it
+// is.
+func CheckForUpdate(ctx context.Context, force bool) (*CheckResult, error) {
+	cache, ok := loadCheckCache()
+
+	if apiclient.Offline() {
+		if !ok {
+			return nil, fmt.Errorf("No update check is cached and %w is on. Run without --offline to check for updates.", apiclient.ErrOffline)
+		}
+	} else if force || !ok || now().Sub(cache.CheckedAt) >= CheckInterval() {
+		gh, err := fetchRelease(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+
+		cache = checkCache{LatestVersion: normalizeTag(gh.TagName), CheckedAt: now().UTC()}
+
+		saveCheckCache(cache)
+	}
+
+	return &CheckResult{
+		CurrentVersion:  version.Version,
+		LatestVersion:   cache.LatestVersion,
+		UpdateAvailable: isNewer(cache.LatestVersion, version.Version),
+		CheckedAt:       cache.CheckedAt,
+	}, nil
+}
+
+// IsReleaseBuild reports whether the running binary is a tagged release.
+// Development builds are never out of date, so they need no check.
+func IsReleaseBuild() bool {
+	_, err := semver.NewVersion(version.Version)
+
+	return err == nil
+}
+
+// isNewer reports whether latest is a higher version than current. A
+// development build is never considered out of date.
+func isNewer(latest, current string) bool {
+	latestVer, err := semver.NewVersion(latest)
+	if err != nil {
+		return false
+	}
+
+	currentVer, err := semver.NewVersion(current)
+	if err != nil {
+		return false
+	}
+
+	return latestVer.GreaterThan(currentVer)
+}
+
+func checkCachePath() (string, error) {
+	dir, err := config.CacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(dir, checkCacheFile), nil
+}
+
+func loadCheckCache() (checkCache, bool) {
+	var cache checkCache
+
+	path, err := checkCachePath()
+	if err != nil {
+		return cache, false
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			log.Debug("Failed to read update check cache", "error", err)
+		}
+
+		return cache, false
+	}
+
+	if err := json.Unmarshal(data, &cache); err != nil || cache.LatestVersion == "" {
+		return cache, false
+	}
+
+	return cache, true
+}
+
+// saveCheckCache persists the check result. Failures only cost an extra
+// request next time, so they are logged rather than returned.
+func saveCheckCache(cache checkCache) {
+	path, err := checkCachePath()
+	if err != nil {
+		return
+	}
+
+	data, err := json.Marshal(cache)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		log.Debug("Failed to create cache directory", "error", err)
+
+		return
+	}
+
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		log.Debug("Failed to write update check cache", "error", err)
+	}
+}
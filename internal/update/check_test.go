@@ -0,0 +1,188 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package update
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/version"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// serveLatest starts a fake releases API whose latest release is tag and
+// returns a counter of how often it was queried
+func serveLatest(t *testing.T, tag string) *int {
+	t.Helper()
+
+	hits := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		hits++
+
+		_ = json.NewEncoder(w).Encode(githubRelease{TagName: tag})
+	}))
+
+	t.Cleanup(server.Close)
+
+	original := ReleasesURL
+	ReleasesURL = server.URL
+
+	t.Cleanup(func() { ReleasesURL = original })
+
+	return &hits
+}
+
+func setupCheck(t *testing.T, current string) *time.Time {
+	t.Helper()
+
+	t.Setenv("HOME", t.TempDir())
+
+	originalVersion := version.Version
+	version.Version = current
+
+	clock := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	now = func() time.Time { return clock }
+
+	t.Cleanup(func() {
+		version.Version = originalVersion
+		now = time.Now
+	})
+
+	return &clock
+}
+
+func TestCheckForUpdateReportsNewerRelease(t *testing.T) {
+	setupCheck(t, "v0.2.0")
+	serveLatest(t, "v0.3.0")
+
+	result, err := CheckForUpdate(context.Background(), false)
+	require.NoError(t, err)
+
+	assert.Equal(t, "v0.2.0", result.CurrentVersion)
+	assert.Equal(t, "v0.3.0", result.LatestVersion)
+	assert.True(t, result.UpdateAvailable)
+}
+
+func TestCheckForUpdateUpToDate(t *testing.T) {
+	setupCheck(t, "v0.3.0")
+	serveLatest(t, "v0.3.0")
+
+	result, err := CheckForUpdate(context.Background(), false)
+	require.NoError(t, err)
+	assert.False(t, result.UpdateAvailable)
+}
+
+func TestCheckForUpdateDevBuild(t *testing.T) {
+	setupCheck(t, "dev")
+	serveLatest(t, "v0.3.0")
+
+	result, err := CheckForUpdate(context.Background(), false)
+	require.NoError(t, err)
+	assert.False(t, result.UpdateAvailable)
+}
+
+func TestCheckForUpdateUsesCache(t *testing.T) {
+	clock := setupCheck(t, "v0.2.0")
+	hits := serveLatest(t, "v0.3.0")
+
+	_, err := CheckForUpdate(context.Background(), false)
+	require.NoError(t, err)
+
+	*clock = clock.Add(23 * time.Hour)
+
+	_, err = CheckForUpdate(context.Background(), false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, *hits, "check within the TTL should use the cache")
+
+	*clock = clock.Add(DefaultCheckInterval)
+
+	_, err = CheckForUpdate(context.Background(), false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, *hits, "stale cache should be refreshed")
+}
+
+func TestCheckForUpdateInterval(t *testing.T) {
+	clock := setupCheck(t, "v0.2.0")
+	hits := serveLatest(t, "v0.3.0")
+
+	viper.Set(CheckIntervalKey, "1h")
+	t.Cleanup(viper.Reset)
+
+	_, err := CheckForUpdate(context.Background(), false)
+	require.NoError(t, err)
+
+	*clock = clock.Add(59 * time.Minute)
+
+	_, err = CheckForUpdate(context.Background(), false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, *hits)
+
+	*clock = clock.Add(time.Minute)
+
+	_, err = CheckForUpdate(context.Background(), false)
+	require.NoError(t, err)
+	assert.Equal(t, 2, *hits, "a check older than the interval should be refreshed")
+
+	viper.Set(CheckIntervalKey, "0s")
+
+	_, err = CheckForUpdate(context.Background(), false)
+	require.NoError(t, err)
+	assert.Equal(t, 3, *hits, "a zero interval should always check")
+}
+
+func TestCheckForUpdateForce(t *testing.T) {
+	setupCheck(t, "v0.2.0")
+	hits := serveLatest(t, "v0.3.0")
+
+	_, err := CheckForUpdate(context.Background(), false)
+	require.NoError(t, err)
+
+	result, err := CheckForUpdate(context.Background(), true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, *hits, "a forced check should bypass the cache")
+	assert.True(t, result.UpdateAvailable)
+}
+
+func TestCheckForUpdateOffline(t *testing.T) {
+	clock := setupCheck(t, "v0.2.0")
+	hits := serveLatest(t, "v0.3.0")
+
+	t.Cleanup(viper.Reset)
+	viper.Set(apiclient.OfflineKey, true)
+
+	_, err := CheckForUpdate(context.Background(), true)
+	require.ErrorIs(t, err, apiclient.ErrOffline)
+
+	viper.Set(apiclient.OfflineKey, false)
+
+	_, err = CheckForUpdate(context.Background(), false)
+	require.NoError(t, err)
+
+	viper.Set(apiclient.OfflineKey, true)
+	*clock = clock.Add(30 * DefaultCheckInterval)
+
+	result, err := CheckForUpdate(context.Background(), true)
+	require.NoError(t, err)
+	assert.True(t, result.UpdateAvailable)
+	assert.Equal(t, 1, *hits, "offline checks should only use the cache")
+}
@@ -0,0 +1,416 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package update
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/codeclysm/extract/v4"
+	"github.com/datarobot/cli/internal/apiclient"
+	"github.com/datarobot/cli/internal/config"
+	"github.com/datarobot/cli/internal/log"
+	"github.com/datarobot/cli/internal/version"
+	"github.com/spf13/viper"
+)
+
+// ReleasesURL is the GitHub API endpoint listing CLI releases. It is a
+// variable so tests can point it at a local server.
+var ReleasesURL = "https://api.github.com/repos/datarobot-oss/cli/releases"
+
+const (
+	// DownloadTimeoutKey is the viper key bounding the download of a
+	// release archive, settable via 'dr self update --download-timeout'.
+	// Archives are much larger than API responses, so the request timeout
+	// does not apply.
+	DownloadTimeoutKey = "download-timeout"
+
+	DefaultDownloadTimeout = 5 * time.Minute
+
+	// DisableSelfUpdateKey turns off update checks and prompts, for
+	// installations managed by an administrator or package manager. It is
+	// also settable via DATAROBOT_CLI_DISABLE_SELF_UPDATE.
+	DisableSelfUpdateKey = "disable-self-update"
+)
+
+// ErrSelfUpdateDisabled is returned when an update is requested while
+// DisableSelfUpdateKey is set
+var ErrSelfUpdateDisabled = errors.New("Self-update is disabled by policy (disable-self-update is set). " +
+	"Ask your administrator to install a newer version of the CLI.")
+
+// SelfUpdateDisabled reports whether the CLI may not update itself
+func SelfUpdateDisabled() bool {
+	return viper.GetBool(DisableSelfUpdateKey)
+}
+
+// DownloadTimeout returns the configured release download timeout
+func DownloadTimeout() time.Duration {
+	if timeout := viper.GetDuration(DownloadTimeoutKey); timeout > 0 {
+		return timeout
+	}
+
+	return DefaultDownloadTimeout
+}
+
+// ErrChecksumMismatch is returned when a downloaded archive does not match
+// the checksum published with the release
+var ErrChecksumMismatch = errors.New("checksum mismatch")
+
+// Release is a published CLI release along with the assets needed to
+// install it on the current platform
+type Release struct {
+	Version      string
+	ArchiveName  string
+	ArchiveURL   string
+	ChecksumsURL string
+}
+
+type githubRelease struct {
+	TagName string        `json:"tag_name"`
+	Assets  []githubAsset `json:"assets"`
+}
+
+type githubAsset struct {
+	Name string `json:"name"`
+	URL  string `json:"browser_download_url"`
+}
+
+// ArchiveName returns the name of the release archive for the given
+// platform, following the goreleaser name template
+func ArchiveName(tag, goos, goarch string) string {
+	if goarch == "amd64" {
+		goarch = "x86_64"
+	} else if goarch == "386" {
+		goarch = "i386"
+	}
+
+	ext := ".tar.gz"
+	if goos == "windows" {
+		ext = ".zip"
+	}
+
+	return fmt.Sprintf("%s_%s_%s_%s%s", version.CliName, normalizeTag(tag),
+		strings.ToUpper(goos[:1])+goos[1:], goarch, ext)
+}
+
+// ChecksumsName returns the name of the checksums file for a release
+func ChecksumsName(tag string) string {
+	return fmt.Sprintf("%s_%s_checksums.txt", version.CliName, normalizeTag(tag))
+}
+
+func normalizeTag(tag string) string {
+	return "v" + strings.TrimPrefix(tag, "v")
+}
+
+// ResolveRelease looks up the release to install: the latest one when
+// requested is empty, otherwise the release tagged with that version
+func ResolveRelease(ctx context.Context, requested string) (*Release, error) {
+	gh, err := fetchRelease(ctx, requested)
+	if err != nil {
+		return nil, err
+	}
+
+	release := &Release{
+		Version:     normalizeTag(gh.TagName),
+		ArchiveName: ArchiveName(gh.TagName, runtime.GOOS, runtime.GOARCH),
+	}
+
+	checksumsName := ChecksumsName(gh.TagName)
+
+	for _, asset := range gh.Assets {
+		switch asset.Name {
+		case release.ArchiveName:
+			release.ArchiveURL = asset.URL
+		case checksumsName:
+			release.ChecksumsURL = asset.URL
+		}
+	}
+
+	if release.ArchiveURL == "" {
+		return nil, fmt.Errorf("Release %s has no build for %s/%s.", release.Version, runtime.GOOS, runtime.GOARCH)
+	}
+
+	if release.ChecksumsURL == "" {
+		return nil, fmt.Errorf("Release %s does not publish checksums.", release.Version)
+	}
+
+	return release, nil
+}
+
+// fetchRelease queries the GitHub API for the latest release, or for the
+// release tagged requested
+func fetchRelease(ctx context.Context, requested string) (*githubRelease, error) {
+	url := ReleasesURL + "/latest"
+	if requested != "" {
+		url = ReleasesURL + "/tags/" + normalizeTag(requested)
+	}
+
+	resp, err := get(ctx, url, 0)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to look up release: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound && requested != "" {
+		return nil, fmt.Errorf("Release %s was not found.", normalizeTag(requested))
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("Failed to look up release: HTTP %d", resp.StatusCode)
+	}
+
+	var gh githubRelease
+
+	if err := json.NewDecoder(resp.Body).Decode(&gh); err != nil {
+		return nil, fmt.Errorf("Failed to parse release: %w", err)
+	}
+
+	return &gh, nil
+}
+
+// Install downloads the release archive, verifies it against the published
+// SHA256 checksums, and replaces the binary at executable with the one
+// from the archive. Nothing is replaced if verification fails.
+func Install(ctx context.Context, release *Release, executable string) error {
+	workDir, err := os.MkdirTemp("", "dr-update-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(workDir)
+
+	archivePath := filepath.Join(workDir, release.ArchiveName)
+
+	if err := download(ctx, release.ArchiveURL, archivePath); err != nil {
+		return fmt.Errorf("Failed to download %s: %w", release.ArchiveName, err)
+	}
+
+	expected, err := fetchChecksum(ctx, release.ChecksumsURL, release.ArchiveName)
+	if err != nil {
+		return err
+	}
+
+	if err := verifyChecksum(archivePath, expected); err != nil {
+		return err
+	}
+
+	extractDir := filepath.Join(workDir, "extract")
+
+	binary, err := extractBinary(ctx, archivePath, extractDir)
+	if err != nil {
+		return err
+	}
+
+	return replaceExecutable(binary, executable)
+}
+
+// get fetches url with the given timeout; zero means the request timeout
+func get(ctx context.Context, url string, timeout time.Duration) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("User-Agent", config.GetUserAgentHeader())
+
+	client := apiclient.New(timeout)
+
+	return client.Do(req)
+}
+
+func download(ctx context.Context, url, dest string) error {
+	log.Debug("Downloading release asset", "url", url)
+
+	resp, err := get(ctx, url, DownloadTimeout())
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+
+	f, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = io.Copy(f, resp.Body)
+
+	return err
+}
+
+// fetchChecksum downloads the checksums file and returns the entry for name
+func fetchChecksum(ctx context.Context, url, name string) (string, error) {
+	resp, err := get(ctx, url, 0)
+	if err != nil {
+		return "", fmt.Errorf("Failed to download checksums: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("Failed to download checksums: HTTP %d", resp.StatusCode)
+	}
+
+	return parseChecksums(resp.Body, name)
+}
+
+// parseChecksums finds the SHA256 for name in sha256sum-formatted input
+func parseChecksums(r io.Reader, name string) (string, error) {
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == name {
+			return strings.ToLower(fields[0]), nil
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("Failed to read checksums: %w", err)
+	}
+
+	return "", fmt.Errorf("No checksum published for %s.", name)
+}
+
+func verifyChecksum(path, expected string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+
+	actual := hex.EncodeToString(h.Sum(nil))
+	if actual != expected {
+		return fmt.Errorf("%w for %s: expected %s, got %s", ErrChecksumMismatch, filepath.Base(path), expected, actual)
+	}
+
+	return nil
+}
+
+// extractBinary unpacks the archive into dir and returns the path of the
+// dr binary inside it
+func extractBinary(ctx context.Context, archivePath, dir string) (string, error) {
+	f, err := os.Open(archivePath)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if err := extract.Archive(ctx, f, dir, nil); err != nil {
+		return "", fmt.Errorf("Failed to extract %s: %w", filepath.Base(archivePath), err)
+	}
+
+	name := version.CliName
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+
+	binary := filepath.Join(dir, name)
+	if _, err := os.Stat(binary); err != nil {
+		return "", fmt.Errorf("Archive %s does not contain %s.", filepath.Base(archivePath), name)
+	}
+
+	return binary, nil
+}
+
+// replaceExecutable copies binary next to executable and renames it into
+// place, so the swap is atomic and never leaves a partial file behind.
+// Windows cannot overwrite a running executable, so the old one is moved
+// aside first and restored if the swap fails.
+func replaceExecutable(binary, executable string) error {
+	info, err := os.Stat(executable)
+	if err != nil {
+		return err
+	}
+
+	staged, err := os.CreateTemp(filepath.Dir(executable), "."+filepath.Base(executable)+".new-*")
+	if err != nil {
+		return fmt.Errorf("Failed to stage new binary: %w", err)
+	}
+
+	stagedPath := staged.Name()
+	defer os.Remove(stagedPath)
+
+	if err := copyInto(staged, binary); err != nil {
+		return fmt.Errorf("Failed to stage new binary: %w", err)
+	}
+
+	if err := os.Chmod(stagedPath, info.Mode().Perm()|0o755); err != nil {
+		return err
+	}
+
+	if runtime.GOOS != "windows" {
+		if err := os.Rename(stagedPath, executable); err != nil {
+			return fmt.Errorf("Failed to replace %s: %w", executable, err)
+		}
+
+		return nil
+	}
+
+	old := executable + ".old"
+	_ = os.Remove(old)
+
+	if err := os.Rename(executable, old); err != nil {
+		return fmt.Errorf("Failed to move %s aside: %w", executable, err)
+	}
+
+	if err := os.Rename(stagedPath, executable); err != nil {
+		if restoreErr := os.Rename(old, executable); restoreErr != nil {
+			log.Error("Could not restore executable from backup", "backup", old, "error", restoreErr)
+		}
+
+		return fmt.Errorf("Failed to replace %s: %w", executable, err)
+	}
+
+	return nil
+}
+
+func copyInto(dst *os.File, src string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		dst.Close()
+
+		return err
+	}
+	defer in.Close()
+
+	if _, err := io.Copy(dst, in); err != nil {
+		dst.Close()
+
+		return err
+	}
+
+	return dst.Close()
+}
@@ -0,0 +1,202 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package update
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchiveName(t *testing.T) {
+	tests := []struct {
+		goos, goarch, want string
+	}{
+		{"linux", "amd64", "dr_v0.2.0_Linux_x86_64.tar.gz"},
+		{"darwin", "arm64", "dr_v0.2.0_Darwin_arm64.tar.gz"},
+		{"windows", "amd64", "dr_v0.2.0_Windows_x86_64.zip"},
+		{"linux", "riscv64", "dr_v0.2.0_Linux_riscv64.tar.gz"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, ArchiveName("0.2.0", tt.goos, tt.goarch))
+	}
+
+	assert.Equal(t, "dr_v0.2.0_checksums.txt", ChecksumsName("v0.2.0"))
+}
+
+func TestParseChecksums(t *testing.T) {
+	input := "abc123  dr_v0.2.0_Linux_x86_64.tar.gz\nDEF456 *dr_v0.2.0_Windows_x86_64.zip\n"
+
+	sum, err := parseChecksums(bytes.NewBufferString(input), "dr_v0.2.0_Windows_x86_64.zip")
+	require.NoError(t, err)
+	assert.Equal(t, "def456", sum)
+
+	_, err = parseChecksums(bytes.NewBufferString(input), "dr_v0.2.0_Darwin_arm64.tar.gz")
+	require.Error(t, err)
+}
+
+// buildArchive packages content as the dr binary in the archive format
+// used for the current platform
+func buildArchive(t *testing.T, content []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	if runtime.GOOS == "windows" {
+		zw := zip.NewWriter(&buf)
+
+		w, err := zw.Create("dr.exe")
+		require.NoError(t, err)
+
+		_, err = w.Write(content)
+		require.NoError(t, err)
+		require.NoError(t, zw.Close())
+
+		return buf.Bytes()
+	}
+
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "dr", Mode: 0o755, Size: int64(len(content))}))
+
+	_, err := tw.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+
+	return buf.Bytes()
+}
+
+// serveRelease starts a fake GitHub releases API publishing tag with the
+// given archive and checksum
+func serveRelease(t *testing.T, tag string, archive []byte, checksum string) {
+	t.Helper()
+
+	archiveName := ArchiveName(tag, runtime.GOOS, runtime.GOARCH)
+	checksumsName := ChecksumsName(tag)
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	t.Cleanup(server.Close)
+
+	releaseHandler := func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(githubRelease{
+			TagName: tag,
+			Assets: []githubAsset{
+				{Name: archiveName, URL: server.URL + "/download/" + archiveName},
+				{Name: checksumsName, URL: server.URL + "/download/" + checksumsName},
+			},
+		})
+	}
+
+	mux.HandleFunc("/releases/latest", releaseHandler)
+	mux.HandleFunc("/releases/tags/"+tag, releaseHandler)
+	mux.HandleFunc("/download/"+archiveName, func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(archive)
+	})
+	mux.HandleFunc("/download/"+checksumsName, func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprintf(w, "%s  %s\n", checksum, archiveName)
+	})
+
+	original := ReleasesURL
+	ReleasesURL = server.URL + "/releases"
+
+	t.Cleanup(func() { ReleasesURL = original })
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+
+	return hex.EncodeToString(sum[:])
+}
+
+func writeExecutable(t *testing.T) string {
+	t.Helper()
+
+	executable := filepath.Join(t.TempDir(), "dr")
+	require.NoError(t, os.WriteFile(executable, []byte("old binary"), 0o755))
+
+	return executable
+}
+
+func TestResolveRelease(t *testing.T) {
+	serveRelease(t, "v0.3.0", nil, "")
+
+	release, err := ResolveRelease(context.Background(), "")
+	require.NoError(t, err)
+	assert.Equal(t, "v0.3.0", release.Version)
+
+	release, err = ResolveRelease(context.Background(), "0.3.0")
+	require.NoError(t, err)
+	assert.Equal(t, "v0.3.0", release.Version)
+
+	_, err = ResolveRelease(context.Background(), "9.9.9")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "v9.9.9 was not found")
+}
+
+func TestInstallReplacesExecutable(t *testing.T) {
+	archive := buildArchive(t, []byte("new binary"))
+	serveRelease(t, "v0.3.0", archive, sha256Hex(archive))
+
+	executable := writeExecutable(t)
+
+	release, err := ResolveRelease(context.Background(), "")
+	require.NoError(t, err)
+	require.NoError(t, Install(context.Background(), release, executable))
+
+	data, err := os.ReadFile(executable)
+	require.NoError(t, err)
+	assert.Equal(t, "new binary", string(data))
+
+	entries, err := os.ReadDir(filepath.Dir(executable))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "staged binary should not be left behind")
+}
+
+func TestInstallRefusesChecksumMismatch(t *testing.T) {
+	archive := buildArchive(t, []byte("tampered binary"))
+	serveRelease(t, "v0.3.0", archive, sha256Hex([]byte("something else")))
+
+	executable := writeExecutable(t)
+
+	release, err := ResolveRelease(context.Background(), "")
+	require.NoError(t, err)
+
+	err = Install(context.Background(), release, executable)
+	require.ErrorIs(t, err, ErrChecksumMismatch)
+
+	data, err := os.ReadFile(executable)
+	require.NoError(t, err)
+	assert.Equal(t, "old binary", string(data))
+}
@@ -32,10 +32,10 @@ var GitCommit = "unknown"
 var BuildDate = "unknown"
 
 type InfoData struct {
-	Version   string `json:"version"`
-	Commit    string `json:"commit"`
-	BuildDate string `json:"build_date"`
-	Runtime   string `json:"runtime"`
+	Version   string `json:"version"    yaml:"version"`
+	Commit    string `json:"commit"     yaml:"commit"`
+	BuildDate string `json:"build_date" yaml:"build_date"`
+	Runtime   string `json:"runtime"    yaml:"runtime"`
 }
 
 var (
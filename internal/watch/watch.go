@@ -0,0 +1,219 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/datarobot/cli/tui"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+const (
+	// DefaultInterval is how often a watch polls unless --interval is given
+	DefaultInterval = 5 * time.Second
+	// MinInterval keeps a watch from polling the API faster than once a
+	// second
+	MinInterval = time.Second
+)
+
+// now is stubbed in tests
+var now = time.Now
+
+// stdoutIsTerminal is stubbed in tests
+var stdoutIsTerminal = func() bool {
+	return term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// Flags holds the values of the flags AddFlags adds
+type Flags struct {
+	Enabled  bool
+	Interval time.Duration
+}
+
+// AddFlags adds --watch and --interval to a list command
+func AddFlags(cmd *cobra.Command, flags *Flags) {
+	cmd.Flags().BoolVarP(&flags.Enabled, "watch", "w", false, "Keep the table on screen and redraw it every --interval, highlighting rows that changed")
+	cmd.Flags().DurationVar(&flags.Interval, "interval", DefaultInterval, "Time between refreshes with --watch")
+}
+
+// Options describe a table to keep up to date
+type Options[T any] struct {
+	// Interval is the time between polls
+	Interval time.Duration
+	// Columns are the table's columns
+	Columns []printer.Column[T]
+	// Key identifies a record across polls, so changed rows can be found
+	Key func(T) string
+	// Fetch returns the records to show
+	Fetch func(context.Context) ([]T, error)
+	// Empty is shown instead of the table when there are no records
+	Empty string
+}
+
+// Check returns an error if a watch can't run: the output is not a
+// terminal, structured output was requested, or interval is too short
+func Check(interval time.Duration) error {
+	if !stdoutIsTerminal() {
+		return errors.New("--watch redraws the table in place and needs a terminal. Run the command without --watch to print the list once.")
+	}
+
+	if printer.IsStructured() {
+		return fmt.Errorf("--watch shows a table and can't be combined with --output %s.", printer.CurrentFormat())
+	}
+
+	if interval < MinInterval {
+		return fmt.Errorf("--interval must be at least %s.", MinInterval)
+	}
+
+	return nil
+}
+
+// Run shows the records opts.Fetch returns in a table that is redrawn
+// every opts.Interval, with the rows that changed since the previous poll
+// highlighted, until the user presses q or Ctrl-C or ctx is done
+func Run[T any](ctx context.Context, opts Options[T]) error {
+	if err := Check(opts.Interval); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	_, err := tui.Run(newModel(ctx, opts), tea.WithAltScreen(), tea.WithContext(ctx))
+	if errors.Is(err, tea.ErrProgramKilled) {
+		return nil
+	}
+
+	return err
+}
+
+type fetchedMsg[T any] struct {
+	records []T
+	err     error
+	at      time.Time
+}
+
+type pollMsg struct{}
+
+type model[T any] struct {
+	ctx   context.Context
+	opts  Options[T]
+	width int
+
+	polled  bool
+	table   printer.Table
+	cells   map[string][]string
+	err     error
+	updated time.Time
+}
+
+func newModel[T any](ctx context.Context, opts Options[T]) model[T] {
+	return model[T]{ctx: ctx, opts: opts}
+}
+
+func (m model[T]) Init() tea.Cmd {
+	return m.fetch
+}
+
+func (m model[T]) fetch() tea.Msg {
+	records, err := m.opts.Fetch(m.ctx)
+
+	return fetchedMsg[T]{records: records, err: err, at: now()}
+}
+
+func (m model[T]) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if msg.String() == "q" || msg.String() == "esc" {
+			return m, tea.Quit
+		}
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+	case fetchedMsg[T]:
+		m = m.apply(msg)
+
+		return m, tea.Tick(m.opts.Interval, func(time.Time) tea.Msg { return pollMsg{} })
+	case pollMsg:
+		return m, m.fetch
+	}
+
+	return m, nil
+}
+
+// apply updates the table with the result of a poll. A failed poll keeps
+// the previous table and reports the error until a poll succeeds.
+func (m model[T]) apply(msg fetchedMsg[T]) model[T] {
+	m.err = msg.err
+	if msg.err != nil {
+		return m
+	}
+
+	table := printer.NewTable(m.opts.Columns, msg.records)
+	cells := make(map[string][]string, len(msg.records))
+	table.Highlight = make([]bool, len(msg.records))
+
+	for i, record := range msg.records {
+		key := m.opts.Key(record)
+		cells[key] = table.Rows[i]
+
+		if previous, ok := m.cells[key]; m.polled && (!ok || !slices.Equal(previous, table.Rows[i])) {
+			table.Highlight[i] = true
+		}
+	}
+
+	m.table = table
+	m.cells = cells
+	m.polled = true
+	m.updated = msg.at
+
+	return m
+}
+
+func (m model[T]) View() string {
+	var b strings.Builder
+
+	status := fmt.Sprintf("Every %s", m.opts.Interval)
+	if !m.updated.IsZero() {
+		status += " · updated " + m.updated.Format("15:04:05")
+	}
+
+	b.WriteString(tui.DimStyle.Render(status+" · press q to quit") + "\n\n")
+
+	switch {
+	case !m.polled && m.err == nil:
+		b.WriteString("Loading...")
+	case !m.polled:
+	case len(m.table.Rows) == 0:
+		b.WriteString(m.opts.Empty)
+	default:
+		b.WriteString(m.table.Render(m.width))
+	}
+
+	if m.err != nil {
+		b.WriteString("\n\n" + tui.ErrorStyle.Render("Refresh failed: "+m.err.Error()))
+	}
+
+	return b.String()
+}
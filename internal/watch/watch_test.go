@@ -0,0 +1,106 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package watch
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/datarobot/cli/internal/printer"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+type item struct {
+	id     string
+	status string
+}
+
+func testModel() model[item] {
+	return newModel(context.Background(), Options[item]{
+		Interval: DefaultInterval,
+		Columns: []printer.Column[item]{
+			{Field: "id", Header: "ID", Value: func(i item) string { return i.id }},
+			{Field: "status", Header: "STATUS", Value: func(i item) string { return i.status }},
+		},
+		Key:   func(i item) string { return i.id },
+		Empty: "Nothing here.",
+	})
+}
+
+func stubTerminal(t *testing.T, terminal bool) {
+	t.Helper()
+
+	original := stdoutIsTerminal
+	stdoutIsTerminal = func() bool { return terminal }
+
+	t.Cleanup(func() { stdoutIsTerminal = original })
+}
+
+func TestCheck(t *testing.T) {
+	viper.Reset()
+	t.Cleanup(viper.Reset)
+
+	stubTerminal(t, false)
+	assert.ErrorContains(t, Check(DefaultInterval), "needs a terminal")
+
+	stubTerminal(t, true)
+	assert.NoError(t, Check(DefaultInterval))
+	assert.EqualError(t, Check(500*time.Millisecond), "--interval must be at least 1s.")
+
+	viper.Set(printer.OutputKey, "json")
+	assert.EqualError(t, Check(DefaultInterval), "--watch shows a table and can't be combined with --output json.")
+}
+
+func TestApplyHighlightsChangedRows(t *testing.T) {
+	at := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	m := testModel()
+
+	m = m.apply(fetchedMsg[item]{records: []item{{"a", "active"}, {"b", "building"}}, at: at})
+	assert.Equal(t, []bool{false, false}, m.table.Highlight, "the first poll has nothing to compare with")
+
+	m = m.apply(fetchedMsg[item]{records: []item{{"b", "active"}, {"a", "active"}, {"c", "new"}}, at: at.Add(DefaultInterval)})
+	assert.Equal(t, []bool{true, false, true}, m.table.Highlight)
+	assert.Equal(t, at.Add(DefaultInterval), m.updated)
+
+	m = m.apply(fetchedMsg[item]{records: []item{{"b", "active"}, {"a", "active"}, {"c", "new"}}})
+	assert.Equal(t, []bool{false, false, false}, m.table.Highlight)
+}
+
+func TestApplyKeepsTableOnError(t *testing.T) {
+	m := testModel()
+	m = m.apply(fetchedMsg[item]{records: []item{{"a", "active"}}})
+
+	m = m.apply(fetchedMsg[item]{err: errors.New("connection refused")})
+	assert.Len(t, m.table.Rows, 1)
+
+	view := m.View()
+	assert.Contains(t, view, "a   active")
+	assert.Contains(t, view, "Refresh failed: connection refused")
+
+	m = m.apply(fetchedMsg[item]{records: []item{{"a", "active"}}})
+	assert.NotContains(t, m.View(), "Refresh failed")
+}
+
+func TestView(t *testing.T) {
+	m := testModel()
+	assert.Contains(t, m.View(), "Loading...")
+
+	m = m.apply(fetchedMsg[item]{records: nil, at: time.Date(2026, 3, 1, 9, 30, 0, 0, time.Local)})
+	assert.Contains(t, m.View(), "Every 5s · updated 09:30:00 · press q to quit")
+	assert.Contains(t, m.View(), "Nothing here.")
+}
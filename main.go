@@ -18,16 +18,19 @@ import (
 	"context"
 	"os"
 	"os/signal"
+	"syscall"
 
 	"github.com/datarobot/cli/cmd"
+	"github.com/datarobot/cli/internal/errs"
 )
 
 func main() {
-	// Create a context that's canceled on interrupt signals
-	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, os.Kill)
+	// Create a context that's canceled on interrupt signals. SIGTERM is
+	// what CI systems send to cancel a job.
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer cancel()
 
 	if err := cmd.ExecuteContext(ctx); err != nil {
-		os.Exit(1)
+		os.Exit(errs.ExitCode(err))
 	}
 }
@@ -6,8 +6,12 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
 )
 
+// NoColorKey is the config key for --no-color
+const NoColorKey = "no-color"
+
 // DataRobot brand colors, utilizing the Design System palette
 const (
 	DrPurple      = lipgloss.Color("#7770F9") // purple-60
@@ -39,7 +43,23 @@ func GetAdaptiveColor(darkColor, lightColor lipgloss.Color) lipgloss.Color {
 	return lightColor
 }
 
+// DisableColor turns off ANSI styling for everything rendered with
+// lipgloss, including the TUI
+func DisableColor() {
+	lipgloss.SetColorProfile(termenv.Ascii)
+}
+
+// ColorEnabled reports whether output is styled. It is not with
+// --no-color, when NO_COLOR is set, or when stdout is not a terminal.
+func ColorEnabled() bool {
+	return lipgloss.ColorProfile() != termenv.Ascii
+}
+
 func SetAnsiForegroundColor(hexColor lipgloss.Color) string {
+	if !ColorEnabled() {
+		return ""
+	}
+
 	hexString := strings.TrimPrefix(string(hexColor), "#")
 
 	rVal, _ := strconv.ParseUint(hexString[0:2], 16, 8)
@@ -50,5 +70,9 @@ func SetAnsiForegroundColor(hexColor lipgloss.Color) string {
 }
 
 func ResetForegroundColor() string {
+	if !ColorEnabled() {
+		return ""
+	}
+
 	return "\033[39m"
 }
@@ -5,10 +5,18 @@ import (
 	"github.com/datarobot/cli/internal/log"
 )
 
+// InterruptHandler is implemented by models that must clean up, or ask for
+// confirmation, before Ctrl-C quits, such as one running a child process.
+// Interrupt is called instead of quitting immediately.
+type InterruptHandler interface {
+	Interrupt() (tea.Model, tea.Cmd)
+}
+
 // InterruptibleModel wraps any Bubble Tea model to ensure Ctrl-C always works.
 // This wrapper intercepts ALL messages before they reach the underlying model,
 // checking for Ctrl-C and immediately quitting if detected. This guarantees
 // users can never get stuck in the program, regardless of what the model does.
+// Models implementing InterruptHandler handle Ctrl-C themselves instead.
 type InterruptibleModel struct {
 	Model tea.Model
 }
@@ -34,6 +42,16 @@ func (m InterruptibleModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	// screen state, or what the underlying model does
 	if keyMsg, ok := msg.(tea.KeyMsg); ok {
 		if keyMsg.String() == "ctrl+c" {
+			// Models with work in flight decide for themselves how to stop
+			if handler, ok := m.Model.(InterruptHandler); ok {
+				log.Info("Ctrl-C detected, delegating to model...")
+
+				updatedModel, cmd := handler.Interrupt()
+				m.Model = updatedModel
+
+				return m, cmd
+			}
+
 			// Log the interrupt for debugging purposes
 			log.Info("Ctrl-C detected, quitting...")
 			return m, tea.Quit
@@ -0,0 +1,100 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tui
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// MaskChar is shown for each character of a secret
+const MaskChar = '*'
+
+// MaskedInput is a text input for secrets such as API tokens. Every
+// character shows as MaskChar, pasted text loses the line breaks and
+// surrounding whitespace copied along with it, and backspace erases one
+// character at a time. Ctrl+W clears the whole value, like Ctrl+U, since
+// the words in a secret can't be seen.
+type MaskedInput struct {
+	input textinput.Model
+}
+
+// NewMaskedInput returns a MaskedInput that shows prompt before the
+// masked value. It is not focused.
+func NewMaskedInput(prompt string) MaskedInput {
+	input := textinput.New()
+	input.Prompt = prompt
+	input.EchoMode = textinput.EchoPassword
+	input.EchoCharacter = MaskChar
+
+	return MaskedInput{input: input}
+}
+
+// Focus makes the input accept keys and shows its cursor
+func (m *MaskedInput) Focus() tea.Cmd {
+	return m.input.Focus()
+}
+
+// Value returns the secret as entered
+func (m MaskedInput) Value() string {
+	return m.input.Value()
+}
+
+// SetValue replaces the secret, such as with one generated for the user
+func (m *MaskedInput) SetValue(s string) {
+	m.input.SetValue(s)
+}
+
+func (m MaskedInput) Update(msg tea.Msg) (MaskedInput, tea.Cmd) {
+	if key, ok := msg.(tea.KeyMsg); ok && key.Type == tea.KeyRunes {
+		key.Runes = secretRunes(key.Runes, key.Paste)
+		if len(key.Runes) == 0 {
+			return m, nil
+		}
+
+		msg = key
+	}
+
+	var cmd tea.Cmd
+
+	m.input, cmd = m.input.Update(msg)
+
+	return m, cmd
+}
+
+func (m MaskedInput) View() string {
+	return m.input.View()
+}
+
+// secretRunes drops the control characters, such as line breaks, that
+// never belong in a secret, and the whitespace around pasted text
+func secretRunes(runes []rune, pasted bool) []rune {
+	s := string(runes)
+
+	if pasted {
+		s = strings.TrimSpace(s)
+	}
+
+	return []rune(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+
+		return r
+	}, s))
+}
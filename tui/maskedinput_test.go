@@ -0,0 +1,90 @@
+// Copyright 2025 DataRobot, Inc. and its affiliates.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tui
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/stretchr/testify/assert"
+)
+
+func typeKeys(m MaskedInput, msgs ...tea.KeyMsg) MaskedInput {
+	for _, msg := range msgs {
+		m, _ = m.Update(msg)
+	}
+
+	return m
+}
+
+func runes(s string) tea.KeyMsg {
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func newFocusedInput() MaskedInput {
+	m := NewMaskedInput("Token: ")
+	m.Focus()
+
+	return m
+}
+
+func TestMaskedInputMasksValue(t *testing.T) {
+	m := typeKeys(newFocusedInput(), runes("s"), runes("e"), runes("cret"))
+
+	assert.Equal(t, "secret", m.Value())
+	assert.Contains(t, m.View(), "Token: ******")
+	assert.NotContains(t, m.View(), "secret")
+}
+
+func TestMaskedInputBackspace(t *testing.T) {
+	m := typeKeys(newFocusedInput(), runes("secret"), tea.KeyMsg{Type: tea.KeyBackspace})
+	assert.Equal(t, "secre", m.Value())
+
+	m = typeKeys(m, tea.KeyMsg{Type: tea.KeyCtrlW})
+	assert.Empty(t, m.Value())
+
+	m = typeKeys(m, runes("two words"), tea.KeyMsg{Type: tea.KeyCtrlU})
+	assert.Empty(t, m.Value())
+	assert.NotContains(t, m.View(), string(MaskChar))
+}
+
+func TestMaskedInputPaste(t *testing.T) {
+	pasted := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("  abc\r\ndef\n"), Paste: true}
+	m := typeKeys(newFocusedInput(), runes("x"), pasted)
+
+	assert.Equal(t, "xabcdef", m.Value())
+	assert.Contains(t, m.View(), strings.Repeat(string(MaskChar), 7))
+}
+
+func TestMaskedInputKeepsTypedSpaces(t *testing.T) {
+	m := typeKeys(newFocusedInput(), runes("a"), runes(" "), runes("b"), runes("\t"))
+
+	assert.Equal(t, "a b", m.Value())
+}
+
+func TestMaskedInputIgnoresKeysUnlessFocused(t *testing.T) {
+	m := typeKeys(NewMaskedInput("Token: "), runes("secret"))
+
+	assert.Empty(t, m.Value())
+}
+
+func TestMaskedInputSetValue(t *testing.T) {
+	m := NewMaskedInput("")
+	m.SetValue("generated")
+
+	assert.Equal(t, "generated", m.Value())
+	assert.NotContains(t, m.View(), "generated")
+}
@@ -1,10 +1,26 @@
 package tui
 
 import (
+	"os"
+
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/datarobot/cli/internal/log"
+	"golang.org/x/term"
 )
 
+// stdoutIsTerminal is stubbed in tests
+var stdoutIsTerminal = func() bool {
+	return term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// Headless reports whether commands that have a non-interactive mode
+// should use it rather than a TUI: JSON logs were requested and stdout is
+// not a terminal, as when running in a container whose output goes to a
+// log collector
+func Headless() bool {
+	return log.JSONFormat() && !stdoutIsTerminal()
+}
+
 // Run is a wrapper for tea.NewProgram and (p *Program) Run()
 // Disables stderr logging while bubbletea program is running
 // Wraps a model in NewInterruptibleModel